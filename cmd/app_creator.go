@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cast"
 
 	"github.com/bandprotocol/falcon/relayer"
+	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/logger"
 	"github.com/bandprotocol/falcon/relayer/store"
 )
@@ -34,6 +35,16 @@ func (a *AppCreator) NewApp(
 	}
 
 	logWrapper := logger.NewZapLogWrapper(log)
-	app := relayer.NewApp(logWrapper, cfg, passphrase, dbPath, store, nil)
+
+	// initialize alert backend from the config, if any
+	var alerter alert.Alert
+	if cfg != nil {
+		alerter, err = alert.NewAlert(cfg.Alert, logWrapper.With("sys", "alert"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	app := relayer.NewApp(logWrapper, cfg, passphrase, dbPath, store, alerter)
 	return app, nil
 }
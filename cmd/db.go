@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bandprotocol/falcon/relayer"
+	"github.com/bandprotocol/falcon/relayer/db"
+)
+
+// DbCmd represents the command for managing the schema of the database.
+func DbCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Manage the schema of the database",
+		Long:  "Manage the schema of the database given by the DB_PATH environment variable using the migrations embedded in the binary.",
+	}
+
+	cmd.AddCommand(
+		dbMigrateCmd(appCreator, defaultHome),
+		dbStatusCmd(appCreator, defaultHome),
+		dbRollbackCmd(appCreator, defaultHome),
+	)
+
+	return cmd
+}
+
+// dbMigrateCmd returns a command that applies all pending migrations to the database.
+func dbMigrateCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "migrate",
+		Short:   "Apply all pending migrations to the database",
+		Args:    withUsage(cobra.NoArgs),
+		Example: "db migrate",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := createApp(cmd, appCreator, defaultHome)
+			if err != nil {
+				return err
+			}
+			defer syncLog(app.GetLog())
+
+			results, err := app.MigrateDatabase(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if len(results) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "Database is up to date")
+				return nil
+			}
+
+			for _, result := range results {
+				fmt.Fprintf(cmd.OutOrStdout(), "Applied migration %d: %s\n", result.Version, result.Name)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// dbStatusCmd returns a command that shows the status of the migrations of the database.
+func dbStatusCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "status",
+		Short:   "Show the status of the migrations of the database",
+		Args:    withUsage(cobra.NoArgs),
+		Example: "db status --output json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := createApp(cmd, appCreator, defaultHome)
+			if err != nil {
+				return err
+			}
+			defer syncLog(app.GetLog())
+
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			if output != outputFormatTable && output != outputFormatJSON {
+				return fmt.Errorf("unsupported output format: %s", output)
+			}
+
+			statuses, err := app.GetDatabaseStatus(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if output == outputFormatJSON {
+				out, err := json.MarshalIndent(statuses, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return nil
+			}
+
+			return printMigrationStatusesTable(cmd, statuses)
+		},
+	}
+
+	cmd.Flags().StringP(flagOutput, "o", outputFormatTable, "output format (table or json)")
+
+	return cmd
+}
+
+// dbRollbackCmd returns a command that rolls back the latest applied migration of the database.
+func dbRollbackCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rollback",
+		Short:   "Roll back the latest applied migration of the database",
+		Args:    withUsage(cobra.NoArgs),
+		Example: "db rollback",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := createApp(cmd, appCreator, defaultHome)
+			if err != nil {
+				return err
+			}
+			defer syncLog(app.GetLog())
+
+			result, err := app.RollbackDatabase(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Rolled back migration %d: %s\n", result.Version, result.Name)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printMigrationStatusesTable prints the migration statuses as a table.
+func printMigrationStatusesTable(cmd *cobra.Command, statuses []db.MigrationStatus) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED_AT")
+	for _, status := range statuses {
+		state := "pending"
+		appliedAt := "-"
+		if status.Applied {
+			state = "applied"
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+
+	return w.Flush()
+}
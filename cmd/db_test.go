@@ -0,0 +1,74 @@
+package cmd_test
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/internal/relayertest"
+	"github.com/bandprotocol/falcon/relayer/db"
+)
+
+func TestDbMigrateStatusRollback(t *testing.T) {
+	sys := relayertest.NewSystem(t)
+	t.Setenv("DB_PATH", "sqlite:///"+path.Join(sys.HomeDir, "falcon.db"))
+
+	res := sys.RunWithInput(t, "config", "init")
+	require.NoError(t, res.Err)
+
+	// all migrations are pending on a new database.
+	res = sys.RunWithInput(t, "db", "status")
+	require.NoError(t, res.Err)
+	lines := strings.Split(strings.TrimSpace(res.Stdout.String()), "\n")
+	require.Len(t, lines, 4)
+	require.True(t, strings.HasPrefix(lines[0], "VERSION"))
+	for _, line := range lines[1:] {
+		require.Contains(t, line, "pending")
+	}
+
+	res = sys.RunWithInput(t, "db", "migrate")
+	require.NoError(t, res.Err)
+	require.Equal(t,
+		"Applied migration 1: 00001_create_transactions.sql\n"+
+			"Applied migration 2: 00002_create_relay_checkpoints.sql\n"+
+			"Applied migration 3: 00003_create_funding_transactions.sql\n",
+		res.Stdout.String(),
+	)
+
+	res = sys.RunWithInput(t, "db", "migrate")
+	require.NoError(t, res.Err)
+	require.Equal(t, "Database is up to date\n", res.Stdout.String())
+
+	res = sys.RunWithInput(t, "db", "status", "--output", "json")
+	require.NoError(t, res.Err)
+	var statuses []db.MigrationStatus
+	require.NoError(t, json.Unmarshal(res.Stdout.Bytes(), &statuses))
+	require.Len(t, statuses, 3)
+	for _, status := range statuses {
+		require.True(t, status.Applied)
+	}
+
+	res = sys.RunWithInput(t, "db", "rollback")
+	require.NoError(t, res.Err)
+	require.Equal(t, "Rolled back migration 3: 00003_create_funding_transactions.sql\n", res.Stdout.String())
+
+	res = sys.RunWithInput(t, "db", "status")
+	require.NoError(t, res.Err)
+	lines = strings.Split(strings.TrimSpace(res.Stdout.String()), "\n")
+	require.Contains(t, lines[2], "applied")
+	require.Contains(t, lines[3], "pending")
+}
+
+func TestDbMigrateNoDatabase(t *testing.T) {
+	sys := relayertest.NewSystem(t)
+	t.Setenv("DB_PATH", "")
+
+	res := sys.RunWithInput(t, "config", "init")
+	require.NoError(t, res.Err)
+
+	res = sys.RunWithInput(t, "db", "migrate")
+	require.ErrorContains(t, res.Err, "database is not configured")
+}
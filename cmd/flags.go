@@ -17,9 +17,20 @@ const (
 	flagRemoteUrl         = "remote-url"
 	flagRemoteKey         = "remote-key"
 	flagMetricsListenAddr = "metrics-listen-addr"
+	flagAdminListenAddr   = "admin-listen-addr"
 	flagTunnelCreator     = "tunnel-creator"
 	flagTunnelIds         = "tunnel-ids"
 	flagForce             = "force"
+	flagTunnelID          = "tunnel-id"
+	flagChain             = "chain"
+	flagStatus            = "status"
+	flagSince             = "since"
+	flagUntil             = "until"
+	flagLimit             = "limit"
+	flagOutput            = "output"
+	flagAll               = "all"
+	flagDryRun            = "dry-run"
+	flagDecode            = "decode"
 )
 
 // registerCommonFlags registers the common flags for the command.
@@ -56,6 +56,7 @@ func KeysCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Command {
 		keysListCmd(appCreator, defaultHome),
 		keysExportCmd(appCreator, defaultHome),
 		keysShowCmd(appCreator, defaultHome),
+		keysSweepCmd(appCreator, defaultHome),
 	)
 
 	return cmd
@@ -276,6 +277,86 @@ keys show eth test-key`),
 	return cmd
 }
 
+// keysSweepCmd returns a command that transfers the balances of keys to a destination address.
+func keysSweepCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep [chain_name] [key_name|--all] [destination]",
+		Short: "Transfer the balance of keys, less the fee, to a destination address",
+		Long: strings.TrimSpace(`
+Transfer the balance of a key, or of every key of the chain with --all, to the destination
+address. The transferred amount is the balance less the transfer fee and the amount that must
+stay in the account (e.g. the XRPL account reserve). Use --dry-run to preview the amounts
+without sending any transaction.`),
+		Args: withUsage(func(cmd *cobra.Command, args []string) error {
+			isAll, err := cmd.Flags().GetBool(flagAll)
+			if err != nil {
+				return err
+			}
+
+			if isAll {
+				return cobra.ExactArgs(2)(cmd, args)
+			}
+			return cobra.ExactArgs(3)(cmd, args)
+		}),
+		Example: strings.TrimSpace(`
+keys sweep eth test-key 0x5FbDB2315678afecb367f032d93F642f64180aa3
+keys sweep eth --all 0x5FbDB2315678afecb367f032d93F642f64180aa3 --dry-run`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := createApp(cmd, appCreator, defaultHome)
+			if err != nil {
+				return err
+			}
+			defer syncLog(app.GetLog())
+
+			dryRun, err := cmd.Flags().GetBool(flagDryRun)
+			if err != nil {
+				return err
+			}
+
+			chainName := args[0]
+			destination := args[len(args)-1]
+
+			var keyNames []string
+			if len(args) == 3 {
+				keyNames = []string{args[1]}
+			}
+
+			if err := app.InitTargetChain(chainName); err != nil {
+				return err
+			}
+
+			outputs, err := app.SweepKeys(cmd.Context(), chainName, keyNames, destination, dryRun)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(outputs, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+
+			failed := 0
+			for _, output := range outputs {
+				if output.Error != "" {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("failed to sweep %d of %d keys", failed, len(outputs))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagAll, false, "sweep every key of the chain")
+	cmd.Flags().Bool(flagDryRun, false, "only show the amounts to transfer without sending any transaction")
+
+	return cmd
+}
+
 // validateAddKeyInput checks that the AddKeyInput is valid.
 func validateAddKeyInput(input *AddKeyInput) error {
 	hasPrivateKey := input.PrivateKey != ""
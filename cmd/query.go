@@ -4,10 +4,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/bandprotocol/falcon/relayer"
+	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
+)
+
+const (
+	outputFormatTable = "table"
+	outputFormatJSON  = "json"
 )
 
 // QueryCmd represents the command for querying data from source and destination chains.
@@ -22,6 +32,8 @@ func QueryCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Command
 		queryTunnelCmd(appCreator, defaultHome),
 		queryPacketCmd(appCreator, defaultHome),
 		queryBalanceCmd(appCreator, defaultHome),
+		queryTxsCmd(appCreator, defaultHome),
+		queryTxCmd(appCreator, defaultHome),
 	)
 
 	return cmd
@@ -76,7 +88,9 @@ func queryPacketCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Co
 		Aliases: []string{"p"},
 		Short:   "Query commands on packet data",
 		Args:    withUsage(cobra.ExactArgs(2)),
-		Example: "query packet 1 1",
+		Example: strings.TrimSpace(`
+query packet 1 1
+query packet 1 1 --decode`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			app, err := createApp(cmd, appCreator, defaultHome)
 			if err != nil {
@@ -98,7 +112,17 @@ func queryPacketCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Co
 				return err
 			}
 
-			packet, err := app.QueryTunnelPacketInfo(cmd.Context(), tunnelID, sequence)
+			isDecode, err := cmd.Flags().GetBool(flagDecode)
+			if err != nil {
+				return err
+			}
+
+			var packet any
+			if isDecode {
+				packet, err = app.QueryDecodedTunnelPacket(cmd.Context(), tunnelID, sequence)
+			} else {
+				packet, err = app.QueryTunnelPacketInfo(cmd.Context(), tunnelID, sequence)
+			}
 			if err != nil {
 				return err
 			}
@@ -113,6 +137,8 @@ func queryPacketCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Co
 		},
 	}
 
+	cmd.Flags().Bool(flagDecode, false, "decode the TSS message of the packet by the encoder of the tunnel")
+
 	return cmd
 }
 
@@ -148,3 +174,184 @@ func queryBalanceCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.C
 
 	return cmd
 }
+
+// queryTxsCmd returns a command that query relayed transactions from the database.
+func queryTxsCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "txs",
+		Short: "Query relayed transactions stored in the database",
+		Long:  "Query relayed transactions stored in the database given by the DB_PATH environment variable.",
+		Args:  withUsage(cobra.NoArgs),
+		Example: "query txs --tunnel-id 1 --status success --since 24h\n" +
+			"query txs --chain eth --since 2025-01-01T00:00:00Z --until 2025-01-02T00:00:00Z --output json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := createApp(cmd, appCreator, defaultHome)
+			if err != nil {
+				return err
+			}
+			defer syncLog(app.GetLog())
+
+			filter, err := parseTransactionFilterFromFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			if output != outputFormatTable && output != outputFormatJSON {
+				return fmt.Errorf("unsupported output format: %s", output)
+			}
+
+			txs, err := app.QueryTransactions(filter)
+			if err != nil {
+				return err
+			}
+
+			if output == outputFormatJSON {
+				out, err := json.MarshalIndent(txs, "", "  ")
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return nil
+			}
+
+			return printTransactionsTable(cmd, txs)
+		},
+	}
+
+	cmd.Flags().Uint64(flagTunnelID, 0, "filter by tunnel ID")
+	cmd.Flags().String(flagChain, "", "filter by target chain name")
+	cmd.Flags().String(flagStatus, "", "filter by status (pending, success, failed or timeout)")
+	cmd.Flags().String(flagSince, "", "only show transactions created since the given RFC3339 time or duration ago (e.g. 24h)")
+	cmd.Flags().String(flagUntil, "", "only show transactions created before the given RFC3339 time or duration ago (e.g. 1h)")
+	cmd.Flags().Int(flagLimit, 20, "maximum number of transactions to show")
+	cmd.Flags().StringP(flagOutput, "o", outputFormatTable, "output format (table or json)")
+
+	return cmd
+}
+
+// queryTxCmd returns a command that query a relayed transaction from the database.
+func queryTxCmd(appCreator relayer.AppCreator, defaultHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tx [tx_hash]",
+		Short:   "Query a relayed transaction stored in the database",
+		Long:    "Query a relayed transaction stored in the database given by the DB_PATH environment variable.",
+		Args:    withUsage(cobra.ExactArgs(1)),
+		Example: "query tx 0x6f1f...",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := createApp(cmd, appCreator, defaultHome)
+			if err != nil {
+				return err
+			}
+			defer syncLog(app.GetLog())
+
+			tx, err := app.QueryTransaction(args[0])
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(tx, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// parseTransactionFilterFromFlag parses the transaction filter from the command line flags.
+func parseTransactionFilterFromFlag(cmd *cobra.Command) (db.TransactionFilter, error) {
+	var filter db.TransactionFilter
+
+	if cmd.Flags().Changed(flagTunnelID) {
+		tunnelID, err := cmd.Flags().GetUint64(flagTunnelID)
+		if err != nil {
+			return filter, err
+		}
+		filter.TunnelID = &tunnelID
+	}
+
+	chainName, err := cmd.Flags().GetString(flagChain)
+	if err != nil {
+		return filter, err
+	}
+	filter.ChainName = chainName
+
+	status, err := cmd.Flags().GetString(flagStatus)
+	if err != nil {
+		return filter, err
+	}
+	if status != "" {
+		filter.Status = chainstypes.ToTxStatus(status)
+		if filter.Status == 0 {
+			return filter, fmt.Errorf("invalid status: %s", status)
+		}
+	}
+
+	now := time.Now()
+	if filter.From, err = parseTimeFlag(cmd, flagSince, now); err != nil {
+		return filter, err
+	}
+	if filter.To, err = parseTimeFlag(cmd, flagUntil, now); err != nil {
+		return filter, err
+	}
+
+	if filter.Limit, err = cmd.Flags().GetInt(flagLimit); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+// parseTimeFlag parses the flag value as either an RFC3339 time or a duration before now.
+func parseTimeFlag(cmd *cobra.Command, flag string, now time.Time) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s value, expected RFC3339 time or duration: %s", flag, value)
+	}
+
+	t := now.Add(-d)
+	return &t, nil
+}
+
+// printTransactionsTable prints the transactions as a table.
+func printTransactionsTable(cmd *cobra.Command, txs []db.Transaction) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TX_HASH\tTUNNEL_ID\tSEQUENCE\tCHAIN\tSTATUS\tSENDER\tGAS_USED\tCREATED_AT")
+	for _, tx := range txs {
+		gasUsed := "-"
+		if tx.GasUsed.Valid {
+			gasUsed = tx.GasUsed.Decimal.String()
+		}
+
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\n",
+			tx.TxHash,
+			tx.TunnelID,
+			tx.Sequence,
+			tx.ChainName,
+			tx.Status,
+			tx.Sender,
+			gasUsed,
+			tx.CreatedAt.UTC().Format(time.RFC3339),
+		)
+	}
+
+	return w.Flush()
+}
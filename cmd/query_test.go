@@ -0,0 +1,121 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/internal/relayertest"
+	"github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
+)
+
+// setupTxDatabase creates a sqlite database with the given transactions and sets it
+// as the database of the falcon command.
+func setupTxDatabase(t *testing.T, sys *relayertest.System, txs ...*db.Transaction) {
+	dbPath := "sqlite:///" + path.Join(sys.HomeDir, "falcon.db")
+
+	migrator, err := db.NewMigrator(dbPath)
+	require.NoError(t, err)
+	defer migrator.Close()
+
+	_, err = migrator.Migrate(context.Background())
+	require.NoError(t, err)
+
+	sql, err := db.NewSQL(dbPath)
+	require.NoError(t, err)
+
+	for _, tx := range txs {
+		require.NoError(t, sql.AddOrUpdateTransaction(tx))
+	}
+
+	t.Setenv("DB_PATH", dbPath)
+}
+
+func newTestTransaction(txHash string, tunnelID uint64, status types.TxStatus) *db.Transaction {
+	return db.NewTransaction(
+		txHash,
+		tunnelID,
+		1,
+		"testnet",
+		types.ChainTypeEVM,
+		"0xsender",
+		status,
+		decimal.NewNullDecimal(decimal.NewFromInt(21000)),
+		decimal.NullDecimal{},
+		decimal.NullDecimal{},
+		[]db.SignalPrice{*db.NewSignalPrice("CS:BTC-USD", 100)},
+		nil,
+		nil,
+	)
+}
+
+func TestQueryTxs(t *testing.T) {
+	sys := relayertest.NewSystem(t)
+
+	res := sys.RunWithInput(t, "config", "init")
+	require.NoError(t, res.Err)
+
+	setupTxDatabase(t, sys,
+		newTestTransaction("0x01", 1, types.TX_STATUS_SUCCESS),
+		newTestTransaction("0x02", 2, types.TX_STATUS_FAILED),
+	)
+
+	// table output
+	res = sys.RunWithInput(t, "query", "txs")
+	require.NoError(t, res.Err)
+	lines := strings.Split(strings.TrimSpace(res.Stdout.String()), "\n")
+	require.Len(t, lines, 3)
+	require.True(t, strings.HasPrefix(lines[0], "TX_HASH"))
+	require.True(t, strings.HasPrefix(lines[1], "0x02"))
+	require.Contains(t, lines[1], "Failed")
+
+	// json output with filters
+	res = sys.RunWithInput(t, "q", "txs", "--tunnel-id", "1", "--status", "success", "-o", "json")
+	require.NoError(t, res.Err)
+
+	var txs []db.Transaction
+	require.NoError(t, json.Unmarshal(res.Stdout.Bytes(), &txs))
+	require.Len(t, txs, 1)
+	require.Equal(t, "0x01", txs[0].TxHash)
+
+	// invalid flags
+	res = sys.RunWithInput(t, "query", "txs", "--status", "unknown")
+	require.ErrorContains(t, res.Err, "invalid status: unknown")
+
+	res = sys.RunWithInput(t, "query", "txs", "--since", "yesterday")
+	require.ErrorContains(t, res.Err, "invalid --since value")
+}
+
+func TestQueryTx(t *testing.T) {
+	sys := relayertest.NewSystem(t)
+
+	res := sys.RunWithInput(t, "config", "init")
+	require.NoError(t, res.Err)
+
+	setupTxDatabase(t, sys, newTestTransaction("0x01", 1, types.TX_STATUS_SUCCESS))
+
+	res = sys.RunWithInput(t, "query", "tx", "0x01")
+	require.NoError(t, res.Err)
+	require.Contains(t, res.Stdout.String(), `"status": "Success"`)
+	require.Contains(t, res.Stdout.String(), `"signal_id": "CS:BTC-USD"`)
+
+	res = sys.RunWithInput(t, "query", "tx", "0x02")
+	require.ErrorIs(t, res.Err, db.ErrTransactionNotFound)
+}
+
+func TestQueryTxsNoDatabase(t *testing.T) {
+	sys := relayertest.NewSystem(t)
+
+	res := sys.RunWithInput(t, "config", "init")
+	require.NoError(t, res.Err)
+
+	t.Setenv("DB_PATH", "")
+	res = sys.RunWithInput(t, "query", "txs")
+	require.ErrorContains(t, res.Err, "database is not configured")
+}
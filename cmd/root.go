@@ -59,6 +59,7 @@ func NewRootCmd() *cobra.Command {
 		ConfigCmd(ac.NewApp, defaultHome),
 		ChainsCmd(ac.NewApp, defaultHome),
 		KeysCmd(ac.NewApp, defaultHome),
+		DbCmd(ac.NewApp, defaultHome),
 
 		lineBreakCommand(),
 		TransactionCmd(ac.NewApp, defaultHome),
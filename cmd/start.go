@@ -67,6 +67,16 @@ start --tunnel-creator 0xABC123   # start relaying data from tunnels created by
 				}
 			}
 
+			adminListenAddr, err := cmd.Flags().GetString(flagAdminListenAddr)
+			if err != nil {
+				return err
+			}
+
+			// override the admin server address of the config, if any
+			if adminListenAddr != "" {
+				cfg.Global.AdminListenAddr = adminListenAddr
+			}
+
 			if tunnelCreator != "" && len(tunnelIDs) != 0 {
 				return fmt.Errorf(
 					"the --tunnel-creator and --tunnel-ids flags cannot be used together, please specify only one of these options at a time",
@@ -83,6 +93,12 @@ start --tunnel-creator 0xABC123   # start relaying data from tunnels created by
 		"address to use for metrics server. By default, "+
 			"will be the metrics-listen-addr parameter in the global config. ",
 	)
+	cmd.Flags().String(
+		flagAdminListenAddr,
+		"",
+		"address to use for admin server. By default, "+
+			"will be the admin-listen-addr parameter in the global config. ",
+	)
 	cmd.Flags().UintSlice(
 		flagTunnelIds,
 		[]uint{},
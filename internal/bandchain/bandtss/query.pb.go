@@ -32,3 +32,49 @@ type QuerySigningResponse struct {
 func (m *QuerySigningResponse) Reset()         { *m = QuerySigningResponse{} }
 func (m *QuerySigningResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningResponse) ProtoMessage()    {}
+
+// QueryCurrentGroupRequest is the request type for the Query/CurrentGroup RPC method.
+type QueryCurrentGroupRequest struct{}
+
+func (m *QueryCurrentGroupRequest) Reset()         { *m = QueryCurrentGroupRequest{} }
+func (m *QueryCurrentGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentGroupRequest) ProtoMessage()    {}
+
+// QueryCurrentGroupResponse is the response type for the Query/CurrentGroup RPC method.
+type QueryCurrentGroupResponse struct {
+	// group_id is the ID of the current group.
+	GroupID tsstypes.GroupID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=github.com/bandprotocol/falcon/internal/bandchain/tss.GroupID" json:"group_id,omitempty"`
+	// size is the number of members in the group.
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3"                                                                                        json:"size,omitempty"`
+	// threshold is the minimum number of members needed to generate a valid signature.
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3"                                                                                   json:"threshold,omitempty"`
+	// pub_key is the public key generated by the group.
+	PubKey tsstypes.Point `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3,casttype=github.com/bandprotocol/falcon/internal/bandchain/tss.Point"   json:"pub_key,omitempty"`
+}
+
+func (m *QueryCurrentGroupResponse) Reset()         { *m = QueryCurrentGroupResponse{} }
+func (m *QueryCurrentGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentGroupResponse) ProtoMessage()    {}
+
+// QueryIncomingGroupRequest is the request type for the Query/IncomingGroup RPC method.
+type QueryIncomingGroupRequest struct{}
+
+func (m *QueryIncomingGroupRequest) Reset()         { *m = QueryIncomingGroupRequest{} }
+func (m *QueryIncomingGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncomingGroupRequest) ProtoMessage()    {}
+
+// QueryIncomingGroupResponse is the response type for the Query/IncomingGroup RPC method.
+type QueryIncomingGroupResponse struct {
+	// group_id is the ID of the incoming group.
+	GroupID tsstypes.GroupID `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3,casttype=github.com/bandprotocol/falcon/internal/bandchain/tss.GroupID" json:"group_id,omitempty"`
+	// size is the number of members in the group.
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3"                                                                                        json:"size,omitempty"`
+	// threshold is the minimum number of members needed to generate a valid signature.
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3"                                                                                   json:"threshold,omitempty"`
+	// pub_key is the public key generated by the group.
+	PubKey tsstypes.Point `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3,casttype=github.com/bandprotocol/falcon/internal/bandchain/tss.Point"   json:"pub_key,omitempty"`
+}
+
+func (m *QueryIncomingGroupResponse) Reset()         { *m = QueryIncomingGroupResponse{} }
+func (m *QueryIncomingGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncomingGroupResponse) ProtoMessage()    {}
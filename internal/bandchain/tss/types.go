@@ -0,0 +1,9 @@
+package tss
+
+// events
+const (
+	EventTypeSigningSuccess = "signing_success"
+	EventTypeSigningFailed  = "signing_failed"
+
+	AttributeKeySigningID = "signing_id"
+)
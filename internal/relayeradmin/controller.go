@@ -0,0 +1,57 @@
+package relayeradmin
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned by the Controller if the requested tunnel or chain does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned by the Controller if the request conflicts with the current state.
+	ErrConflict = errors.New("conflict")
+)
+
+// TunnelState is the state of a tunnel relayer of the running relayer.
+type TunnelState struct {
+	TunnelID            uint64     `json:"tunnel_id"`
+	ChainName           string     `json:"chain_name"`
+	ChainType           string     `json:"chain_type"`
+	IsPaused            bool       `json:"is_paused"`
+	IsChainPaused       bool       `json:"is_chain_paused"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	PenaltyErrorClass   string     `json:"penalty_error_class,omitempty"`
+	PenaltyUntil        *time.Time `json:"penalty_until,omitempty"`
+	LastRelayedSequence *uint64    `json:"last_relayed_sequence,omitempty"`
+	LastRelayedAt       *time.Time `json:"last_relayed_at,omitempty"`
+}
+
+// ReloadResult is the result of reloading the config of the running relayer, listing the
+// target chains added, removed and updated by the reload.
+type ReloadResult struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Updated []string `json:"updated"`
+}
+
+// Controller controls the tunnel relayers of the running relayer.
+type Controller interface {
+	// GetTunnelStates returns the states of all tunnel relayers, sorted by tunnel ID.
+	GetTunnelStates() []TunnelState
+	// GetTunnelState returns the state of the tunnel relayer of the given tunnel ID.
+	GetTunnelState(tunnelID uint64) (TunnelState, error)
+	// TriggerTunnel schedules the tunnel relayer of the given tunnel ID to check and relay packets.
+	TriggerTunnel(tunnelID uint64) error
+	// PauseTunnel stops scheduling the tunnel relayer of the given tunnel ID.
+	PauseTunnel(tunnelID uint64) error
+	// ResumeTunnel resumes scheduling the tunnel relayer of the given tunnel ID.
+	ResumeTunnel(tunnelID uint64) error
+	// PauseChain stops scheduling all tunnel relayers targeting the given chain.
+	PauseChain(chainName string) error
+	// ResumeChain resumes scheduling the tunnel relayers targeting the given chain.
+	ResumeChain(chainName string) error
+	// ClearPenalty resets the penalty of the tunnel relayer of the given tunnel ID.
+	ClearPenalty(tunnelID uint64) error
+	// Reload reloads the config file into the running relayer.
+	Reload() (ReloadResult, error)
+}
@@ -0,0 +1,212 @@
+package relayeradmin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+// errorResponse is the response body of a failed admin request.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// NewHandler returns the HTTP handler of the admin API. Every request must carry the given
+// token as a bearer token in the Authorization header.
+func NewHandler(log logger.Logger, token string, controller Controller) http.Handler {
+	h := &handler{log: log, controller: controller}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /tunnels", h.listTunnels)
+	mux.HandleFunc("GET /tunnels/{id}", h.withTunnelID(h.getTunnel))
+	mux.HandleFunc("POST /tunnels/{id}/trigger", h.withTunnelID(h.tunnelAction("trigger", controller.TriggerTunnel)))
+	mux.HandleFunc("POST /tunnels/{id}/pause", h.withTunnelID(h.tunnelAction("pause", controller.PauseTunnel)))
+	mux.HandleFunc("POST /tunnels/{id}/resume", h.withTunnelID(h.tunnelAction("resume", controller.ResumeTunnel)))
+	mux.HandleFunc(
+		"POST /tunnels/{id}/clear-penalty",
+		h.withTunnelID(h.tunnelAction("clear-penalty", controller.ClearPenalty)),
+	)
+	mux.HandleFunc("POST /chains/{name}/pause", h.chainAction("pause", controller.PauseChain))
+	mux.HandleFunc("POST /chains/{name}/resume", h.chainAction("resume", controller.ResumeChain))
+	mux.HandleFunc("POST /reload", h.reload)
+
+	return withBearerAuth(token, mux)
+}
+
+// StartAdminServer starts the admin HTTP server on the given address. The server is closed
+// when the context is done.
+func StartAdminServer(
+	ctx context.Context,
+	log logger.Logger,
+	adminListenAddr string,
+	token string,
+	controller Controller,
+) error {
+	if token == "" {
+		return fmt.Errorf("admin_token is required to start the admin server")
+	}
+
+	ln, err := net.Listen("tcp", adminListenAddr)
+	if err != nil {
+		log.Error(
+			"Failed to start admin server you can change the address and port using admin-listen-addr config setting or --admin-listen-addr flag",
+		)
+
+		return fmt.Errorf("failed to listen on admin address %q: %w", adminListenAddr, err)
+	}
+	log = log.With("sys", "adminhttp")
+	log.Info("Admin server listening", "addr", adminListenAddr)
+
+	srv := &http.Server{
+		Handler:  NewHandler(log, token, controller),
+		ErrorLog: log.ToStdLog(),
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+
+	return nil
+}
+
+// withBearerAuth rejects the requests that do not carry the given bearer token.
+func withBearerAuth(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(reqToken), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="falcon"`)
+			writeError(w, http.StatusUnauthorized, fmt.Errorf("invalid or missing bearer token"))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handler serves the admin API requests with the given controller.
+type handler struct {
+	log        logger.Logger
+	controller Controller
+}
+
+// listTunnels writes the states of all tunnel relayers.
+func (h *handler) listTunnels(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, h.controller.GetTunnelStates())
+}
+
+// getTunnel writes the state of the tunnel relayer of the given tunnel ID.
+func (h *handler) getTunnel(w http.ResponseWriter, _ *http.Request, tunnelID uint64) {
+	state, err := h.controller.GetTunnelState(tunnelID)
+	if err != nil {
+		writeControllerError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, state)
+}
+
+// tunnelAction returns the handler applying the given action to the tunnel relayer and
+// writing its resulting state.
+func (h *handler) tunnelAction(
+	name string,
+	action func(tunnelID uint64) error,
+) func(http.ResponseWriter, *http.Request, uint64) {
+	return func(w http.ResponseWriter, r *http.Request, tunnelID uint64) {
+		if err := action(tunnelID); err != nil {
+			writeControllerError(w, err)
+			return
+		}
+		h.log.Info("Admin action is applied to the tunnel", "action", name, "tunnel_id", tunnelID)
+
+		h.getTunnel(w, r, tunnelID)
+	}
+}
+
+// chainAction returns the handler applying the given action to the tunnel relayers of the chain
+// and writing their resulting states.
+func (h *handler) chainAction(name string, action func(chainName string) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		chainName := r.PathValue("name")
+		if err := action(chainName); err != nil {
+			writeControllerError(w, err)
+			return
+		}
+		h.log.Info("Admin action is applied to the chain", "action", name, "chain_name", chainName)
+
+		states := []TunnelState{}
+		for _, state := range h.controller.GetTunnelStates() {
+			if state.ChainName == chainName {
+				states = append(states, state)
+			}
+		}
+
+		writeJSON(w, http.StatusOK, states)
+	}
+}
+
+// reload reloads the config file into the running relayer and writes the reloaded target chains.
+func (h *handler) reload(w http.ResponseWriter, _ *http.Request) {
+	result, err := h.controller.Reload()
+	if err != nil {
+		writeControllerError(w, err)
+		return
+	}
+	h.log.Info("Admin action is applied to the config", "action", "reload")
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// withTunnelID parses the tunnel ID from the request path and passes it to the given handler.
+func (h *handler) withTunnelID(next func(http.ResponseWriter, *http.Request, uint64)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		tunnelID, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid tunnel id: %s", r.PathValue("id")))
+			return
+		}
+
+		next(w, r, tunnelID)
+	}
+}
+
+// writeControllerError writes the error returned by the controller with its matching status code.
+func writeControllerError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		writeError(w, http.StatusNotFound, err)
+	case errors.Is(err, ErrConflict):
+		writeError(w, http.StatusConflict, err)
+	default:
+		writeError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// writeError writes the error as a JSON response with the given status code.
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+// writeJSON writes the value as a JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
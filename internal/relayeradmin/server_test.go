@@ -0,0 +1,246 @@
+package relayeradmin_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/bandprotocol/falcon/internal/relayeradmin"
+	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+const testToken = "secret"
+
+type AdminServerTestSuite struct {
+	suite.Suite
+
+	controller *mocks.MockController
+	server     *httptest.Server
+}
+
+func TestAdminServerTestSuite(t *testing.T) {
+	suite.Run(t, new(AdminServerTestSuite))
+}
+
+// SetupTest sets up the admin server with a mock controller.
+func (s *AdminServerTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.controller = mocks.NewMockController(ctrl)
+
+	log := logger.NewZapLogWrapper(zap.NewNop().Sugar())
+	s.server = httptest.NewServer(relayeradmin.NewHandler(log, testToken, s.controller))
+	s.T().Cleanup(s.server.Close)
+}
+
+// do sends the request to the admin server with the given token and decodes the response into v.
+func (s *AdminServerTestSuite) do(method string, path string, token string, v any) int {
+	req, err := http.NewRequest(method, s.server.URL+path, nil)
+	s.Require().NoError(err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+
+	if v != nil {
+		s.Require().NoError(json.NewDecoder(resp.Body).Decode(v))
+	}
+
+	return resp.StatusCode
+}
+
+func (s *AdminServerTestSuite) TestUnauthorized() {
+	testcases := []struct {
+		name  string
+		token string
+	}{
+		{name: "missing token", token: ""},
+		{name: "invalid token", token: "invalid"},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			var resp map[string]string
+			status := s.do(http.MethodGet, "/tunnels", tc.token, &resp)
+
+			s.Require().Equal(http.StatusUnauthorized, status)
+			s.Require().Equal("invalid or missing bearer token", resp["error"])
+		})
+	}
+}
+
+func (s *AdminServerTestSuite) TestListTunnels() {
+	seq := uint64(10)
+	relayedAt := time.Unix(1700000000, 0).UTC()
+	penaltyUntil := relayedAt.Add(time.Minute)
+	expected := []relayeradmin.TunnelState{
+		{
+			TunnelID:            1,
+			ChainName:           "testnet_evm",
+			ChainType:           "evm",
+			ConsecutiveFailures: 2,
+			PenaltyErrorClass:   "transient",
+			PenaltyUntil:        &penaltyUntil,
+			LastRelayedSequence: &seq,
+			LastRelayedAt:       &relayedAt,
+		},
+		{TunnelID: 2, ChainName: "testnet_xrpl", ChainType: "xrpl", IsPaused: true},
+	}
+	s.controller.EXPECT().GetTunnelStates().Return(expected)
+
+	var states []relayeradmin.TunnelState
+	status := s.do(http.MethodGet, "/tunnels", testToken, &states)
+
+	s.Require().Equal(http.StatusOK, status)
+	s.Require().Equal(expected, states)
+}
+
+func (s *AdminServerTestSuite) TestGetTunnel() {
+	s.controller.EXPECT().GetTunnelState(uint64(1)).Return(relayeradmin.TunnelState{TunnelID: 1}, nil)
+	s.controller.EXPECT().
+		GetTunnelState(uint64(2)).
+		Return(relayeradmin.TunnelState{}, fmt.Errorf("tunnel 2: %w", relayeradmin.ErrNotFound))
+
+	var state relayeradmin.TunnelState
+	status := s.do(http.MethodGet, "/tunnels/1", testToken, &state)
+	s.Require().Equal(http.StatusOK, status)
+	s.Require().Equal(uint64(1), state.TunnelID)
+
+	var resp map[string]string
+	status = s.do(http.MethodGet, "/tunnels/2", testToken, &resp)
+	s.Require().Equal(http.StatusNotFound, status)
+	s.Require().Equal("tunnel 2: not found", resp["error"])
+
+	status = s.do(http.MethodGet, "/tunnels/abc", testToken, &resp)
+	s.Require().Equal(http.StatusBadRequest, status)
+	s.Require().Equal("invalid tunnel id: abc", resp["error"])
+}
+
+func (s *AdminServerTestSuite) TestTunnelActions() {
+	testcases := []struct {
+		name       string
+		path       string
+		preprocess func()
+		status     int
+	}{
+		{
+			name: "trigger",
+			path: "/tunnels/1/trigger",
+			preprocess: func() {
+				s.controller.EXPECT().TriggerTunnel(uint64(1)).Return(nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "trigger paused tunnel",
+			path: "/tunnels/1/trigger",
+			preprocess: func() {
+				s.controller.EXPECT().
+					TriggerTunnel(uint64(1)).
+					Return(fmt.Errorf("tunnel 1 is paused: %w", relayeradmin.ErrConflict))
+			},
+			status: http.StatusConflict,
+		},
+		{
+			name: "pause",
+			path: "/tunnels/1/pause",
+			preprocess: func() {
+				s.controller.EXPECT().PauseTunnel(uint64(1)).Return(nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "resume",
+			path: "/tunnels/1/resume",
+			preprocess: func() {
+				s.controller.EXPECT().ResumeTunnel(uint64(1)).Return(nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "clear penalty",
+			path: "/tunnels/1/clear-penalty",
+			preprocess: func() {
+				s.controller.EXPECT().ClearPenalty(uint64(1)).Return(nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "unknown tunnel",
+			path: "/tunnels/1/pause",
+			preprocess: func() {
+				s.controller.EXPECT().
+					PauseTunnel(uint64(1)).
+					Return(fmt.Errorf("tunnel 1: %w", relayeradmin.ErrNotFound))
+			},
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			tc.preprocess()
+			if tc.status == http.StatusOK {
+				s.controller.EXPECT().GetTunnelState(uint64(1)).Return(relayeradmin.TunnelState{TunnelID: 1}, nil)
+			}
+
+			status := s.do(http.MethodPost, tc.path, testToken, nil)
+			s.Require().Equal(tc.status, status)
+		})
+	}
+}
+
+func (s *AdminServerTestSuite) TestChainActions() {
+	s.controller.EXPECT().PauseChain("testnet_evm").Return(nil)
+	s.controller.EXPECT().GetTunnelStates().Return([]relayeradmin.TunnelState{
+		{TunnelID: 1, ChainName: "testnet_evm", IsChainPaused: true},
+		{TunnelID: 2, ChainName: "testnet_xrpl"},
+	})
+
+	var states []relayeradmin.TunnelState
+	status := s.do(http.MethodPost, "/chains/testnet_evm/pause", testToken, &states)
+	s.Require().Equal(http.StatusOK, status)
+	s.Require().Equal([]relayeradmin.TunnelState{{TunnelID: 1, ChainName: "testnet_evm", IsChainPaused: true}}, states)
+
+	s.controller.EXPECT().ResumeChain("unknown").Return(fmt.Errorf("chain unknown: %w", relayeradmin.ErrNotFound))
+
+	status = s.do(http.MethodPost, "/chains/unknown/resume", testToken, nil)
+	s.Require().Equal(http.StatusNotFound, status)
+}
+
+func (s *AdminServerTestSuite) TestReload() {
+	s.controller.EXPECT().Reload().Return(relayeradmin.ReloadResult{
+		Added:   []string{"testnet_xrpl"},
+		Removed: []string{},
+		Updated: []string{"testnet_evm"},
+	}, nil)
+
+	var result relayeradmin.ReloadResult
+	status := s.do(http.MethodPost, "/reload", testToken, &result)
+	s.Require().Equal(http.StatusOK, status)
+	s.Require().Equal(relayeradmin.ReloadResult{
+		Added:   []string{"testnet_xrpl"},
+		Removed: []string{},
+		Updated: []string{"testnet_evm"},
+	}, result)
+
+	s.controller.EXPECT().Reload().Return(relayeradmin.ReloadResult{}, fmt.Errorf("failed to reload chain testnet_evm"))
+
+	status = s.do(http.MethodPost, "/reload", testToken, nil)
+	s.Require().Equal(http.StatusInternalServerError, status)
+}
+
+func (s *AdminServerTestSuite) TestMethodNotAllowed() {
+	status := s.do(http.MethodGet, "/tunnels/1/pause", testToken, nil)
+	s.Require().Equal(http.StatusMethodNotAllowed, status)
+}
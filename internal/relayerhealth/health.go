@@ -0,0 +1,225 @@
+package relayerhealth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// defaultCheckTimeout is the maximum time given to all checks of a health request.
+const defaultCheckTimeout = 5 * time.Second
+
+// Status is the health status of a component.
+type Status string
+
+const (
+	StatusUp   Status = "up"
+	StatusDown Status = "down"
+)
+
+// ComponentHealth is the health of a component of the relayer with its detail.
+type ComponentHealth struct {
+	Status Status            `json:"status"`
+	Error  string            `json:"error,omitempty"`
+	Detail map[string]string `json:"detail,omitempty"`
+}
+
+// Up returns the health of a component that is up with the given detail.
+func Up(detail map[string]string) ComponentHealth {
+	return ComponentHealth{Status: StatusUp, Detail: detail}
+}
+
+// Down returns the health of a component that is down because of the given error.
+func Down(err error, detail map[string]string) ComponentHealth {
+	return ComponentHealth{Status: StatusDown, Error: err.Error(), Detail: detail}
+}
+
+// Checker checks the health of a component.
+type Checker interface {
+	CheckHealth(ctx context.Context) ComponentHealth
+}
+
+// CheckerFunc is an adapter to use an ordinary function as a Checker.
+type CheckerFunc func(ctx context.Context) ComponentHealth
+
+// CheckHealth calls f(ctx).
+func (f CheckerFunc) CheckHealth(ctx context.Context) ComponentHealth {
+	return f(ctx)
+}
+
+// Report is the aggregated health of the components of the relayer.
+type Report struct {
+	Status     Status                     `json:"status"`
+	Components map[string]ComponentHealth `json:"components"`
+}
+
+// Registry holds the checkers of the components of the relayer. Liveness checkers are
+// components the relayer cannot work without and are part of both the liveness and
+// readiness reports; readiness checkers are only part of the readiness report.
+type Registry struct {
+	mu        sync.RWMutex
+	liveness  map[string]Checker
+	readiness map[string]Checker
+}
+
+// NewRegistry creates a new Registry without any checker.
+func NewRegistry() *Registry {
+	return &Registry{
+		liveness:  make(map[string]Checker),
+		readiness: make(map[string]Checker),
+	}
+}
+
+// RegisterLiveness registers the checker of a component for the liveness and readiness reports.
+func (r *Registry) RegisterLiveness(name string, checker Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.liveness[name] = checker
+}
+
+// RegisterReadiness registers the checker of a component for the readiness report.
+func (r *Registry) RegisterReadiness(name string, checker Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.readiness[name] = checker
+}
+
+// Unregister removes the checker of the given component.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.liveness, name)
+	delete(r.readiness, name)
+}
+
+// Liveness checks the liveness components. The relayer is live if all of them are up.
+func (r *Registry) Liveness(ctx context.Context) Report {
+	r.mu.RLock()
+	checkers := make(map[string]Checker, len(r.liveness))
+	for name, checker := range r.liveness {
+		checkers[name] = checker
+	}
+	r.mu.RUnlock()
+
+	return check(ctx, checkers)
+}
+
+// Readiness checks all components. The relayer is ready if at least one component is
+// registered and all of them are up.
+func (r *Registry) Readiness(ctx context.Context) Report {
+	r.mu.RLock()
+	checkers := make(map[string]Checker, len(r.liveness)+len(r.readiness))
+	for name, checker := range r.liveness {
+		checkers[name] = checker
+	}
+	for name, checker := range r.readiness {
+		checkers[name] = checker
+	}
+	r.mu.RUnlock()
+
+	if len(checkers) == 0 {
+		return Report{Status: StatusDown, Components: map[string]ComponentHealth{}}
+	}
+
+	return check(ctx, checkers)
+}
+
+// LivenessHandler returns the HTTP handler serving the liveness report.
+func (r *Registry) LivenessHandler() http.Handler {
+	return reportHandler(r.Liveness)
+}
+
+// ReadinessHandler returns the HTTP handler serving the readiness report.
+func (r *Registry) ReadinessHandler() http.Handler {
+	return reportHandler(r.Readiness)
+}
+
+// check runs the given checkers concurrently and aggregates their results.
+func check(ctx context.Context, checkers map[string]Checker) Report {
+	names := make([]string, 0, len(checkers))
+	for name := range checkers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]ComponentHealth, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = checkers[name].CheckHealth(ctx)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Components: make(map[string]ComponentHealth, len(names))}
+	for i, name := range names {
+		report.Components[name] = results[i]
+		if results[i].Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+
+	return report
+}
+
+// reportHandler serves the report from the given function, with status code 200 if the
+// report is up or 503 otherwise.
+func reportHandler(getReport func(ctx context.Context) Report) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), defaultCheckTimeout)
+		defer cancel()
+
+		report := getReport(ctx)
+
+		status := http.StatusOK
+		if report.Status != StatusUp {
+			status = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
+
+// defaultRegistry is the registry served by the metrics server.
+var defaultRegistry = NewRegistry()
+
+// RegisterLiveness registers the checker of a component to the default registry for the
+// liveness and readiness reports.
+func RegisterLiveness(name string, checker Checker) {
+	defaultRegistry.RegisterLiveness(name, checker)
+}
+
+// RegisterReadiness registers the checker of a component to the default registry for the
+// readiness report.
+func RegisterReadiness(name string, checker Checker) {
+	defaultRegistry.RegisterReadiness(name, checker)
+}
+
+// Unregister removes the checker of the given component from the default registry.
+func Unregister(name string) {
+	defaultRegistry.Unregister(name)
+}
+
+// DefaultRegistry returns the default registry.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// FormatTime formats the time for the detail of a component health.
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,84 @@
+package relayerhealth_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
+)
+
+// staticChecker returns a checker always returning the given health.
+func staticChecker(health relayerhealth.ComponentHealth) relayerhealth.Checker {
+	return relayerhealth.CheckerFunc(func(context.Context) relayerhealth.ComponentHealth { return health })
+}
+
+// getReport requests the report from the given handler and returns its status code and body.
+func getReport(t *testing.T, handler http.Handler) (int, relayerhealth.Report) {
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var report relayerhealth.Report
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+
+	return resp.StatusCode, report
+}
+
+func TestRegistryReports(t *testing.T) {
+	registry := relayerhealth.NewRegistry()
+
+	// no component is registered yet.
+	status, report := getReport(t, registry.LivenessHandler())
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, relayerhealth.StatusUp, report.Status)
+
+	status, report = getReport(t, registry.ReadinessHandler())
+	require.Equal(t, http.StatusServiceUnavailable, status)
+	require.Equal(t, relayerhealth.StatusDown, report.Status)
+
+	bandchain := relayerhealth.Up(map[string]string{"selected_endpoint": "http://localhost:26657"})
+	registry.RegisterLiveness("bandchain", staticChecker(bandchain))
+	registry.RegisterReadiness("database", staticChecker(relayerhealth.Up(nil)))
+
+	status, report = getReport(t, registry.ReadinessHandler())
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, relayerhealth.Report{
+		Status: relayerhealth.StatusUp,
+		Components: map[string]relayerhealth.ComponentHealth{
+			"bandchain": bandchain,
+			"database":  relayerhealth.Up(nil),
+		},
+	}, report)
+
+	// a readiness component that is down only fails the readiness report.
+	targetChain := relayerhealth.Down(fmt.Errorf("no selected endpoint"), map[string]string{"selected_endpoint": ""})
+	registry.RegisterReadiness("target_chain:testnet", staticChecker(targetChain))
+
+	status, report = getReport(t, registry.LivenessHandler())
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, map[string]relayerhealth.ComponentHealth{"bandchain": bandchain}, report.Components)
+
+	status, report = getReport(t, registry.ReadinessHandler())
+	require.Equal(t, http.StatusServiceUnavailable, status)
+	require.Equal(t, relayerhealth.StatusDown, report.Status)
+	require.Equal(t, targetChain, report.Components["target_chain:testnet"])
+
+	// a liveness component that is down fails both reports.
+	registry.RegisterLiveness("bandchain", staticChecker(relayerhealth.Down(fmt.Errorf("connection refused"), nil)))
+
+	status, report = getReport(t, registry.LivenessHandler())
+	require.Equal(t, http.StatusServiceUnavailable, status)
+	require.Equal(t, "connection refused", report.Components["bandchain"].Error)
+
+	status, _ = getReport(t, registry.ReadinessHandler())
+	require.Equal(t, http.StatusServiceUnavailable, status)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/relayer/logger"
 )
 
@@ -28,16 +29,40 @@ const (
 	ExecutingTaskStatus = "executing"
 )
 
+// Signing statuses of the signing wait used as labels
+const (
+	SuccessSigningStatus = "success"
+	FailedSigningStatus  = "failed"
+	TimeoutSigningStatus = "timeout"
+)
+
+// Cache results used as labels
+const (
+	HitCacheResult  = "hit"
+	MissCacheResult = "miss"
+)
+
 type PrometheusMetrics struct {
 	PacketsRelayedSuccess      *prometheus.CounterVec
 	UnrelayedPackets           *prometheus.GaugeVec
 	TasksCount                 *prometheus.CounterVec
 	FinishedTaskExecutionTime  *prometheus.SummaryVec
-	TunnelsPerDestinationChain *prometheus.CounterVec
+	TunnelsPerDestinationChain *prometheus.GaugeVec
 	ActiveTargetContractsCount *prometheus.GaugeVec
 	TxsCount                   *prometheus.CounterVec
 	TxProcessTime              *prometheus.SummaryVec
 	GasUsed                    *prometheus.SummaryVec
+	EstimatedRelayProfit       *prometheus.GaugeVec
+	UnprofitableRelaysCount    *prometheus.CounterVec
+	TunnelTargetBalance        *prometheus.GaugeVec
+	SignerBalance              *prometheus.GaugeVec
+	QuarantinedSignersCount    *prometheus.GaugeVec
+	RelayQueueDepth            *prometheus.GaugeVec
+	TunnelPenaltyBackoff       *prometheus.GaugeVec
+	TunnelConsecutiveFailures  *prometheus.GaugeVec
+	BandCacheRequestsCount     *prometheus.CounterVec
+	SigningWaitTime            *prometheus.SummaryVec
+	AlertsDroppedCount         *prometheus.CounterVec
 }
 
 func updateMetrics(updateFn func()) {
@@ -87,6 +112,13 @@ func IncTunnelsPerDestinationChain(destinationChain string, chainType string) {
 	})
 }
 
+// DecTunnelsPerDestinationChain decrements the count of tunnels per destination chain.
+func DecTunnelsPerDestinationChain(destinationChain string, chainType string) {
+	updateMetrics(func() {
+		metrics.TunnelsPerDestinationChain.WithLabelValues(destinationChain, chainType).Dec()
+	})
+}
+
 // IncActiveTargetContractsCount increases the count of active target contracts.
 func IncActiveTargetContractsCount(destinationChain string, chainType string) {
 	updateMetrics(func() {
@@ -124,6 +156,111 @@ func ObserveGasUsed(tunnelID uint64, destinationChain string, chainType string,
 	})
 }
 
+// SetEstimatedRelayProfit sets the estimated reimbursement minus the estimated gas cost of the latest relay.
+func SetEstimatedRelayProfit(tunnelID uint64, destinationChain string, chainType string, profit float64) {
+	updateMetrics(func() {
+		metrics.EstimatedRelayProfit.WithLabelValues(fmt.Sprintf("%d", tunnelID), destinationChain, chainType).
+			Set(profit)
+	})
+}
+
+// IncUnprofitableRelaysCount increments the count of relays whose estimated gas cost exceeds the reimbursement.
+func IncUnprofitableRelaysCount(tunnelID uint64, destinationChain string, chainType string, policy string) {
+	updateMetrics(func() {
+		metrics.UnprofitableRelaysCount.WithLabelValues(fmt.Sprintf("%d", tunnelID), destinationChain, chainType, policy).
+			Inc()
+	})
+}
+
+// SetTunnelTargetBalance sets the balance of the tunnel on the target contract.
+func SetTunnelTargetBalance(tunnelID uint64, destinationChain string, chainType string, balance float64) {
+	updateMetrics(func() {
+		metrics.TunnelTargetBalance.WithLabelValues(fmt.Sprintf("%d", tunnelID), destinationChain, chainType).
+			Set(balance)
+	})
+}
+
+// SetSignerBalance sets the balance of the signer on the destination chain.
+func SetSignerBalance(destinationChain string, chainType string, signer string, balance float64) {
+	updateMetrics(func() {
+		metrics.SignerBalance.WithLabelValues(destinationChain, chainType, signer).Set(balance)
+	})
+}
+
+// SetQuarantinedSignersCount sets the number of signers removed from the signer pool of the destination chain.
+func SetQuarantinedSignersCount(destinationChain string, chainType string, count int) {
+	updateMetrics(func() {
+		metrics.QuarantinedSignersCount.WithLabelValues(destinationChain, chainType).Set(float64(count))
+	})
+}
+
+// SetRelayQueueDepth sets the number of tunnels waiting in the relay queue of the destination chain.
+func SetRelayQueueDepth(destinationChain string, depth int) {
+	updateMetrics(func() {
+		metrics.RelayQueueDepth.WithLabelValues(destinationChain).Set(float64(depth))
+	})
+}
+
+// SetTunnelPenalty sets the backoff (in seconds) of the current penalty of the tunnel by the
+// class of its error, and its number of consecutive failures.
+func SetTunnelPenalty(
+	tunnelID uint64,
+	destinationChain string,
+	chainType string,
+	errorClass string,
+	backoff time.Duration,
+	failures int,
+) {
+	updateMetrics(func() {
+		tunnelIDLabel := fmt.Sprintf("%d", tunnelID)
+		metrics.TunnelPenaltyBackoff.WithLabelValues(tunnelIDLabel, destinationChain, chainType, errorClass).
+			Set(backoff.Seconds())
+		metrics.TunnelConsecutiveFailures.WithLabelValues(tunnelIDLabel, destinationChain, chainType).
+			Set(float64(failures))
+	})
+}
+
+// ResetTunnelPenalty removes the penalty of the tunnel by the class of its error and resets its
+// number of consecutive failures.
+func ResetTunnelPenalty(tunnelID uint64, destinationChain string, chainType string, errorClass string) {
+	updateMetrics(func() {
+		tunnelIDLabel := fmt.Sprintf("%d", tunnelID)
+		metrics.TunnelPenaltyBackoff.DeleteLabelValues(tunnelIDLabel, destinationChain, chainType, errorClass)
+		metrics.TunnelConsecutiveFailures.WithLabelValues(tunnelIDLabel, destinationChain, chainType).Set(0)
+	})
+}
+
+// IncBandCacheRequestsCount increments the count of the BandChain queries served by the given
+// cache, by whether they are served from the cache or from BandChain.
+func IncBandCacheRequestsCount(cache string, result string) {
+	updateMetrics(func() {
+		metrics.BandCacheRequestsCount.WithLabelValues(cache, result).Inc()
+	})
+}
+
+// ObserveSigningWaitTime records the time (ms) the tunnel relayer waited for the signing of a
+// packet, by how the signing is resolved.
+func ObserveSigningWaitTime(
+	tunnelID uint64,
+	destinationChain string,
+	chainType string,
+	signingStatus string,
+	waitTime int64,
+) {
+	updateMetrics(func() {
+		metrics.SigningWaitTime.WithLabelValues(fmt.Sprintf("%d", tunnelID), destinationChain, chainType, signingStatus).
+			Observe(float64(waitTime))
+	})
+}
+
+// IncAlertsDroppedCount increments the count of the alerts dropped because the queue of the
+// alert backend is full.
+func IncAlertsDroppedCount(backend string) {
+	updateMetrics(func() {
+		metrics.AlertsDroppedCount.WithLabelValues(backend).Inc()
+	})
+}
+
 func InitPrometheusMetrics() {
 	packetLabels := []string{"tunnel_id"}
 	tasksCountLabels := []string{"tunnel_id", "destination_chain", "chain_type", "task_status"}
@@ -134,6 +271,17 @@ func InitPrometheusMetrics() {
 	txProcessTimeLabels := []string{"tunnel_id", "destination_chain", "chain_type", "tx_status"}
 
 	gasUsedLabels := []string{"tunnel_id", "destination_chain", "chain_type", "tx_status"}
+	estimatedRelayProfitLabels := []string{"tunnel_id", "destination_chain", "chain_type"}
+	unprofitableRelaysCountLabels := []string{"tunnel_id", "destination_chain", "chain_type", "policy"}
+	tunnelTargetBalanceLabels := []string{"tunnel_id", "destination_chain", "chain_type"}
+	signerBalanceLabels := []string{"destination_chain", "chain_type", "signer"}
+	quarantinedSignersCountLabels := []string{"destination_chain", "chain_type"}
+	relayQueueDepthLabels := []string{"destination_chain"}
+	tunnelPenaltyBackoffLabels := []string{"tunnel_id", "destination_chain", "chain_type", "error_class"}
+	tunnelConsecutiveFailuresLabels := []string{"tunnel_id", "destination_chain", "chain_type"}
+	bandCacheRequestsCountLabels := []string{"cache", "result"}
+	signingWaitTimeLabels := []string{"tunnel_id", "destination_chain", "chain_type", "signing_status"}
+	alertsDroppedCountLabels := []string{"backend"}
 
 	metrics = &PrometheusMetrics{
 		PacketsRelayedSuccess: promauto.NewCounterVec(prometheus.CounterOpts{
@@ -157,9 +305,9 @@ func InitPrometheusMetrics() {
 				0.99: 0.001,
 			},
 		}, finishedTaskExecutionTimeLabels),
-		TunnelsPerDestinationChain: promauto.NewCounterVec(prometheus.CounterOpts{
+		TunnelsPerDestinationChain: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "falcon_tunnels_per_destination_chain",
-			Help: "Total number of tunnels per destination chain",
+			Help: "Number of tunnels per destination chain",
 		}, tunnelPerDestinationChainLabels),
 		ActiveTargetContractsCount: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "falcon_active_target_contracts_count",
@@ -187,6 +335,55 @@ func InitPrometheusMetrics() {
 				0.99: 0.001,
 			},
 		}, gasUsedLabels),
+		EstimatedRelayProfit: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "falcon_estimated_relay_profit",
+			Help: "Estimated reimbursement minus estimated gas cost (in the smallest unit of the native token) of the latest relay",
+		}, estimatedRelayProfitLabels),
+		UnprofitableRelaysCount: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "falcon_unprofitable_relays_count",
+			Help: "Total number of relays whose estimated gas cost exceeds the reimbursement",
+		}, unprofitableRelaysCountLabels),
+		TunnelTargetBalance: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "falcon_tunnel_target_balance",
+			Help: "Balance (in the smallest unit of the native token) of the tunnel on the target contract",
+		}, tunnelTargetBalanceLabels),
+		SignerBalance: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "falcon_signer_balance",
+			Help: "Balance (in the smallest unit of the native token) of the signer on the destination chain",
+		}, signerBalanceLabels),
+		QuarantinedSignersCount: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "falcon_quarantined_signers_count",
+			Help: "Number of signers removed from the signer pool because their balance is below the floor",
+		}, quarantinedSignersCountLabels),
+		RelayQueueDepth: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "falcon_relay_queue_depth",
+			Help: "Number of tunnels waiting in the relay queue of the destination chain",
+		}, relayQueueDepthLabels),
+		TunnelPenaltyBackoff: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "falcon_tunnel_penalty_backoff_seconds",
+			Help: "Backoff (s) of the current penalty of the tunnel by the class of its error",
+		}, tunnelPenaltyBackoffLabels),
+		TunnelConsecutiveFailures: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "falcon_tunnel_consecutive_failures",
+			Help: "Number of consecutive failures of the tunnel relayer",
+		}, tunnelConsecutiveFailuresLabels),
+		BandCacheRequestsCount: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "falcon_band_cache_requests_count",
+			Help: "Total number of BandChain queries by cache, served from the cache (hit) or BandChain (miss)",
+		}, bandCacheRequestsCountLabels),
+		SigningWaitTime: promauto.NewSummaryVec(prometheus.SummaryOpts{
+			Name: "falcon_signing_wait_time",
+			Help: "Time (ms) waiting for the signing of the packets on BandChain",
+			Objectives: map[float64]float64{
+				0.5:  0.05,
+				0.9:  0.01,
+				0.99: 0.001,
+			},
+		}, signingWaitTimeLabels),
+		AlertsDroppedCount: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "falcon_alerts_dropped_count",
+			Help: "Total number of alerts dropped because the queue of the alert backend is full",
+		}, alertsDroppedCountLabels),
 	}
 }
 
@@ -218,6 +415,10 @@ func StartMetricsServer(ctx context.Context, log logger.Logger, metricsListenAdd
 	// serve prometheus metrics
 	mux.Handle("/metrics", promhttp.Handler())
 
+	// serve liveness and readiness probes
+	mux.Handle("/healthz", relayerhealth.DefaultRegistry().LivenessHandler())
+	mux.Handle("/readyz", relayerhealth.DefaultRegistry().ReadinessHandler())
+
 	srv := &http.Server{
 		Handler:  mux,
 		ErrorLog: log.ToStdLog(),
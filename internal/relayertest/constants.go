@@ -4,6 +4,7 @@ import (
 	_ "embed"
 	"time"
 
+	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/band"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/chains/evm"
@@ -14,6 +15,11 @@ import (
 //go:embed testdata/default_config.toml
 var DefaultCfgText string
 
+// LegacyCfgText is the default config text written before the fields with defaults were added.
+//
+//go:embed testdata/legacy_config.toml
+var LegacyCfgText string
+
 //go:embed testdata/custom_config.toml
 var CustomCfgText string
 
@@ -24,13 +30,22 @@ var CustomCfg = config.Config{
 	Global: config.GlobalConfig{
 		CheckingPacketInterval: 1 * time.Minute,
 		SyncTunnelsInterval:    5 * time.Minute,
-		PenaltySkipRounds:      3,
+		CatchUpWindow:          time.Hour,
+		SigningMaxWait:         2 * time.Minute,
+		ShutdownGracePeriod:    30 * time.Second,
+		MaxConcurrentRelays:    100,
 		LogLevel:               "info",
 	},
 	BandChain: band.Config{
 		RpcEndpoints:               []string{"http://localhost:26657", "http://localhost:26658"},
 		Timeout:                    3 * time.Second,
 		LivelinessCheckingInterval: 5 * time.Minute,
+		TunnelsRefreshInterval:     time.Minute,
+		PacketCacheSize:            1000,
+		VerifySignatures:           true,
+	},
+	Alert: alert.Config{
+		Timeout: 5 * time.Second,
 	},
 	TargetChains: config.ChainProviderConfigs{
 		"testnet": &evm.EVMChainProviderConfig{
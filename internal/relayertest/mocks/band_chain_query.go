@@ -43,6 +43,46 @@ func (m *MockQueryClient) EXPECT() *MockQueryClientMockRecorder {
 	return m.recorder
 }
 
+// CurrentGroup mocks base method.
+func (m *MockQueryClient) CurrentGroup(ctx context.Context, in *bandtss.QueryCurrentGroupRequest, opts ...grpc.CallOption) (*bandtss.QueryCurrentGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CurrentGroup", varargs...)
+	ret0, _ := ret[0].(*bandtss.QueryCurrentGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentGroup indicates an expected call of CurrentGroup.
+func (mr *MockQueryClientMockRecorder) CurrentGroup(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentGroup", reflect.TypeOf((*MockQueryClient)(nil).CurrentGroup), varargs...)
+}
+
+// IncomingGroup mocks base method.
+func (m *MockQueryClient) IncomingGroup(ctx context.Context, in *bandtss.QueryIncomingGroupRequest, opts ...grpc.CallOption) (*bandtss.QueryIncomingGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IncomingGroup", varargs...)
+	ret0, _ := ret[0].(*bandtss.QueryIncomingGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncomingGroup indicates an expected call of IncomingGroup.
+func (mr *MockQueryClientMockRecorder) IncomingGroup(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncomingGroup", reflect.TypeOf((*MockQueryClient)(nil).IncomingGroup), varargs...)
+}
+
 // Packet mocks base method.
 func (m *MockQueryClient) Packet(ctx context.Context, in *tunnel.QueryPacketRequest, opts ...grpc.CallOption) (*tunnel.QueryPacketResponse, error) {
 	m.ctrl.T.Helper()
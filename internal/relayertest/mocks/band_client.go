@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	relayerhealth "github.com/bandprotocol/falcon/internal/relayerhealth"
 	subscriber "github.com/bandprotocol/falcon/relayer/band/subscriber"
 	types "github.com/bandprotocol/falcon/relayer/band/types"
 	gomock "go.uber.org/mock/gomock"
@@ -42,6 +43,64 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// GetCurrentGroup mocks base method.
+func (m *MockClient) GetCurrentGroup(ctx context.Context) (*types.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentGroup", ctx)
+	ret0, _ := ret[0].(*types.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentGroup indicates an expected call of GetCurrentGroup.
+func (mr *MockClientMockRecorder) GetCurrentGroup(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentGroup", reflect.TypeOf((*MockClient)(nil).GetCurrentGroup), ctx)
+}
+
+// GetHealth mocks base method.
+func (m *MockClient) GetHealth() relayerhealth.ComponentHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth")
+	ret0, _ := ret[0].(relayerhealth.ComponentHealth)
+	return ret0
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockClientMockRecorder) GetHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockClient)(nil).GetHealth))
+}
+
+// GetIncomingGroup mocks base method.
+func (m *MockClient) GetIncomingGroup(ctx context.Context) (*types.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncomingGroup", ctx)
+	ret0, _ := ret[0].(*types.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncomingGroup indicates an expected call of GetIncomingGroup.
+func (mr *MockClientMockRecorder) GetIncomingGroup(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncomingGroup", reflect.TypeOf((*MockClient)(nil).GetIncomingGroup), ctx)
+}
+
+// GetSubscriptionHealth mocks base method.
+func (m *MockClient) GetSubscriptionHealth() relayerhealth.ComponentHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionHealth")
+	ret0, _ := ret[0].(relayerhealth.ComponentHealth)
+	return ret0
+}
+
+// GetSubscriptionHealth indicates an expected call of GetSubscriptionHealth.
+func (mr *MockClientMockRecorder) GetSubscriptionHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionHealth", reflect.TypeOf((*MockClient)(nil).GetSubscriptionHealth))
+}
+
 // GetTunnel mocks base method.
 func (m *MockClient) GetTunnel(ctx context.Context, tunnelID uint64) (*types.Tunnel, error) {
 	m.ctrl.T.Helper()
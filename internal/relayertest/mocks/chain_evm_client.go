@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	relayerhealth "github.com/bandprotocol/falcon/internal/relayerhealth"
 	evm "github.com/bandprotocol/falcon/relayer/chains/evm"
 	ethereum "github.com/ethereum/go-ethereum"
 	common "github.com/ethereum/go-ethereum/common"
@@ -149,6 +150,21 @@ func (mr *MockEVMClientMockRecorder) EstimateGasTipCap(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGasTipCap", reflect.TypeOf((*MockEVMClient)(nil).EstimateGasTipCap), ctx)
 }
 
+// FeeHistory mocks base method.
+func (m *MockEVMClient) FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, blockCount, rewardPercentiles)
+	ret0, _ := ret[0].(*ethereum.FeeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeeHistory indicates an expected call of FeeHistory.
+func (mr *MockEVMClientMockRecorder) FeeHistory(ctx, blockCount, rewardPercentiles any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockEVMClient)(nil).FeeHistory), ctx, blockCount, rewardPercentiles)
+}
+
 // GetBalance mocks base method.
 func (m *MockEVMClient) GetBalance(ctx context.Context, gethAddr common.Address, blockNumber *big.Int) (*big.Int, error) {
 	m.ctrl.T.Helper()
@@ -194,6 +210,36 @@ func (mr *MockEVMClientMockRecorder) GetHeaderBlock(ctx, height any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeaderBlock", reflect.TypeOf((*MockEVMClient)(nil).GetHeaderBlock), ctx, height)
 }
 
+// GetHealth mocks base method.
+func (m *MockEVMClient) GetHealth() relayerhealth.ComponentHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth")
+	ret0, _ := ret[0].(relayerhealth.ComponentHealth)
+	return ret0
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockEVMClientMockRecorder) GetHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockEVMClient)(nil).GetHealth))
+}
+
+// GetTxByHash mocks base method.
+func (m *MockEVMClient) GetTxByHash(ctx context.Context, txHash string) (*types.Transaction, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxByHash", ctx, txHash)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTxByHash indicates an expected call of GetTxByHash.
+func (mr *MockEVMClientMockRecorder) GetTxByHash(ctx, txHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxByHash", reflect.TypeOf((*MockEVMClient)(nil).GetTxByHash), ctx, txHash)
+}
+
 // GetTxReceipt mocks base method.
 func (m *MockEVMClient) GetTxReceipt(ctx context.Context, txHash string) (*evm.TxReceipt, error) {
 	m.ctrl.T.Helper()
@@ -224,6 +270,21 @@ func (mr *MockEVMClientMockRecorder) NonceAt(ctx, address any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonceAt", reflect.TypeOf((*MockEVMClient)(nil).NonceAt), ctx, address)
 }
 
+// PendingNonceAt mocks base method.
+func (m *MockEVMClient) PendingNonceAt(ctx context.Context, address common.Address) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingNonceAt", ctx, address)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingNonceAt indicates an expected call of PendingNonceAt.
+func (mr *MockEVMClientMockRecorder) PendingNonceAt(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingNonceAt", reflect.TypeOf((*MockEVMClient)(nil).PendingNonceAt), ctx, address)
+}
+
 // Query mocks base method.
 func (m *MockEVMClient) Query(ctx context.Context, gethAddr common.Address, data []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
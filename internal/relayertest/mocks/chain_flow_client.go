@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	relayerhealth "github.com/bandprotocol/falcon/internal/relayerhealth"
 	flow "github.com/onflow/flow-go-sdk"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -131,6 +132,20 @@ func (mr *MockFlowClientMockRecorder) GetBlockTimestamp(ctx, txHash any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockTimestamp", reflect.TypeOf((*MockFlowClient)(nil).GetBlockTimestamp), ctx, txHash)
 }
 
+// GetHealth mocks base method.
+func (m *MockFlowClient) GetHealth() relayerhealth.ComponentHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth")
+	ret0, _ := ret[0].(relayerhealth.ComponentHealth)
+	return ret0
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockFlowClientMockRecorder) GetHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockFlowClient)(nil).GetHealth))
+}
+
 // GetLatestBlockID mocks base method.
 func (m *MockFlowClient) GetLatestBlockID(ctx context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -14,7 +14,9 @@ import (
 	big "math/big"
 	reflect "reflect"
 
+	relayerhealth "github.com/bandprotocol/falcon/internal/relayerhealth"
 	types "github.com/bandprotocol/falcon/relayer/band/types"
+	chains "github.com/bandprotocol/falcon/relayer/chains"
 	types0 "github.com/bandprotocol/falcon/relayer/chains/types"
 	db "github.com/bandprotocol/falcon/relayer/db"
 	wallet "github.com/bandprotocol/falcon/relayer/wallet"
@@ -73,6 +75,34 @@ func (mr *MockChainProviderMockRecorder) GetChainName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainName", reflect.TypeOf((*MockChainProvider)(nil).GetChainName))
 }
 
+// GetFreeSigners mocks base method.
+func (m *MockChainProvider) GetFreeSigners() chan wallet.Signer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreeSigners")
+	ret0, _ := ret[0].(chan wallet.Signer)
+	return ret0
+}
+
+// GetFreeSigners indicates an expected call of GetFreeSigners.
+func (mr *MockChainProviderMockRecorder) GetFreeSigners() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreeSigners", reflect.TypeOf((*MockChainProvider)(nil).GetFreeSigners))
+}
+
+// GetHealth mocks base method.
+func (m *MockChainProvider) GetHealth() relayerhealth.ComponentHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth")
+	ret0, _ := ret[0].(relayerhealth.ComponentHealth)
+	return ret0
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockChainProviderMockRecorder) GetHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockChainProvider)(nil).GetHealth))
+}
+
 // GetWallet mocks base method.
 func (m *MockChainProvider) GetWallet() wallet.Wallet {
 	m.ctrl.T.Helper()
@@ -132,11 +162,12 @@ func (mr *MockChainProviderMockRecorder) QueryTunnelInfo(ctx, tunnelID, tunnelDe
 }
 
 // RelayPacket mocks base method.
-func (m *MockChainProvider) RelayPacket(ctx context.Context, packet *types.Packet) error {
+func (m *MockChainProvider) RelayPacket(ctx context.Context, packet *types.Packet) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RelayPacket", ctx, packet)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RelayPacket indicates an expected call of RelayPacket.
@@ -156,3 +187,363 @@ func (mr *MockChainProviderMockRecorder) SetDatabase(database any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDatabase", reflect.TypeOf((*MockChainProvider)(nil).SetDatabase), database)
 }
+
+// MockSignerFunder is a mock of SignerFunder interface.
+type MockSignerFunder struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignerFunderMockRecorder
+	isgomock struct{}
+}
+
+// MockSignerFunderMockRecorder is the mock recorder for MockSignerFunder.
+type MockSignerFunderMockRecorder struct {
+	mock *MockSignerFunder
+}
+
+// NewMockSignerFunder creates a new mock instance.
+func NewMockSignerFunder(ctrl *gomock.Controller) *MockSignerFunder {
+	mock := &MockSignerFunder{ctrl: ctrl}
+	mock.recorder = &MockSignerFunderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignerFunder) EXPECT() *MockSignerFunderMockRecorder {
+	return m.recorder
+}
+
+// ChainType mocks base method.
+func (m *MockSignerFunder) ChainType() types0.ChainType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainType")
+	ret0, _ := ret[0].(types0.ChainType)
+	return ret0
+}
+
+// ChainType indicates an expected call of ChainType.
+func (mr *MockSignerFunderMockRecorder) ChainType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainType", reflect.TypeOf((*MockSignerFunder)(nil).ChainType))
+}
+
+// FundSigner mocks base method.
+func (m *MockSignerFunder) FundSigner(ctx context.Context, address string, amount *big.Int) (chains.FundingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundSigner", ctx, address, amount)
+	ret0, _ := ret[0].(chains.FundingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FundSigner indicates an expected call of FundSigner.
+func (mr *MockSignerFunderMockRecorder) FundSigner(ctx, address, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundSigner", reflect.TypeOf((*MockSignerFunder)(nil).FundSigner), ctx, address, amount)
+}
+
+// GetChainName mocks base method.
+func (m *MockSignerFunder) GetChainName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChainName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetChainName indicates an expected call of GetChainName.
+func (mr *MockSignerFunderMockRecorder) GetChainName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainName", reflect.TypeOf((*MockSignerFunder)(nil).GetChainName))
+}
+
+// GetFreeSigners mocks base method.
+func (m *MockSignerFunder) GetFreeSigners() chan wallet.Signer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreeSigners")
+	ret0, _ := ret[0].(chan wallet.Signer)
+	return ret0
+}
+
+// GetFreeSigners indicates an expected call of GetFreeSigners.
+func (mr *MockSignerFunderMockRecorder) GetFreeSigners() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreeSigners", reflect.TypeOf((*MockSignerFunder)(nil).GetFreeSigners))
+}
+
+// GetHealth mocks base method.
+func (m *MockSignerFunder) GetHealth() relayerhealth.ComponentHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth")
+	ret0, _ := ret[0].(relayerhealth.ComponentHealth)
+	return ret0
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockSignerFunderMockRecorder) GetHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockSignerFunder)(nil).GetHealth))
+}
+
+// GetWallet mocks base method.
+func (m *MockSignerFunder) GetWallet() wallet.Wallet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWallet")
+	ret0, _ := ret[0].(wallet.Wallet)
+	return ret0
+}
+
+// GetWallet indicates an expected call of GetWallet.
+func (mr *MockSignerFunderMockRecorder) GetWallet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockSignerFunder)(nil).GetWallet))
+}
+
+// Init mocks base method.
+func (m *MockSignerFunder) Init(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Init", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockSignerFunderMockRecorder) Init(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockSignerFunder)(nil).Init), ctx)
+}
+
+// QueryBalance mocks base method.
+func (m *MockSignerFunder) QueryBalance(ctx context.Context, address string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryBalance", ctx, address)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryBalance indicates an expected call of QueryBalance.
+func (mr *MockSignerFunderMockRecorder) QueryBalance(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBalance", reflect.TypeOf((*MockSignerFunder)(nil).QueryBalance), ctx, address)
+}
+
+// QueryTunnelInfo mocks base method.
+func (m *MockSignerFunder) QueryTunnelInfo(ctx context.Context, tunnelID uint64, tunnelDestinationAddr string) (*types0.Tunnel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTunnelInfo", ctx, tunnelID, tunnelDestinationAddr)
+	ret0, _ := ret[0].(*types0.Tunnel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTunnelInfo indicates an expected call of QueryTunnelInfo.
+func (mr *MockSignerFunderMockRecorder) QueryTunnelInfo(ctx, tunnelID, tunnelDestinationAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTunnelInfo", reflect.TypeOf((*MockSignerFunder)(nil).QueryTunnelInfo), ctx, tunnelID, tunnelDestinationAddr)
+}
+
+// RelayPacket mocks base method.
+func (m *MockSignerFunder) RelayPacket(ctx context.Context, packet *types.Packet) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayPacket", ctx, packet)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayPacket indicates an expected call of RelayPacket.
+func (mr *MockSignerFunderMockRecorder) RelayPacket(ctx, packet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayPacket", reflect.TypeOf((*MockSignerFunder)(nil).RelayPacket), ctx, packet)
+}
+
+// SetDatabase mocks base method.
+func (m *MockSignerFunder) SetDatabase(database db.Database) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDatabase", database)
+}
+
+// SetDatabase indicates an expected call of SetDatabase.
+func (mr *MockSignerFunderMockRecorder) SetDatabase(database any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDatabase", reflect.TypeOf((*MockSignerFunder)(nil).SetDatabase), database)
+}
+
+// MockSignerSweeper is a mock of SignerSweeper interface.
+type MockSignerSweeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignerSweeperMockRecorder
+	isgomock struct{}
+}
+
+// MockSignerSweeperMockRecorder is the mock recorder for MockSignerSweeper.
+type MockSignerSweeperMockRecorder struct {
+	mock *MockSignerSweeper
+}
+
+// NewMockSignerSweeper creates a new mock instance.
+func NewMockSignerSweeper(ctrl *gomock.Controller) *MockSignerSweeper {
+	mock := &MockSignerSweeper{ctrl: ctrl}
+	mock.recorder = &MockSignerSweeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSignerSweeper) EXPECT() *MockSignerSweeperMockRecorder {
+	return m.recorder
+}
+
+// ChainType mocks base method.
+func (m *MockSignerSweeper) ChainType() types0.ChainType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainType")
+	ret0, _ := ret[0].(types0.ChainType)
+	return ret0
+}
+
+// ChainType indicates an expected call of ChainType.
+func (mr *MockSignerSweeperMockRecorder) ChainType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainType", reflect.TypeOf((*MockSignerSweeper)(nil).ChainType))
+}
+
+// GetChainName mocks base method.
+func (m *MockSignerSweeper) GetChainName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChainName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetChainName indicates an expected call of GetChainName.
+func (mr *MockSignerSweeperMockRecorder) GetChainName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChainName", reflect.TypeOf((*MockSignerSweeper)(nil).GetChainName))
+}
+
+// GetFreeSigners mocks base method.
+func (m *MockSignerSweeper) GetFreeSigners() chan wallet.Signer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreeSigners")
+	ret0, _ := ret[0].(chan wallet.Signer)
+	return ret0
+}
+
+// GetFreeSigners indicates an expected call of GetFreeSigners.
+func (mr *MockSignerSweeperMockRecorder) GetFreeSigners() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreeSigners", reflect.TypeOf((*MockSignerSweeper)(nil).GetFreeSigners))
+}
+
+// GetHealth mocks base method.
+func (m *MockSignerSweeper) GetHealth() relayerhealth.ComponentHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth")
+	ret0, _ := ret[0].(relayerhealth.ComponentHealth)
+	return ret0
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockSignerSweeperMockRecorder) GetHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockSignerSweeper)(nil).GetHealth))
+}
+
+// GetWallet mocks base method.
+func (m *MockSignerSweeper) GetWallet() wallet.Wallet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWallet")
+	ret0, _ := ret[0].(wallet.Wallet)
+	return ret0
+}
+
+// GetWallet indicates an expected call of GetWallet.
+func (mr *MockSignerSweeperMockRecorder) GetWallet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWallet", reflect.TypeOf((*MockSignerSweeper)(nil).GetWallet))
+}
+
+// Init mocks base method.
+func (m *MockSignerSweeper) Init(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Init", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockSignerSweeperMockRecorder) Init(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockSignerSweeper)(nil).Init), ctx)
+}
+
+// QueryBalance mocks base method.
+func (m *MockSignerSweeper) QueryBalance(ctx context.Context, address string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryBalance", ctx, address)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryBalance indicates an expected call of QueryBalance.
+func (mr *MockSignerSweeperMockRecorder) QueryBalance(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBalance", reflect.TypeOf((*MockSignerSweeper)(nil).QueryBalance), ctx, address)
+}
+
+// QueryTunnelInfo mocks base method.
+func (m *MockSignerSweeper) QueryTunnelInfo(ctx context.Context, tunnelID uint64, tunnelDestinationAddr string) (*types0.Tunnel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTunnelInfo", ctx, tunnelID, tunnelDestinationAddr)
+	ret0, _ := ret[0].(*types0.Tunnel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTunnelInfo indicates an expected call of QueryTunnelInfo.
+func (mr *MockSignerSweeperMockRecorder) QueryTunnelInfo(ctx, tunnelID, tunnelDestinationAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTunnelInfo", reflect.TypeOf((*MockSignerSweeper)(nil).QueryTunnelInfo), ctx, tunnelID, tunnelDestinationAddr)
+}
+
+// RelayPacket mocks base method.
+func (m *MockSignerSweeper) RelayPacket(ctx context.Context, packet *types.Packet) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayPacket", ctx, packet)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayPacket indicates an expected call of RelayPacket.
+func (mr *MockSignerSweeperMockRecorder) RelayPacket(ctx, packet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayPacket", reflect.TypeOf((*MockSignerSweeper)(nil).RelayPacket), ctx, packet)
+}
+
+// SetDatabase mocks base method.
+func (m *MockSignerSweeper) SetDatabase(database db.Database) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDatabase", database)
+}
+
+// SetDatabase indicates an expected call of SetDatabase.
+func (mr *MockSignerSweeperMockRecorder) SetDatabase(database any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDatabase", reflect.TypeOf((*MockSignerSweeper)(nil).SetDatabase), database)
+}
+
+// SweepSigner mocks base method.
+func (m *MockSignerSweeper) SweepSigner(ctx context.Context, signer wallet.Signer, destination string, dryRun bool) (chains.SweepResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepSigner", ctx, signer, destination, dryRun)
+	ret0, _ := ret[0].(chains.SweepResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SweepSigner indicates an expected call of SweepSigner.
+func (mr *MockSignerSweeperMockRecorder) SweepSigner(ctx, signer, destination, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepSigner", reflect.TypeOf((*MockSignerSweeper)(nil).SweepSigner), ctx, signer, destination, dryRun)
+}
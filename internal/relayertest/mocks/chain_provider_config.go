@@ -44,6 +44,20 @@ func (m *MockChainProviderConfig) EXPECT() *MockChainProviderConfigMockRecorder
 	return m.recorder
 }
 
+// GetBaseConfig mocks base method.
+func (m *MockChainProviderConfig) GetBaseConfig() chains.BaseChainProviderConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseConfig")
+	ret0, _ := ret[0].(chains.BaseChainProviderConfig)
+	return ret0
+}
+
+// GetBaseConfig indicates an expected call of GetBaseConfig.
+func (mr *MockChainProviderConfigMockRecorder) GetBaseConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseConfig", reflect.TypeOf((*MockChainProviderConfig)(nil).GetBaseConfig))
+}
+
 // GetChainType mocks base method.
 func (m *MockChainProviderConfig) GetChainType() types.ChainType {
 	m.ctrl.T.Helper()
@@ -59,16 +73,16 @@ func (mr *MockChainProviderConfigMockRecorder) GetChainType() *gomock.Call {
 }
 
 // NewChainProvider mocks base method.
-func (m *MockChainProviderConfig) NewChainProvider(chainName string, log logger.Logger, arg2 wallet.Wallet, arg3 alert.Alert) (chains.ChainProvider, error) {
+func (m *MockChainProviderConfig) NewChainProvider(chainName string, log logger.Logger, arg2 wallet.Wallet, arg3 alert.Alert, signingVerifier chains.SigningVerifier) (chains.ChainProvider, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewChainProvider", chainName, log, arg2, arg3)
+	ret := m.ctrl.Call(m, "NewChainProvider", chainName, log, arg2, arg3, signingVerifier)
 	ret0, _ := ret[0].(chains.ChainProvider)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewChainProvider indicates an expected call of NewChainProvider.
-func (mr *MockChainProviderConfigMockRecorder) NewChainProvider(chainName, log, arg2, arg3 any) *gomock.Call {
+func (mr *MockChainProviderConfigMockRecorder) NewChainProvider(chainName, log, arg2, arg3, signingVerifier any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChainProvider", reflect.TypeOf((*MockChainProviderConfig)(nil).NewChainProvider), chainName, log, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewChainProvider", reflect.TypeOf((*MockChainProviderConfig)(nil).NewChainProvider), chainName, log, arg2, arg3, signingVerifier)
 }
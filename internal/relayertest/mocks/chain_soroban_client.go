@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	relayerhealth "github.com/bandprotocol/falcon/internal/relayerhealth"
 	soroban "github.com/bandprotocol/falcon/relayer/chains/soroban"
 	horizon "github.com/stellar/go-stellar-sdk/protocols/horizon"
 	gomock "go.uber.org/mock/gomock"
@@ -132,6 +133,20 @@ func (mr *MockSorobanClientMockRecorder) GetFeeStats() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeStats", reflect.TypeOf((*MockSorobanClient)(nil).GetFeeStats))
 }
 
+// GetHealth mocks base method.
+func (m *MockSorobanClient) GetHealth() relayerhealth.ComponentHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth")
+	ret0, _ := ret[0].(relayerhealth.ComponentHealth)
+	return ret0
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockSorobanClientMockRecorder) GetHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockSorobanClient)(nil).GetHealth))
+}
+
 // GetLedgerCloseTime mocks base method.
 func (m *MockSorobanClient) GetLedgerCloseTime(ledgerIndex uint64) (*time.Time, error) {
 	m.ctrl.T.Helper()
@@ -17,6 +17,7 @@ import (
 
 	common "github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	transaction "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	relayerhealth "github.com/bandprotocol/falcon/internal/relayerhealth"
 	xrpl "github.com/bandprotocol/falcon/relayer/chains/xrpl"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -102,6 +103,21 @@ func (mr *MockXRPLClientMockRecorder) Connect(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockXRPLClient)(nil).Connect), ctx)
 }
 
+// GetAccountReserve mocks base method.
+func (m *MockXRPLClient) GetAccountReserve(account string) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountReserve", account)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountReserve indicates an expected call of GetAccountReserve.
+func (mr *MockXRPLClientMockRecorder) GetAccountReserve(account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountReserve", reflect.TypeOf((*MockXRPLClient)(nil).GetAccountReserve), account)
+}
+
 // GetAccountSequenceNumber mocks base method.
 func (m *MockXRPLClient) GetAccountSequenceNumber(account string) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +148,20 @@ func (mr *MockXRPLClientMockRecorder) GetBalance(account any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockXRPLClient)(nil).GetBalance), account)
 }
 
+// GetHealth mocks base method.
+func (m *MockXRPLClient) GetHealth() relayerhealth.ComponentHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth")
+	ret0, _ := ret[0].(relayerhealth.ComponentHealth)
+	return ret0
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockXRPLClientMockRecorder) GetHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockXRPLClient)(nil).GetHealth))
+}
+
 // GetLedgerCloseTime mocks base method.
 func (m *MockXRPLClient) GetLedgerCloseTime(ledgerIndex common.LedgerIndex) (*time.Time, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +182,26 @@ func (mr *MockFkmsServiceClientMockRecorder) SignXrpl(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignXrpl", reflect.TypeOf((*MockFkmsServiceClient)(nil).SignXrpl), varargs...)
 }
 
+// SignXrplPayment mocks base method.
+func (m *MockFkmsServiceClient) SignXrplPayment(ctx context.Context, in *fkmsv1.SignXrplPaymentRequest, opts ...grpc.CallOption) (*fkmsv1.SignXrplPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignXrplPayment", varargs...)
+	ret0, _ := ret[0].(*fkmsv1.SignXrplPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignXrplPayment indicates an expected call of SignXrplPayment.
+func (mr *MockFkmsServiceClientMockRecorder) SignXrplPayment(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignXrplPayment", reflect.TypeOf((*MockFkmsServiceClient)(nil).SignXrplPayment), varargs...)
+}
+
 // MockFkmsServiceServer is a mock of FkmsServiceServer interface.
 type MockFkmsServiceServer struct {
 	ctrl     *gomock.Controller
@@ -311,6 +331,21 @@ func (mr *MockFkmsServiceServerMockRecorder) SignXrpl(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignXrpl", reflect.TypeOf((*MockFkmsServiceServer)(nil).SignXrpl), arg0, arg1)
 }
 
+// SignXrplPayment mocks base method.
+func (m *MockFkmsServiceServer) SignXrplPayment(arg0 context.Context, arg1 *fkmsv1.SignXrplPaymentRequest) (*fkmsv1.SignXrplPaymentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignXrplPayment", arg0, arg1)
+	ret0, _ := ret[0].(*fkmsv1.SignXrplPaymentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignXrplPayment indicates an expected call of SignXrplPayment.
+func (mr *MockFkmsServiceServerMockRecorder) SignXrplPayment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignXrplPayment", reflect.TypeOf((*MockFkmsServiceServer)(nil).SignXrplPayment), arg0, arg1)
+}
+
 // mustEmbedUnimplementedFkmsServiceServer mocks base method.
 func (m *MockFkmsServiceServer) mustEmbedUnimplementedFkmsServiceServer() {
 	m.ctrl.T.Helper()
@@ -14,6 +14,7 @@ import (
 
 	types "github.com/bandprotocol/falcon/relayer/chains/types"
 	config "github.com/bandprotocol/falcon/relayer/config"
+	db "github.com/bandprotocol/falcon/relayer/db"
 	wallet "github.com/bandprotocol/falcon/relayer/wallet"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -72,6 +73,21 @@ func (mr *MockStoreMockRecorder) GetHashedPassphrase() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHashedPassphrase", reflect.TypeOf((*MockStore)(nil).GetHashedPassphrase))
 }
 
+// GetRelayCheckpoint mocks base method.
+func (m *MockStore) GetRelayCheckpoint(tunnelID uint64) (*db.RelayCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelayCheckpoint", tunnelID)
+	ret0, _ := ret[0].(*db.RelayCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelayCheckpoint indicates an expected call of GetRelayCheckpoint.
+func (mr *MockStoreMockRecorder) GetRelayCheckpoint(tunnelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelayCheckpoint", reflect.TypeOf((*MockStore)(nil).GetRelayCheckpoint), tunnelID)
+}
+
 // HasConfig mocks base method.
 func (m *MockStore) HasConfig() (bool, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +146,20 @@ func (mr *MockStoreMockRecorder) SavePassphrase(passphrase any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePassphrase", reflect.TypeOf((*MockStore)(nil).SavePassphrase), passphrase)
 }
 
+// SaveRelayCheckpoint mocks base method.
+func (m *MockStore) SaveRelayCheckpoint(checkpoint *db.RelayCheckpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRelayCheckpoint", checkpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRelayCheckpoint indicates an expected call of SaveRelayCheckpoint.
+func (mr *MockStoreMockRecorder) SaveRelayCheckpoint(checkpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRelayCheckpoint", reflect.TypeOf((*MockStore)(nil).SaveRelayCheckpoint), checkpoint)
+}
+
 // ValidatePassphrase mocks base method.
 func (m *MockStore) ValidatePassphrase(passphrase string) error {
 	m.ctrl.T.Helper()
@@ -271,6 +271,94 @@ func (x *SignXrplResponse) GetTxBlob() []byte {
 	return nil
 }
 
+type SignXrplPaymentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentPayload *XrplPaymentPayload    `protobuf:"bytes,1,opt,name=payment_payload,json=paymentPayload,proto3" json:"payment_payload,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SignXrplPaymentRequest) Reset() {
+	*x = SignXrplPaymentRequest{}
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignXrplPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignXrplPaymentRequest) ProtoMessage() {}
+
+func (x *SignXrplPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignXrplPaymentRequest.ProtoReflect.Descriptor instead.
+func (*SignXrplPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SignXrplPaymentRequest) GetPaymentPayload() *XrplPaymentPayload {
+	if x != nil {
+		return x.PaymentPayload
+	}
+	return nil
+}
+
+type SignXrplPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxBlob        []byte                 `protobuf:"bytes,1,opt,name=tx_blob,json=txBlob,proto3" json:"tx_blob,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignXrplPaymentResponse) Reset() {
+	*x = SignXrplPaymentResponse{}
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignXrplPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignXrplPaymentResponse) ProtoMessage() {}
+
+func (x *SignXrplPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignXrplPaymentResponse.ProtoReflect.Descriptor instead.
+func (*SignXrplPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SignXrplPaymentResponse) GetTxBlob() []byte {
+	if x != nil {
+		return x.TxBlob
+	}
+	return nil
+}
+
 type SignIconRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SignerPayload *IconSignerPayload     `protobuf:"bytes,1,opt,name=signer_payload,json=signerPayload,proto3" json:"signer_payload,omitempty"`
@@ -281,7 +369,7 @@ type SignIconRequest struct {
 
 func (x *SignIconRequest) Reset() {
 	*x = SignIconRequest{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[4]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -293,7 +381,7 @@ func (x *SignIconRequest) String() string {
 func (*SignIconRequest) ProtoMessage() {}
 
 func (x *SignIconRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[4]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +394,7 @@ func (x *SignIconRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignIconRequest.ProtoReflect.Descriptor instead.
 func (*SignIconRequest) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{4}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SignIconRequest) GetSignerPayload() *IconSignerPayload {
@@ -332,7 +420,7 @@ type SignIconResponse struct {
 
 func (x *SignIconResponse) Reset() {
 	*x = SignIconResponse{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[5]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -344,7 +432,7 @@ func (x *SignIconResponse) String() string {
 func (*SignIconResponse) ProtoMessage() {}
 
 func (x *SignIconResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[5]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +445,7 @@ func (x *SignIconResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignIconResponse.ProtoReflect.Descriptor instead.
 func (*SignIconResponse) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{5}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SignIconResponse) GetTxParams() []byte {
@@ -377,7 +465,7 @@ type SignFlowRequest struct {
 
 func (x *SignFlowRequest) Reset() {
 	*x = SignFlowRequest{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[6]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +477,7 @@ func (x *SignFlowRequest) String() string {
 func (*SignFlowRequest) ProtoMessage() {}
 
 func (x *SignFlowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[6]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +490,7 @@ func (x *SignFlowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignFlowRequest.ProtoReflect.Descriptor instead.
 func (*SignFlowRequest) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{6}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SignFlowRequest) GetSignerPayload() *FlowSignerPayload {
@@ -428,7 +516,7 @@ type SignFlowResponse struct {
 
 func (x *SignFlowResponse) Reset() {
 	*x = SignFlowResponse{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[7]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -440,7 +528,7 @@ func (x *SignFlowResponse) String() string {
 func (*SignFlowResponse) ProtoMessage() {}
 
 func (x *SignFlowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[7]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +541,7 @@ func (x *SignFlowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignFlowResponse.ProtoReflect.Descriptor instead.
 func (*SignFlowResponse) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{7}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SignFlowResponse) GetTxBlob() []byte {
@@ -473,7 +561,7 @@ type SignSorobanRequest struct {
 
 func (x *SignSorobanRequest) Reset() {
 	*x = SignSorobanRequest{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[8]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +573,7 @@ func (x *SignSorobanRequest) String() string {
 func (*SignSorobanRequest) ProtoMessage() {}
 
 func (x *SignSorobanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[8]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +586,7 @@ func (x *SignSorobanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignSorobanRequest.ProtoReflect.Descriptor instead.
 func (*SignSorobanRequest) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{8}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SignSorobanRequest) GetSignerPayload() *SorobanSignerPayload {
@@ -524,7 +612,7 @@ type SignSorobanResponse struct {
 
 func (x *SignSorobanResponse) Reset() {
 	*x = SignSorobanResponse{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[9]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +624,7 @@ func (x *SignSorobanResponse) String() string {
 func (*SignSorobanResponse) ProtoMessage() {}
 
 func (x *SignSorobanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[9]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +637,7 @@ func (x *SignSorobanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignSorobanResponse.ProtoReflect.Descriptor instead.
 func (*SignSorobanResponse) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{9}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SignSorobanResponse) GetTxBlob() string {
@@ -569,7 +657,7 @@ type SignSecretRequest struct {
 
 func (x *SignSecretRequest) Reset() {
 	*x = SignSecretRequest{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[10]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +669,7 @@ func (x *SignSecretRequest) String() string {
 func (*SignSecretRequest) ProtoMessage() {}
 
 func (x *SignSecretRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[10]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +682,7 @@ func (x *SignSecretRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignSecretRequest.ProtoReflect.Descriptor instead.
 func (*SignSecretRequest) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{10}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SignSecretRequest) GetSignerPayload() *SecretSignerPayload {
@@ -620,7 +708,7 @@ type SignSecretResponse struct {
 
 func (x *SignSecretResponse) Reset() {
 	*x = SignSecretResponse{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[11]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -632,7 +720,7 @@ func (x *SignSecretResponse) String() string {
 func (*SignSecretResponse) ProtoMessage() {}
 
 func (x *SignSecretResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[11]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +733,7 @@ func (x *SignSecretResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignSecretResponse.ProtoReflect.Descriptor instead.
 func (*SignSecretResponse) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{11}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SignSecretResponse) GetTxBlob() []byte {
@@ -663,7 +751,7 @@ type GetSignerAddressesRequest struct {
 
 func (x *GetSignerAddressesRequest) Reset() {
 	*x = GetSignerAddressesRequest{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[12]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +763,7 @@ func (x *GetSignerAddressesRequest) String() string {
 func (*GetSignerAddressesRequest) ProtoMessage() {}
 
 func (x *GetSignerAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[12]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +776,7 @@ func (x *GetSignerAddressesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSignerAddressesRequest.ProtoReflect.Descriptor instead.
 func (*GetSignerAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{12}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{14}
 }
 
 type GetSignerAddressesResponse struct {
@@ -700,7 +788,7 @@ type GetSignerAddressesResponse struct {
 
 func (x *GetSignerAddressesResponse) Reset() {
 	*x = GetSignerAddressesResponse{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[13]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +800,7 @@ func (x *GetSignerAddressesResponse) String() string {
 func (*GetSignerAddressesResponse) ProtoMessage() {}
 
 func (x *GetSignerAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[13]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +813,7 @@ func (x *GetSignerAddressesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSignerAddressesResponse.ProtoReflect.Descriptor instead.
 func (*GetSignerAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{13}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetSignerAddressesResponse) GetSigners() []*Signers {
@@ -747,7 +835,7 @@ type XrplSignerPayload struct {
 
 func (x *XrplSignerPayload) Reset() {
 	*x = XrplSignerPayload{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[14]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -759,7 +847,7 @@ func (x *XrplSignerPayload) String() string {
 func (*XrplSignerPayload) ProtoMessage() {}
 
 func (x *XrplSignerPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[14]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -772,7 +860,7 @@ func (x *XrplSignerPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use XrplSignerPayload.ProtoReflect.Descriptor instead.
 func (*XrplSignerPayload) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{14}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *XrplSignerPayload) GetAccount() string {
@@ -803,6 +891,82 @@ func (x *XrplSignerPayload) GetSequence() uint64 {
 	return 0
 }
 
+type XrplPaymentPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       string                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee           string                 `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Sequence      uint64                 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XrplPaymentPayload) Reset() {
+	*x = XrplPaymentPayload{}
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XrplPaymentPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XrplPaymentPayload) ProtoMessage() {}
+
+func (x *XrplPaymentPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use XrplPaymentPayload.ProtoReflect.Descriptor instead.
+func (*XrplPaymentPayload) Descriptor() ([]byte, []int) {
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *XrplPaymentPayload) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *XrplPaymentPayload) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *XrplPaymentPayload) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *XrplPaymentPayload) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *XrplPaymentPayload) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 type IconSignerPayload struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Relayer         string                 `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
@@ -815,7 +979,7 @@ type IconSignerPayload struct {
 
 func (x *IconSignerPayload) Reset() {
 	*x = IconSignerPayload{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[15]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +991,7 @@ func (x *IconSignerPayload) String() string {
 func (*IconSignerPayload) ProtoMessage() {}
 
 func (x *IconSignerPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[15]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +1004,7 @@ func (x *IconSignerPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IconSignerPayload.ProtoReflect.Descriptor instead.
 func (*IconSignerPayload) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{15}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *IconSignerPayload) GetRelayer() string {
@@ -885,7 +1049,7 @@ type FlowSignerPayload struct {
 
 func (x *FlowSignerPayload) Reset() {
 	*x = FlowSignerPayload{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[16]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -897,7 +1061,7 @@ func (x *FlowSignerPayload) String() string {
 func (*FlowSignerPayload) ProtoMessage() {}
 
 func (x *FlowSignerPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[16]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +1074,7 @@ func (x *FlowSignerPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowSignerPayload.ProtoReflect.Descriptor instead.
 func (*FlowSignerPayload) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{16}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *FlowSignerPayload) GetAddress() string {
@@ -969,7 +1133,7 @@ type SorobanSignerPayload struct {
 
 func (x *SorobanSignerPayload) Reset() {
 	*x = SorobanSignerPayload{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[17]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -981,7 +1145,7 @@ func (x *SorobanSignerPayload) String() string {
 func (*SorobanSignerPayload) ProtoMessage() {}
 
 func (x *SorobanSignerPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[17]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,7 +1158,7 @@ func (x *SorobanSignerPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SorobanSignerPayload.ProtoReflect.Descriptor instead.
 func (*SorobanSignerPayload) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{17}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SorobanSignerPayload) GetSourceAccount() string {
@@ -1057,7 +1221,7 @@ type SecretSignerPayload struct {
 
 func (x *SecretSignerPayload) Reset() {
 	*x = SecretSignerPayload{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[18]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1069,7 +1233,7 @@ func (x *SecretSignerPayload) String() string {
 func (*SecretSignerPayload) ProtoMessage() {}
 
 func (x *SecretSignerPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[18]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,7 +1246,7 @@ func (x *SecretSignerPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretSignerPayload.ProtoReflect.Descriptor instead.
 func (*SecretSignerPayload) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{18}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SecretSignerPayload) GetSender() string {
@@ -1166,7 +1330,7 @@ type Tss struct {
 
 func (x *Tss) Reset() {
 	*x = Tss{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[19]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1178,7 +1342,7 @@ func (x *Tss) String() string {
 func (*Tss) ProtoMessage() {}
 
 func (x *Tss) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[19]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,7 +1355,7 @@ func (x *Tss) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tss.ProtoReflect.Descriptor instead.
 func (*Tss) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{19}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Tss) GetMessage() []byte {
@@ -1225,7 +1389,7 @@ type Signers struct {
 
 func (x *Signers) Reset() {
 	*x = Signers{}
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[20]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1237,7 +1401,7 @@ func (x *Signers) String() string {
 func (*Signers) ProtoMessage() {}
 
 func (x *Signers) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_fkms_v1_signer_proto_msgTypes[20]
+	mi := &file_proto_fkms_v1_signer_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1250,7 +1414,7 @@ func (x *Signers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Signers.ProtoReflect.Descriptor instead.
 func (*Signers) Descriptor() ([]byte, []int) {
-	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{20}
+	return file_proto_fkms_v1_signer_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Signers) GetChainType() ChainType {
@@ -1281,6 +1445,10 @@ const file_proto_fkms_v1_signer_proto_rawDesc = "" +
 	"\x0esigner_payload\x18\x01 \x01(\v2\x1a.fkms.v1.XrplSignerPayloadR\rsignerPayload\x12\x1e\n" +
 	"\x03tss\x18\x02 \x01(\v2\f.fkms.v1.TssR\x03tss\"+\n" +
 	"\x10SignXrplResponse\x12\x17\n" +
+	"\atx_blob\x18\x01 \x01(\fR\x06txBlob\"^\n" +
+	"\x16SignXrplPaymentRequest\x12D\n" +
+	"\x0fpayment_payload\x18\x01 \x01(\v2\x1b.fkms.v1.XrplPaymentPayloadR\x0epaymentPayload\"2\n" +
+	"\x17SignXrplPaymentResponse\x12\x17\n" +
 	"\atx_blob\x18\x01 \x01(\fR\x06txBlob\"t\n" +
 	"\x0fSignIconRequest\x12A\n" +
 	"\x0esigner_payload\x18\x01 \x01(\v2\x1a.fkms.v1.IconSignerPayloadR\rsignerPayload\x12\x1e\n" +
@@ -1310,6 +1478,12 @@ const file_proto_fkms_v1_signer_proto_rawDesc = "" +
 	"\toracle_id\x18\x02 \x01(\x04R\boracleId\x12\x10\n" +
 	"\x03fee\x18\x03 \x01(\tR\x03fee\x12\x1a\n" +
 	"\bsequence\x18\x04 \x01(\x04R\bsequence\"\x96\x01\n" +
+	"\x12XrplPaymentPayload\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\tR\aaccount\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x10\n" +
+	"\x03fee\x18\x04 \x01(\tR\x03fee\x12\x1a\n" +
+	"\bsequence\x18\x05 \x01(\x04R\bsequence\"\x96\x01\n" +
 	"\x11IconSignerPayload\x12\x18\n" +
 	"\arelayer\x18\x01 \x01(\tR\arelayer\x12)\n" +
 	"\x10contract_address\x18\x02 \x01(\tR\x0fcontractAddress\x12\x1d\n" +
@@ -1361,10 +1535,11 @@ const file_proto_fkms_v1_signer_proto_rawDesc = "" +
 	"\x04FLOW\x10\x03\x12\v\n" +
 	"\aSOROBAN\x10\x04\x12\n" +
 	"\n" +
-	"\x06SECRET\x10\x052\xfe\x03\n" +
+	"\x06SECRET\x10\x052\xd4\x04\n" +
 	"\vFkmsService\x12<\n" +
 	"\aSignEvm\x12\x17.fkms.v1.SignEvmRequest\x1a\x18.fkms.v1.SignEvmResponse\x12?\n" +
-	"\bSignXrpl\x12\x18.fkms.v1.SignXrplRequest\x1a\x19.fkms.v1.SignXrplResponse\x12?\n" +
+	"\bSignXrpl\x12\x18.fkms.v1.SignXrplRequest\x1a\x19.fkms.v1.SignXrplResponse\x12T\n" +
+	"\x0fSignXrplPayment\x12\x1f.fkms.v1.SignXrplPaymentRequest\x1a .fkms.v1.SignXrplPaymentResponse\x12?\n" +
 	"\bSignIcon\x12\x18.fkms.v1.SignIconRequest\x1a\x19.fkms.v1.SignIconResponse\x12?\n" +
 	"\bSignFlow\x12\x18.fkms.v1.SignFlowRequest\x1a\x19.fkms.v1.SignFlowResponse\x12H\n" +
 	"\vSignSoroban\x12\x1b.fkms.v1.SignSorobanRequest\x1a\x1c.fkms.v1.SignSorobanResponse\x12E\n" +
@@ -1385,63 +1560,69 @@ func file_proto_fkms_v1_signer_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_fkms_v1_signer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_fkms_v1_signer_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_fkms_v1_signer_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_proto_fkms_v1_signer_proto_goTypes = []any{
 	(ChainType)(0),                     // 0: fkms.v1.ChainType
 	(*SignEvmRequest)(nil),             // 1: fkms.v1.SignEvmRequest
 	(*SignEvmResponse)(nil),            // 2: fkms.v1.SignEvmResponse
 	(*SignXrplRequest)(nil),            // 3: fkms.v1.SignXrplRequest
 	(*SignXrplResponse)(nil),           // 4: fkms.v1.SignXrplResponse
-	(*SignIconRequest)(nil),            // 5: fkms.v1.SignIconRequest
-	(*SignIconResponse)(nil),           // 6: fkms.v1.SignIconResponse
-	(*SignFlowRequest)(nil),            // 7: fkms.v1.SignFlowRequest
-	(*SignFlowResponse)(nil),           // 8: fkms.v1.SignFlowResponse
-	(*SignSorobanRequest)(nil),         // 9: fkms.v1.SignSorobanRequest
-	(*SignSorobanResponse)(nil),        // 10: fkms.v1.SignSorobanResponse
-	(*SignSecretRequest)(nil),          // 11: fkms.v1.SignSecretRequest
-	(*SignSecretResponse)(nil),         // 12: fkms.v1.SignSecretResponse
-	(*GetSignerAddressesRequest)(nil),  // 13: fkms.v1.GetSignerAddressesRequest
-	(*GetSignerAddressesResponse)(nil), // 14: fkms.v1.GetSignerAddressesResponse
-	(*XrplSignerPayload)(nil),          // 15: fkms.v1.XrplSignerPayload
-	(*IconSignerPayload)(nil),          // 16: fkms.v1.IconSignerPayload
-	(*FlowSignerPayload)(nil),          // 17: fkms.v1.FlowSignerPayload
-	(*SorobanSignerPayload)(nil),       // 18: fkms.v1.SorobanSignerPayload
-	(*SecretSignerPayload)(nil),        // 19: fkms.v1.SecretSignerPayload
-	(*Tss)(nil),                        // 20: fkms.v1.Tss
-	(*Signers)(nil),                    // 21: fkms.v1.Signers
+	(*SignXrplPaymentRequest)(nil),     // 5: fkms.v1.SignXrplPaymentRequest
+	(*SignXrplPaymentResponse)(nil),    // 6: fkms.v1.SignXrplPaymentResponse
+	(*SignIconRequest)(nil),            // 7: fkms.v1.SignIconRequest
+	(*SignIconResponse)(nil),           // 8: fkms.v1.SignIconResponse
+	(*SignFlowRequest)(nil),            // 9: fkms.v1.SignFlowRequest
+	(*SignFlowResponse)(nil),           // 10: fkms.v1.SignFlowResponse
+	(*SignSorobanRequest)(nil),         // 11: fkms.v1.SignSorobanRequest
+	(*SignSorobanResponse)(nil),        // 12: fkms.v1.SignSorobanResponse
+	(*SignSecretRequest)(nil),          // 13: fkms.v1.SignSecretRequest
+	(*SignSecretResponse)(nil),         // 14: fkms.v1.SignSecretResponse
+	(*GetSignerAddressesRequest)(nil),  // 15: fkms.v1.GetSignerAddressesRequest
+	(*GetSignerAddressesResponse)(nil), // 16: fkms.v1.GetSignerAddressesResponse
+	(*XrplSignerPayload)(nil),          // 17: fkms.v1.XrplSignerPayload
+	(*XrplPaymentPayload)(nil),         // 18: fkms.v1.XrplPaymentPayload
+	(*IconSignerPayload)(nil),          // 19: fkms.v1.IconSignerPayload
+	(*FlowSignerPayload)(nil),          // 20: fkms.v1.FlowSignerPayload
+	(*SorobanSignerPayload)(nil),       // 21: fkms.v1.SorobanSignerPayload
+	(*SecretSignerPayload)(nil),        // 22: fkms.v1.SecretSignerPayload
+	(*Tss)(nil),                        // 23: fkms.v1.Tss
+	(*Signers)(nil),                    // 24: fkms.v1.Signers
 }
 var file_proto_fkms_v1_signer_proto_depIdxs = []int32{
-	15, // 0: fkms.v1.SignXrplRequest.signer_payload:type_name -> fkms.v1.XrplSignerPayload
-	20, // 1: fkms.v1.SignXrplRequest.tss:type_name -> fkms.v1.Tss
-	16, // 2: fkms.v1.SignIconRequest.signer_payload:type_name -> fkms.v1.IconSignerPayload
-	20, // 3: fkms.v1.SignIconRequest.tss:type_name -> fkms.v1.Tss
-	17, // 4: fkms.v1.SignFlowRequest.signer_payload:type_name -> fkms.v1.FlowSignerPayload
-	20, // 5: fkms.v1.SignFlowRequest.tss:type_name -> fkms.v1.Tss
-	18, // 6: fkms.v1.SignSorobanRequest.signer_payload:type_name -> fkms.v1.SorobanSignerPayload
-	20, // 7: fkms.v1.SignSorobanRequest.tss:type_name -> fkms.v1.Tss
-	19, // 8: fkms.v1.SignSecretRequest.signer_payload:type_name -> fkms.v1.SecretSignerPayload
-	20, // 9: fkms.v1.SignSecretRequest.tss:type_name -> fkms.v1.Tss
-	21, // 10: fkms.v1.GetSignerAddressesResponse.signers:type_name -> fkms.v1.Signers
-	0,  // 11: fkms.v1.Signers.chain_type:type_name -> fkms.v1.ChainType
-	1,  // 12: fkms.v1.FkmsService.SignEvm:input_type -> fkms.v1.SignEvmRequest
-	3,  // 13: fkms.v1.FkmsService.SignXrpl:input_type -> fkms.v1.SignXrplRequest
-	5,  // 14: fkms.v1.FkmsService.SignIcon:input_type -> fkms.v1.SignIconRequest
-	7,  // 15: fkms.v1.FkmsService.SignFlow:input_type -> fkms.v1.SignFlowRequest
-	9,  // 16: fkms.v1.FkmsService.SignSoroban:input_type -> fkms.v1.SignSorobanRequest
-	11, // 17: fkms.v1.FkmsService.SignSecret:input_type -> fkms.v1.SignSecretRequest
-	13, // 18: fkms.v1.FkmsService.GetSignerAddresses:input_type -> fkms.v1.GetSignerAddressesRequest
-	2,  // 19: fkms.v1.FkmsService.SignEvm:output_type -> fkms.v1.SignEvmResponse
-	4,  // 20: fkms.v1.FkmsService.SignXrpl:output_type -> fkms.v1.SignXrplResponse
-	6,  // 21: fkms.v1.FkmsService.SignIcon:output_type -> fkms.v1.SignIconResponse
-	8,  // 22: fkms.v1.FkmsService.SignFlow:output_type -> fkms.v1.SignFlowResponse
-	10, // 23: fkms.v1.FkmsService.SignSoroban:output_type -> fkms.v1.SignSorobanResponse
-	12, // 24: fkms.v1.FkmsService.SignSecret:output_type -> fkms.v1.SignSecretResponse
-	14, // 25: fkms.v1.FkmsService.GetSignerAddresses:output_type -> fkms.v1.GetSignerAddressesResponse
-	19, // [19:26] is the sub-list for method output_type
-	12, // [12:19] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	17, // 0: fkms.v1.SignXrplRequest.signer_payload:type_name -> fkms.v1.XrplSignerPayload
+	23, // 1: fkms.v1.SignXrplRequest.tss:type_name -> fkms.v1.Tss
+	18, // 2: fkms.v1.SignXrplPaymentRequest.payment_payload:type_name -> fkms.v1.XrplPaymentPayload
+	19, // 3: fkms.v1.SignIconRequest.signer_payload:type_name -> fkms.v1.IconSignerPayload
+	23, // 4: fkms.v1.SignIconRequest.tss:type_name -> fkms.v1.Tss
+	20, // 5: fkms.v1.SignFlowRequest.signer_payload:type_name -> fkms.v1.FlowSignerPayload
+	23, // 6: fkms.v1.SignFlowRequest.tss:type_name -> fkms.v1.Tss
+	21, // 7: fkms.v1.SignSorobanRequest.signer_payload:type_name -> fkms.v1.SorobanSignerPayload
+	23, // 8: fkms.v1.SignSorobanRequest.tss:type_name -> fkms.v1.Tss
+	22, // 9: fkms.v1.SignSecretRequest.signer_payload:type_name -> fkms.v1.SecretSignerPayload
+	23, // 10: fkms.v1.SignSecretRequest.tss:type_name -> fkms.v1.Tss
+	24, // 11: fkms.v1.GetSignerAddressesResponse.signers:type_name -> fkms.v1.Signers
+	0,  // 12: fkms.v1.Signers.chain_type:type_name -> fkms.v1.ChainType
+	1,  // 13: fkms.v1.FkmsService.SignEvm:input_type -> fkms.v1.SignEvmRequest
+	3,  // 14: fkms.v1.FkmsService.SignXrpl:input_type -> fkms.v1.SignXrplRequest
+	5,  // 15: fkms.v1.FkmsService.SignXrplPayment:input_type -> fkms.v1.SignXrplPaymentRequest
+	7,  // 16: fkms.v1.FkmsService.SignIcon:input_type -> fkms.v1.SignIconRequest
+	9,  // 17: fkms.v1.FkmsService.SignFlow:input_type -> fkms.v1.SignFlowRequest
+	11, // 18: fkms.v1.FkmsService.SignSoroban:input_type -> fkms.v1.SignSorobanRequest
+	13, // 19: fkms.v1.FkmsService.SignSecret:input_type -> fkms.v1.SignSecretRequest
+	15, // 20: fkms.v1.FkmsService.GetSignerAddresses:input_type -> fkms.v1.GetSignerAddressesRequest
+	2,  // 21: fkms.v1.FkmsService.SignEvm:output_type -> fkms.v1.SignEvmResponse
+	4,  // 22: fkms.v1.FkmsService.SignXrpl:output_type -> fkms.v1.SignXrplResponse
+	6,  // 23: fkms.v1.FkmsService.SignXrplPayment:output_type -> fkms.v1.SignXrplPaymentResponse
+	8,  // 24: fkms.v1.FkmsService.SignIcon:output_type -> fkms.v1.SignIconResponse
+	10, // 25: fkms.v1.FkmsService.SignFlow:output_type -> fkms.v1.SignFlowResponse
+	12, // 26: fkms.v1.FkmsService.SignSoroban:output_type -> fkms.v1.SignSorobanResponse
+	14, // 27: fkms.v1.FkmsService.SignSecret:output_type -> fkms.v1.SignSecretResponse
+	16, // 28: fkms.v1.FkmsService.GetSignerAddresses:output_type -> fkms.v1.GetSignerAddressesResponse
+	21, // [21:29] is the sub-list for method output_type
+	13, // [13:21] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_proto_fkms_v1_signer_proto_init() }
@@ -1455,7 +1636,7 @@ func file_proto_fkms_v1_signer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_fkms_v1_signer_proto_rawDesc), len(file_proto_fkms_v1_signer_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   21,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	FkmsService_SignEvm_FullMethodName            = "/fkms.v1.FkmsService/SignEvm"
 	FkmsService_SignXrpl_FullMethodName           = "/fkms.v1.FkmsService/SignXrpl"
+	FkmsService_SignXrplPayment_FullMethodName    = "/fkms.v1.FkmsService/SignXrplPayment"
 	FkmsService_SignIcon_FullMethodName           = "/fkms.v1.FkmsService/SignIcon"
 	FkmsService_SignFlow_FullMethodName           = "/fkms.v1.FkmsService/SignFlow"
 	FkmsService_SignSoroban_FullMethodName        = "/fkms.v1.FkmsService/SignSoroban"
@@ -34,6 +35,7 @@ const (
 type FkmsServiceClient interface {
 	SignEvm(ctx context.Context, in *SignEvmRequest, opts ...grpc.CallOption) (*SignEvmResponse, error)
 	SignXrpl(ctx context.Context, in *SignXrplRequest, opts ...grpc.CallOption) (*SignXrplResponse, error)
+	SignXrplPayment(ctx context.Context, in *SignXrplPaymentRequest, opts ...grpc.CallOption) (*SignXrplPaymentResponse, error)
 	SignIcon(ctx context.Context, in *SignIconRequest, opts ...grpc.CallOption) (*SignIconResponse, error)
 	SignFlow(ctx context.Context, in *SignFlowRequest, opts ...grpc.CallOption) (*SignFlowResponse, error)
 	SignSoroban(ctx context.Context, in *SignSorobanRequest, opts ...grpc.CallOption) (*SignSorobanResponse, error)
@@ -69,6 +71,16 @@ func (c *fkmsServiceClient) SignXrpl(ctx context.Context, in *SignXrplRequest, o
 	return out, nil
 }
 
+func (c *fkmsServiceClient) SignXrplPayment(ctx context.Context, in *SignXrplPaymentRequest, opts ...grpc.CallOption) (*SignXrplPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignXrplPaymentResponse)
+	err := c.cc.Invoke(ctx, FkmsService_SignXrplPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fkmsServiceClient) SignIcon(ctx context.Context, in *SignIconRequest, opts ...grpc.CallOption) (*SignIconResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SignIconResponse)
@@ -125,6 +137,7 @@ func (c *fkmsServiceClient) GetSignerAddresses(ctx context.Context, in *GetSigne
 type FkmsServiceServer interface {
 	SignEvm(context.Context, *SignEvmRequest) (*SignEvmResponse, error)
 	SignXrpl(context.Context, *SignXrplRequest) (*SignXrplResponse, error)
+	SignXrplPayment(context.Context, *SignXrplPaymentRequest) (*SignXrplPaymentResponse, error)
 	SignIcon(context.Context, *SignIconRequest) (*SignIconResponse, error)
 	SignFlow(context.Context, *SignFlowRequest) (*SignFlowResponse, error)
 	SignSoroban(context.Context, *SignSorobanRequest) (*SignSorobanResponse, error)
@@ -146,6 +159,9 @@ func (UnimplementedFkmsServiceServer) SignEvm(context.Context, *SignEvmRequest)
 func (UnimplementedFkmsServiceServer) SignXrpl(context.Context, *SignXrplRequest) (*SignXrplResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignXrpl not implemented")
 }
+func (UnimplementedFkmsServiceServer) SignXrplPayment(context.Context, *SignXrplPaymentRequest) (*SignXrplPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignXrplPayment not implemented")
+}
 func (UnimplementedFkmsServiceServer) SignIcon(context.Context, *SignIconRequest) (*SignIconResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignIcon not implemented")
 }
@@ -218,6 +234,24 @@ func _FkmsService_SignXrpl_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FkmsService_SignXrplPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignXrplPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FkmsServiceServer).SignXrplPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FkmsService_SignXrplPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FkmsServiceServer).SignXrplPayment(ctx, req.(*SignXrplPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FkmsService_SignIcon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignIconRequest)
 	if err := dec(in); err != nil {
@@ -323,6 +357,10 @@ var FkmsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignXrpl",
 			Handler:    _FkmsService_SignXrpl_Handler,
 		},
+		{
+			MethodName: "SignXrplPayment",
+			Handler:    _FkmsService_SignXrplPayment_Handler,
+		},
 		{
 			MethodName: "SignIcon",
 			Handler:    _FkmsService_SignIcon_Handler,
@@ -1,5 +1,7 @@
 package alert
 
+import "io"
+
 const (
 	ConnectSingleBandClientErrorMsg  = "Failed to connect BandChain client"
 	ConnectAllBandClientErrorMsg     = "Failed to connect BandChain client on all endpoints"
@@ -11,10 +13,17 @@ const (
 	GetTunnelPacketErrorMsg          = "Failed to get tunnel packet from BandChain"
 	GetContractTunnelInfoErrorMsg    = "Failed to get tunnel info from contract"
 	PacketSigningStatusErrorMsg      = "Failed tunnel packet signing status"
+	InvalidPacketSignatureMsg        = "Tunnel packet signature is not signed by the current or incoming group"
 	GetHeaderBlockErrorMsg           = "Failed to get header block from chain"
 	GetLedgerCloseTimeErrorMsg       = "Failed to get ledger close time from chain"
 	GetBalanceErrorMsg               = "Failed to get balance from chain"
 	SaveDatabaseErrorMsg             = "Failed to save to database"
+	SaveCheckpointErrorMsg           = "Failed to save relay checkpoint"
+	UnprofitableRelayMsg             = "Relay gas cost exceeds reimbursement"
+	LowTunnelBalanceMsg              = "Tunnel balance on target chain is below threshold"
+	LowSignerBalanceMsg              = "Signer balance on target chain is below threshold"
+	TopUpSignerErrorMsg              = "Failed to top up signer from treasury"
+	TopUpDailyCapReachedMsg          = "Signer top-up daily cap is reached"
 )
 
 // Alert represents an object that triggers and resets alerts.
@@ -38,3 +47,11 @@ func HandleReset(alert Alert, topic *Topic) {
 	}
 	alert.Reset(topic.GetFullTopic())
 }
+
+// Close closes the given alert if it sends the alerts in the background, waiting until the
+// queued alerts are sent.
+func Close(alert Alert) {
+	if closer, ok := alert.(io.Closer); ok {
+		_ = closer.Close()
+	}
+}
@@ -0,0 +1,76 @@
+package alert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+// BackendType is the type of the alert backend.
+type BackendType string
+
+const (
+	BackendTypeNone    BackendType = ""
+	BackendTypeWebhook BackendType = "webhook"
+	BackendTypeSlack   BackendType = "slack"
+	BackendTypeEvents  BackendType = "events"
+)
+
+const defaultTimeout = 5 * time.Second
+
+// Config defines the configuration for the alert backend. Thresholds are keyed by
+// the base message of the alert topic (e.g. "Failed to relay transaction") and
+// override the default threshold for that topic.
+type Config struct {
+	Type       BackendType                `mapstructure:"type"        toml:"type"`
+	URL        string                     `mapstructure:"url"         toml:"url"`
+	RoutingKey string                     `mapstructure:"routing_key" toml:"routing_key,omitempty"`
+	Timeout    time.Duration              `mapstructure:"timeout"     toml:"timeout"`
+	Default    ThresholdConfig            `mapstructure:"default"     toml:"default,omitempty"`
+	Thresholds map[string]ThresholdConfig `mapstructure:"thresholds"  toml:"thresholds,omitempty"`
+}
+
+// NewAlert creates the alert backend from the given configuration, wrapped with
+// the ThresholdAlert decorator. It returns nil if no backend is configured.
+func NewAlert(cfg Config, log logger.Logger) (Alert, error) {
+	backend, err := newBackend(cfg, log)
+	if err != nil || backend == nil {
+		return nil, err
+	}
+
+	return NewThresholdAlert(backend, cfg.Default, cfg.Thresholds), nil
+}
+
+// newBackend creates the alert backend from the given configuration.
+func newBackend(cfg Config, log logger.Logger) (Alert, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	switch cfg.Type {
+	case BackendTypeNone:
+		return nil, nil
+	case BackendTypeWebhook:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("alert url is required for %s backend", cfg.Type)
+		}
+		return NewWebhookAlert(cfg.URL, timeout, log), nil
+	case BackendTypeSlack:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("alert url is required for %s backend", cfg.Type)
+		}
+		return NewSlackAlert(cfg.URL, timeout, log), nil
+	case BackendTypeEvents:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("alert url is required for %s backend", cfg.Type)
+		}
+		if cfg.RoutingKey == "" {
+			return nil, fmt.Errorf("alert routing_key is required for %s backend", cfg.Type)
+		}
+		return NewEventsAlert(cfg.URL, cfg.RoutingKey, timeout, log), nil
+	default:
+		return nil, fmt.Errorf("unsupported alert type: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,71 @@
+package alert_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/relayer/alert"
+)
+
+func TestNewAlert(t *testing.T) {
+	testcases := []struct {
+		name string
+		cfg  alert.Config
+		out  alert.Alert
+		err  string
+	}{
+		{
+			name: "no backend",
+			cfg:  alert.Config{},
+			out:  nil,
+		},
+		{
+			name: "webhook",
+			cfg:  alert.Config{Type: alert.BackendTypeWebhook, URL: "http://localhost"},
+			out:  &alert.ThresholdAlert{},
+		},
+		{
+			name: "slack",
+			cfg:  alert.Config{Type: alert.BackendTypeSlack, URL: "http://localhost"},
+			out:  &alert.ThresholdAlert{},
+		},
+		{
+			name: "events",
+			cfg:  alert.Config{Type: alert.BackendTypeEvents, URL: "http://localhost", RoutingKey: "key"},
+			out:  &alert.ThresholdAlert{},
+		},
+		{
+			name: "missing url",
+			cfg:  alert.Config{Type: alert.BackendTypeWebhook},
+			err:  "alert url is required",
+		},
+		{
+			name: "events missing routing key",
+			cfg:  alert.Config{Type: alert.BackendTypeEvents, URL: "http://localhost"},
+			err:  "alert routing_key is required",
+		},
+		{
+			name: "unsupported type",
+			cfg:  alert.Config{Type: "email"},
+			err:  "unsupported alert type: email",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := alert.NewAlert(tc.cfg, nil)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.out == nil {
+				require.Nil(t, actual)
+			} else {
+				require.IsType(t, tc.out, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package alert
+
+import (
+	"time"
+
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+const (
+	eventsActionTrigger = "trigger"
+	eventsActionResolve = "resolve"
+
+	eventsSource   = "falcon"
+	eventsSeverity = "error"
+)
+
+var _ Alert = &EventsAlert{}
+
+// EventsPayload is the JSON body of an Events API (PagerDuty v2 style) request.
+type EventsPayload struct {
+	RoutingKey  string               `json:"routing_key"`
+	EventAction string               `json:"event_action"`
+	DedupKey    string               `json:"dedup_key"`
+	Payload     *EventsPayloadDetail `json:"payload,omitempty"`
+}
+
+// EventsPayloadDetail is the detail of a trigger event.
+type EventsPayloadDetail struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Timestamp     time.Time         `json:"timestamp"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+// EventsAlert sends trigger and resolve events to an Events API endpoint. The full
+// topic is used as the dedup key, so that repeated triggers of the same topic are
+// grouped into a single incident and resolved together.
+type EventsAlert struct {
+	routingKey string
+	sender     *httpSender
+}
+
+// NewEventsAlert creates a new EventsAlert instance.
+func NewEventsAlert(url string, routingKey string, timeout time.Duration, log logger.Logger) *EventsAlert {
+	return &EventsAlert{
+		routingKey: routingKey,
+		sender:     newHTTPSender(url, BackendTypeEvents, timeout, defaultQueueSize, log),
+	}
+}
+
+// Trigger sends a trigger event of the given topic.
+func (e *EventsAlert) Trigger(topic, detail string) {
+	e.sender.markTriggered(topic)
+	e.sender.send(topic, EventsPayload{
+		RoutingKey:  e.routingKey,
+		EventAction: eventsActionTrigger,
+		DedupKey:    topic,
+		Payload: &EventsPayloadDetail{
+			Summary:       topic,
+			Source:        eventsSource,
+			Severity:      eventsSeverity,
+			Timestamp:     time.Now().UTC(),
+			CustomDetails: map[string]string{"detail": detail},
+		},
+	})
+}
+
+// Reset sends a resolve event of the given topic if it was triggered.
+func (e *EventsAlert) Reset(topic string) {
+	if !e.sender.markReset(topic) {
+		return
+	}
+
+	e.sender.send(topic, EventsPayload{
+		RoutingKey:  e.routingKey,
+		EventAction: eventsActionResolve,
+		DedupKey:    topic,
+	})
+}
+
+// Close stops sending alerts and waits until the queued ones are sent.
+func (e *EventsAlert) Close() error {
+	e.sender.close()
+	return nil
+}
@@ -0,0 +1,38 @@
+package alert_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/relayer/alert"
+)
+
+func TestEventsAlert(t *testing.T) {
+	srv, received := newRecordingServer[alert.EventsPayload](t, http.StatusAccepted)
+
+	a := alert.NewEventsAlert(srv.URL, "routing-key", time.Second, nil)
+	topic := alert.NewTopic(alert.EstimateGasFeeErrorMsg).WithTunnelID(3).WithChainName("eth").GetFullTopic()
+
+	a.Trigger(topic, "rpc timeout")
+	a.Trigger(topic, "rpc timeout again")
+	a.Reset(topic)
+	alert.Flush(a)
+
+	require.Len(t, *received, 3)
+
+	for _, event := range *received {
+		require.Equal(t, "routing-key", event.RoutingKey)
+		require.Equal(t, topic, event.DedupKey)
+	}
+
+	require.Equal(t, "trigger", (*received)[0].EventAction)
+	require.Equal(t, topic, (*received)[0].Payload.Summary)
+	require.Equal(t, "falcon", (*received)[0].Payload.Source)
+	require.Equal(t, "rpc timeout", (*received)[0].Payload.CustomDetails["detail"])
+	require.Equal(t, "trigger", (*received)[1].EventAction)
+	require.Equal(t, "resolve", (*received)[2].EventAction)
+	require.Nil(t, (*received)[2].Payload)
+}
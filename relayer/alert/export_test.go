@@ -0,0 +1,37 @@
+package alert
+
+import "time"
+
+// NewThresholdAlertWithClock creates a ThresholdAlert with the given clock for testing.
+func NewThresholdAlertWithClock(
+	alert Alert,
+	defaultCfg ThresholdConfig,
+	thresholds map[string]ThresholdConfig,
+	now func() time.Time,
+) *ThresholdAlert {
+	return newThresholdAlert(alert, defaultCfg, thresholds, now)
+}
+
+// NewWebhookAlertWithQueueSize creates a WebhookAlert with the given queue size for testing.
+func NewWebhookAlertWithQueueSize(url string, timeout time.Duration, queueSize int) *WebhookAlert {
+	return &WebhookAlert{
+		sender: newHTTPSender(url, BackendTypeWebhook, timeout, queueSize, nil),
+	}
+}
+
+// Flush waits until the payloads queued by the given alert are sent.
+func Flush(a Alert) {
+	switch a := a.(type) {
+	case *WebhookAlert:
+		a.sender.flush()
+	case *SlackAlert:
+		a.sender.flush()
+	case *EventsAlert:
+		a.sender.flush()
+	}
+}
+
+// DroppedCount returns the number of payloads dropped by the given webhook alert.
+func DroppedCount(a *WebhookAlert) uint64 {
+	return a.sender.dropped.Load()
+}
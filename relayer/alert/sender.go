@@ -0,0 +1,188 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bandprotocol/falcon/internal/relayermetrics"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+// defaultQueueSize is the number of alert payloads waiting to be sent before new ones are dropped.
+const defaultQueueSize = 100
+
+// sendRequest is a payload waiting in the queue of the httpSender. A request with
+// a done channel is only a marker that is closed once the requests before it are sent.
+type sendRequest struct {
+	topic   string
+	payload any
+	done    chan struct{}
+}
+
+// httpSender posts JSON payloads to the given URL and keeps track of the topics
+// that are currently triggered, so that resolve notifications are only sent for
+// topics that were actually triggered before. The payloads are sent in order by a
+// background goroutine, so that a slow endpoint never blocks the callers; payloads
+// are dropped while the queue is full or once the sender is closed.
+type httpSender struct {
+	url     string
+	backend BackendType
+	client  *http.Client
+	log     logger.Logger
+	queue   chan sendRequest
+	stopped chan struct{}
+	dropped atomic.Uint64
+
+	// closeMu guards closing the queue against the payloads being queued.
+	closeMu  sync.RWMutex
+	isClosed bool
+
+	mu           sync.Mutex
+	activeTopics map[string]struct{}
+}
+
+// newHTTPSender creates a new httpSender instance and starts sending its queued payloads.
+func newHTTPSender(
+	url string,
+	backend BackendType,
+	timeout time.Duration,
+	queueSize int,
+	log logger.Logger,
+) *httpSender {
+	s := &httpSender{
+		url:          url,
+		backend:      backend,
+		client:       &http.Client{Timeout: timeout},
+		log:          log,
+		queue:        make(chan sendRequest, queueSize),
+		stopped:      make(chan struct{}),
+		activeTopics: make(map[string]struct{}),
+	}
+
+	go s.run()
+
+	return s
+}
+
+// markTriggered marks the topic as triggered.
+func (s *httpSender) markTriggered(topic string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.activeTopics[topic] = struct{}{}
+}
+
+// markReset removes the topic from the triggered set and returns whether it was triggered.
+func (s *httpSender) markReset(topic string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.activeTopics[topic]; !ok {
+		return false
+	}
+
+	delete(s.activeTopics, topic)
+	return true
+}
+
+// post sends the given payload as JSON to the configured URL.
+func (s *httpSender) post(payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.client.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create alert request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send alert: %w", err)
+	}
+	defer res.Body.Close()
+
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("alert endpoint returned status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// send queues the payload to be sent, or drops it if the queue is full or the sender is closed.
+func (s *httpSender) send(topic string, payload any) {
+	s.closeMu.RLock()
+	defer s.closeMu.RUnlock()
+
+	if s.isClosed {
+		return
+	}
+
+	select {
+	case s.queue <- sendRequest{topic: topic, payload: payload}:
+	default:
+		s.dropped.Add(1)
+		relayermetrics.IncAlertsDroppedCount(string(s.backend))
+		if s.log != nil {
+			s.log.Warn("Drop alert; alert queue is full", "topic", topic)
+		}
+	}
+}
+
+// flush waits until the payloads queued before it are sent.
+func (s *httpSender) flush() {
+	s.closeMu.RLock()
+	if s.isClosed {
+		s.closeMu.RUnlock()
+		return
+	}
+
+	done := make(chan struct{})
+	s.queue <- sendRequest{done: done}
+	s.closeMu.RUnlock()
+
+	<-done
+}
+
+// close stops accepting new payloads and waits until the queued ones are sent.
+func (s *httpSender) close() {
+	s.closeMu.Lock()
+	if !s.isClosed {
+		s.isClosed = true
+		close(s.queue)
+	}
+	s.closeMu.Unlock()
+
+	<-s.stopped
+}
+
+// run posts the queued payloads in order and logs the error if any, until the queue is closed.
+func (s *httpSender) run() {
+	defer close(s.stopped)
+
+	for req := range s.queue {
+		if req.done != nil {
+			close(req.done)
+			continue
+		}
+
+		if err := s.post(req.payload); err != nil && s.log != nil {
+			s.log.Error("Failed to send alert", "topic", req.topic, err)
+		}
+	}
+}
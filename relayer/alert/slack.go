@@ -0,0 +1,52 @@
+package alert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+var _ Alert = &SlackAlert{}
+
+// SlackPayload is the JSON body accepted by Slack-compatible incoming webhooks.
+type SlackPayload struct {
+	Text string `json:"text"`
+}
+
+// SlackAlert formats alerts as messages for Slack-compatible incoming webhooks.
+type SlackAlert struct {
+	sender *httpSender
+}
+
+// NewSlackAlert creates a new SlackAlert instance.
+func NewSlackAlert(url string, timeout time.Duration, log logger.Logger) *SlackAlert {
+	return &SlackAlert{
+		sender: newHTTPSender(url, BackendTypeSlack, timeout, defaultQueueSize, log),
+	}
+}
+
+// Trigger posts an alert message of the given topic.
+func (s *SlackAlert) Trigger(topic, detail string) {
+	s.sender.markTriggered(topic)
+	s.sender.send(topic, SlackPayload{
+		Text: fmt.Sprintf(":rotating_light: *[ALERT] %s*\n```%s```", topic, detail),
+	})
+}
+
+// Reset posts a resolved message of the given topic if it was triggered.
+func (s *SlackAlert) Reset(topic string) {
+	if !s.sender.markReset(topic) {
+		return
+	}
+
+	s.sender.send(topic, SlackPayload{
+		Text: fmt.Sprintf(":white_check_mark: *[RESOLVED] %s*", topic),
+	})
+}
+
+// Close stops sending alerts and waits until the queued ones are sent.
+func (s *SlackAlert) Close() error {
+	s.sender.close()
+	return nil
+}
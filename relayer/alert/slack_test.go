@@ -0,0 +1,28 @@
+package alert_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/relayer/alert"
+)
+
+func TestSlackAlert(t *testing.T) {
+	srv, received := newRecordingServer[alert.SlackPayload](t, http.StatusOK)
+
+	a := alert.NewSlackAlert(srv.URL, time.Second, nil)
+	topic := alert.NewTopic(alert.GetTunnelErrorMsg).WithTunnelID(7).GetFullTopic()
+
+	a.Reset(topic)
+	a.Trigger(topic, "connection refused")
+	a.Reset(topic)
+	alert.Flush(a)
+
+	require.Len(t, *received, 2)
+	require.Contains(t, (*received)[0].Text, "[ALERT] "+topic)
+	require.Contains(t, (*received)[0].Text, "connection refused")
+	require.Contains(t, (*received)[1].Text, "[RESOLVED] "+topic)
+}
@@ -0,0 +1,189 @@
+package alert
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+var _ Alert = &ThresholdAlert{}
+
+// ThresholdConfig defines when a topic is considered failing and when it is considered
+// recovered. A zero value forwards every trigger and the first reset as is.
+type ThresholdConfig struct {
+	// MinTriggers is the number of consecutive triggers required before an alert is sent.
+	MinTriggers int `mapstructure:"min_triggers" toml:"min_triggers"`
+	// MinDuration is the duration a topic must keep failing before an alert is sent.
+	// If both MinTriggers and MinDuration are set, the alert is sent once either is reached.
+	MinDuration time.Duration `mapstructure:"min_duration" toml:"min_duration"`
+	// RecoveryWindow is the duration a topic must stay healthy before a resolve is sent.
+	RecoveryWindow time.Duration `mapstructure:"recovery_window" toml:"recovery_window"`
+	// MaxNotifications caps the number of alerts sent for a topic until it is resolved.
+	// Zero means no limit.
+	MaxNotifications int `mapstructure:"max_notifications" toml:"max_notifications"`
+}
+
+// topicState is the state of a single topic tracked by ThresholdAlert.
+type topicState struct {
+	consecutiveTriggers int
+	failingSince        time.Time
+	recoveringSince     time.Time
+	firing              bool
+	notifications       int
+}
+
+// ThresholdAlert is an Alert decorator that deduplicates triggers and resets per topic.
+// An alert is only forwarded once the topic reaches its threshold, repeated alerts are
+// capped, and a resolve is only forwarded once the topic has been stable for its
+// recovery window. Thresholds are selected by the base message of the topic.
+type ThresholdAlert struct {
+	alert      Alert
+	defaultCfg ThresholdConfig
+	thresholds map[string]ThresholdConfig
+	now        func() time.Time
+
+	mu     sync.Mutex
+	topics map[string]*topicState
+}
+
+// NewThresholdAlert creates a new ThresholdAlert wrapping the given alert. The thresholds
+// map is keyed by the base message of the topic (e.g. RelayTxErrorMsg); topics without
+// a matching entry use the default config.
+func NewThresholdAlert(
+	alert Alert,
+	defaultCfg ThresholdConfig,
+	thresholds map[string]ThresholdConfig,
+) *ThresholdAlert {
+	return newThresholdAlert(alert, defaultCfg, thresholds, time.Now)
+}
+
+func newThresholdAlert(
+	alert Alert,
+	defaultCfg ThresholdConfig,
+	thresholds map[string]ThresholdConfig,
+	now func() time.Time,
+) *ThresholdAlert {
+	if thresholds == nil {
+		thresholds = make(map[string]ThresholdConfig)
+	}
+
+	return &ThresholdAlert{
+		alert:      alert,
+		defaultCfg: defaultCfg,
+		thresholds: thresholds,
+		now:        now,
+		topics:     make(map[string]*topicState),
+	}
+}
+
+// Trigger records a failure of the given topic and forwards it if the threshold is reached.
+func (t *ThresholdAlert) Trigger(topic, detail string) {
+	t.mu.Lock()
+
+	cfg := t.getThreshold(topic)
+	now := t.now()
+
+	state, ok := t.topics[topic]
+	if !ok {
+		state = &topicState{failingSince: now}
+		t.topics[topic] = state
+	}
+
+	state.consecutiveTriggers++
+	state.recoveringSince = time.Time{}
+
+	send := false
+	switch {
+	case !state.firing && isThresholdReached(cfg, state, now):
+		state.firing = true
+		send = true
+	case state.firing && (cfg.MaxNotifications <= 0 || state.notifications < cfg.MaxNotifications):
+		send = true
+	}
+
+	if send {
+		state.notifications++
+	}
+
+	t.mu.Unlock()
+
+	if send {
+		t.alert.Trigger(topic, detail)
+	}
+}
+
+// Reset records a success of the given topic and forwards the resolve once the topic
+// has been stable for its recovery window.
+func (t *ThresholdAlert) Reset(topic string) {
+	t.mu.Lock()
+
+	state, ok := t.topics[topic]
+	if !ok {
+		t.mu.Unlock()
+		return
+	}
+
+	// the topic never reached its threshold; the failure streak is broken.
+	if !state.firing {
+		delete(t.topics, topic)
+		t.mu.Unlock()
+		return
+	}
+
+	cfg := t.getThreshold(topic)
+	now := t.now()
+
+	if state.recoveringSince.IsZero() {
+		state.recoveringSince = now
+	}
+
+	if now.Sub(state.recoveringSince) < cfg.RecoveryWindow {
+		t.mu.Unlock()
+		return
+	}
+
+	delete(t.topics, topic)
+	t.mu.Unlock()
+
+	t.alert.Reset(topic)
+}
+
+// getThreshold returns the threshold config of the given topic. The longest configured
+// base message that prefixes the topic wins, so that e.g. ConnectAllChainClientErrorMsg
+// is not matched by ConnectSingleChainClientErrorMsg.
+func (t *ThresholdAlert) getThreshold(topic string) ThresholdConfig {
+	if cfg, ok := t.thresholds[topic]; ok {
+		return cfg
+	}
+
+	matched := ""
+	cfg := t.defaultCfg
+	for base, c := range t.thresholds {
+		if len(base) <= len(matched) || !strings.HasPrefix(topic, base+" ") {
+			continue
+		}
+		matched = base
+		cfg = c
+	}
+
+	return cfg
+}
+
+// isThresholdReached checks whether the topic state reaches the given threshold.
+func isThresholdReached(cfg ThresholdConfig, state *topicState, now time.Time) bool {
+	if cfg.MinTriggers <= 0 && cfg.MinDuration <= 0 {
+		return true
+	}
+
+	if cfg.MinTriggers > 0 && state.consecutiveTriggers >= cfg.MinTriggers {
+		return true
+	}
+
+	return cfg.MinDuration > 0 && now.Sub(state.failingSince) >= cfg.MinDuration
+}
+
+// Close closes the wrapped alert, see Close.
+func (t *ThresholdAlert) Close() error {
+	Close(t.alert)
+	return nil
+}
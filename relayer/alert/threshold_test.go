@@ -0,0 +1,163 @@
+package alert_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bandprotocol/falcon/relayer/alert"
+)
+
+// recordingAlert records the calls made to it.
+type recordingAlert struct {
+	triggers []string
+	resets   []string
+}
+
+func (r *recordingAlert) Trigger(topic, detail string) {
+	r.triggers = append(r.triggers, topic)
+}
+
+func (r *recordingAlert) Reset(topic string) {
+	r.resets = append(r.resets, topic)
+}
+
+type ThresholdAlertTestSuite struct {
+	suite.Suite
+
+	inner *recordingAlert
+	now   time.Time
+}
+
+func TestThresholdAlertTestSuite(t *testing.T) {
+	suite.Run(t, new(ThresholdAlertTestSuite))
+}
+
+func (s *ThresholdAlertTestSuite) SetupTest() {
+	s.inner = &recordingAlert{}
+	s.now = time.Unix(1_700_000_000, 0)
+}
+
+func (s *ThresholdAlertTestSuite) newAlert(
+	defaultCfg alert.ThresholdConfig,
+	thresholds map[string]alert.ThresholdConfig,
+) *alert.ThresholdAlert {
+	return alert.NewThresholdAlertWithClock(s.inner, defaultCfg, thresholds, func() time.Time { return s.now })
+}
+
+func (s *ThresholdAlertTestSuite) TestPassThrough() {
+	a := s.newAlert(alert.ThresholdConfig{}, nil)
+	topic := alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(1).GetFullTopic()
+
+	a.Reset(topic)
+	s.Require().Empty(s.inner.resets)
+
+	a.Trigger(topic, "err")
+	a.Trigger(topic, "err")
+	s.Require().Equal([]string{topic, topic}, s.inner.triggers)
+
+	a.Reset(topic)
+	a.Reset(topic)
+	s.Require().Equal([]string{topic}, s.inner.resets)
+}
+
+func (s *ThresholdAlertTestSuite) TestMinTriggers() {
+	a := s.newAlert(alert.ThresholdConfig{MinTriggers: 3}, nil)
+	topic := alert.NewTopic(alert.GetTunnelErrorMsg).WithTunnelID(1).GetFullTopic()
+
+	// a success in between breaks the streak
+	a.Trigger(topic, "err")
+	a.Trigger(topic, "err")
+	a.Reset(topic)
+	a.Trigger(topic, "err")
+	a.Trigger(topic, "err")
+	s.Require().Empty(s.inner.triggers)
+	s.Require().Empty(s.inner.resets)
+
+	a.Trigger(topic, "err")
+	s.Require().Equal([]string{topic}, s.inner.triggers)
+
+	a.Reset(topic)
+	s.Require().Equal([]string{topic}, s.inner.resets)
+}
+
+func (s *ThresholdAlertTestSuite) TestMinDuration() {
+	a := s.newAlert(alert.ThresholdConfig{MinDuration: time.Minute}, nil)
+	topic := alert.NewTopic(alert.EstimateGasFeeErrorMsg).WithChainName("testnet").GetFullTopic()
+
+	a.Trigger(topic, "err")
+	s.now = s.now.Add(30 * time.Second)
+	a.Trigger(topic, "err")
+	s.Require().Empty(s.inner.triggers)
+
+	s.now = s.now.Add(30 * time.Second)
+	a.Trigger(topic, "err")
+	s.Require().Equal([]string{topic}, s.inner.triggers)
+}
+
+func (s *ThresholdAlertTestSuite) TestRecoveryWindow() {
+	a := s.newAlert(alert.ThresholdConfig{RecoveryWindow: time.Minute}, nil)
+	topic := alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(1).GetFullTopic()
+
+	a.Trigger(topic, "err")
+	s.Require().Len(s.inner.triggers, 1)
+
+	a.Reset(topic)
+	s.now = s.now.Add(30 * time.Second)
+	a.Reset(topic)
+	s.Require().Empty(s.inner.resets)
+
+	// flapping restarts the recovery window
+	a.Trigger(topic, "err")
+	s.now = s.now.Add(30 * time.Second)
+	a.Reset(topic)
+	s.Require().Empty(s.inner.resets)
+
+	s.now = s.now.Add(time.Minute)
+	a.Reset(topic)
+	s.Require().Equal([]string{topic}, s.inner.resets)
+}
+
+func (s *ThresholdAlertTestSuite) TestMaxNotifications() {
+	a := s.newAlert(alert.ThresholdConfig{MaxNotifications: 2}, nil)
+	topic := alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(1).GetFullTopic()
+
+	for i := 0; i < 5; i++ {
+		a.Trigger(topic, "err")
+	}
+	s.Require().Len(s.inner.triggers, 2)
+
+	// the cap is reset once the topic is resolved
+	a.Reset(topic)
+	a.Trigger(topic, "err")
+	s.Require().Len(s.inner.triggers, 3)
+}
+
+func (s *ThresholdAlertTestSuite) TestPerBaseMessageThreshold() {
+	a := s.newAlert(
+		alert.ThresholdConfig{},
+		map[string]alert.ThresholdConfig{
+			alert.ConnectSingleChainClientErrorMsg: {MinTriggers: 5},
+			alert.ConnectAllChainClientErrorMsg:    {MinTriggers: 2},
+		},
+	)
+	single := alert.NewTopic(alert.ConnectSingleChainClientErrorMsg).WithChainName("testnet").GetFullTopic()
+	all := alert.NewTopic(alert.ConnectAllChainClientErrorMsg).WithChainName("testnet").GetFullTopic()
+	other := alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(1).GetFullTopic()
+
+	a.Trigger(other, "err")
+	s.Require().Equal([]string{other}, s.inner.triggers)
+
+	a.Trigger(all, "err")
+	a.Trigger(all, "err")
+	s.Require().Equal([]string{other, all}, s.inner.triggers)
+
+	for i := 0; i < 4; i++ {
+		a.Trigger(single, "err")
+	}
+	s.Require().Equal([]string{other, all}, s.inner.triggers)
+
+	a.Trigger(single, "err")
+	s.Require().Equal([]string{other, all, single}, s.inner.triggers)
+}
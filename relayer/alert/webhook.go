@@ -0,0 +1,65 @@
+package alert
+
+import (
+	"time"
+
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+const (
+	webhookEventTrigger = "trigger"
+	webhookEventResolve = "resolve"
+)
+
+var _ Alert = &WebhookAlert{}
+
+// WebhookPayload is the JSON body sent by the WebhookAlert.
+type WebhookPayload struct {
+	Event     string    `json:"event"`
+	Topic     string    `json:"topic"`
+	Detail    string    `json:"detail,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// WebhookAlert sends a generic JSON payload to the given URL for every trigger
+// and for the reset of a triggered topic.
+type WebhookAlert struct {
+	sender *httpSender
+}
+
+// NewWebhookAlert creates a new WebhookAlert instance.
+func NewWebhookAlert(url string, timeout time.Duration, log logger.Logger) *WebhookAlert {
+	return &WebhookAlert{
+		sender: newHTTPSender(url, BackendTypeWebhook, timeout, defaultQueueSize, log),
+	}
+}
+
+// Trigger sends a trigger event of the given topic.
+func (w *WebhookAlert) Trigger(topic, detail string) {
+	w.sender.markTriggered(topic)
+	w.sender.send(topic, WebhookPayload{
+		Event:     webhookEventTrigger,
+		Topic:     topic,
+		Detail:    detail,
+		Timestamp: time.Now().UTC(),
+	})
+}
+
+// Reset sends a resolve event of the given topic if it was triggered.
+func (w *WebhookAlert) Reset(topic string) {
+	if !w.sender.markReset(topic) {
+		return
+	}
+
+	w.sender.send(topic, WebhookPayload{
+		Event:     webhookEventResolve,
+		Topic:     topic,
+		Timestamp: time.Now().UTC(),
+	})
+}
+
+// Close stops sending alerts and waits until the queued ones are sent.
+func (w *WebhookAlert) Close() error {
+	w.sender.close()
+	return nil
+}
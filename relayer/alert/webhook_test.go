@@ -0,0 +1,126 @@
+package alert_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/relayer/alert"
+)
+
+// newRecordingServer creates a test server that decodes every received JSON body into T.
+func newRecordingServer[T any](t *testing.T, status int) (*httptest.Server, *[]T) {
+	t.Helper()
+
+	received := make([]T, 0)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body T
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		received = append(received, body)
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &received
+}
+
+func TestWebhookAlert(t *testing.T) {
+	srv, received := newRecordingServer[alert.WebhookPayload](t, http.StatusOK)
+
+	a := alert.NewWebhookAlert(srv.URL, time.Second, nil)
+	topic := alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(1).WithChainName("testnet").GetFullTopic()
+
+	// reset without trigger should not send anything
+	a.Reset(topic)
+	alert.Flush(a)
+	require.Empty(t, *received)
+
+	a.Trigger(topic, "out of gas")
+	a.Reset(topic)
+
+	// second reset should be ignored as the topic is already resolved
+	a.Reset(topic)
+	alert.Flush(a)
+
+	require.Len(t, *received, 2)
+	require.Equal(t, "trigger", (*received)[0].Event)
+	require.Equal(t, topic, (*received)[0].Topic)
+	require.Equal(t, "out of gas", (*received)[0].Detail)
+	require.Equal(t, "resolve", (*received)[1].Event)
+	require.Equal(t, topic, (*received)[1].Topic)
+}
+
+func TestWebhookAlertServerError(t *testing.T) {
+	srv, received := newRecordingServer[alert.WebhookPayload](t, http.StatusInternalServerError)
+
+	a := alert.NewWebhookAlert(srv.URL, time.Second, nil)
+
+	// the error is swallowed; the alert must not panic or block
+	a.Trigger("topic", "detail")
+	alert.Flush(a)
+	require.Len(t, *received, 1)
+}
+
+func TestWebhookAlertQueueFull(t *testing.T) {
+	started := make(chan struct{}, 1)
+	unblock := make(chan struct{})
+	srv, received := newRecordingServer[alert.WebhookPayload](t, http.StatusOK)
+	blockingSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-unblock
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(blockingSrv.Close)
+
+	a := alert.NewWebhookAlertWithQueueSize(blockingSrv.URL, 5*time.Second, 2)
+
+	// the first alert is being sent and blocks the queue of two alerts.
+	a.Trigger("topic-1", "detail")
+	<-started
+
+	// the callers are never blocked; the alerts beyond the queue are dropped.
+	start := time.Now()
+	for i := 2; i <= 5; i++ {
+		a.Trigger(fmt.Sprintf("topic-%d", i), "detail")
+	}
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, uint64(2), alert.DroppedCount(a))
+
+	close(unblock)
+	alert.Flush(a)
+	require.Len(t, *received, 3)
+	require.Equal(t, []string{"topic-1", "topic-2", "topic-3"}, []string{
+		(*received)[0].Topic,
+		(*received)[1].Topic,
+		(*received)[2].Topic,
+	})
+}
+
+func TestWebhookAlertClose(t *testing.T) {
+	srv, received := newRecordingServer[alert.WebhookPayload](t, http.StatusOK)
+
+	a := alert.NewThresholdAlert(alert.NewWebhookAlert(srv.URL, time.Second, nil), alert.ThresholdConfig{}, nil)
+
+	// the queued alerts are sent before closing.
+	a.Trigger("topic-1", "detail")
+	a.Trigger("topic-2", "detail")
+	alert.Close(a)
+	require.Len(t, *received, 2)
+
+	// the alerts after closing are dropped, and closing again does not block.
+	a.Trigger("topic-3", "detail")
+	alert.Close(a)
+	require.Len(t, *received, 2)
+}
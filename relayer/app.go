@@ -4,18 +4,26 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/bsv-blockchain/go-sdk/compat/bip39"
 
+	"github.com/bandprotocol/falcon/internal/relayeradmin"
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/band"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
 	"github.com/bandprotocol/falcon/relayer/chains"
+	chaintypes "github.com/bandprotocol/falcon/relayer/chains/types"
 	"github.com/bandprotocol/falcon/relayer/config"
 	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/logger"
 	"github.com/bandprotocol/falcon/relayer/store"
 	"github.com/bandprotocol/falcon/relayer/types"
+	"github.com/bandprotocol/falcon/relayer/wallet"
 )
 
 var _ Application = &App{}
@@ -26,12 +34,20 @@ type App struct {
 	Config *config.Config
 	Store  store.Store
 
-	TargetChains chains.ChainProviders
-	BandClient   band.Client
-	Passphrase   string
-	DbPath       string
+	TargetChains    chains.ChainProviders
+	BandClient      band.Client
+	SigningVerifier chains.SigningVerifier
+	Passphrase      string
+	DbPath          string
 
 	Alert alert.Alert
+
+	// the state of the running relayer, set by Start and updated by Reload.
+	reloadMu      sync.Mutex
+	runCtx        context.Context
+	scheduler     *Scheduler
+	database      db.Database
+	runningChains map[string]*runningChain
 }
 
 // NewApp creates a new App instance.
@@ -62,20 +78,37 @@ func (a *App) Init(ctx context.Context) error {
 		return nil
 	}
 
+	if a.Config.Global.PenaltySkipRounds > 0 {
+		a.Log.Warn(
+			"Config penalty_skip_rounds is deprecated and ignored, use [global.penalties] instead",
+			"penalty_skip_rounds", a.Config.Global.PenaltySkipRounds,
+		)
+	}
+
+	// initialize BandChain client, before the target chains verifying the signings with it
+	a.initBandClient()
+
 	// initialize target chain clients
 	if err := a.initTargetChains(); err != nil {
 		return err
 	}
 
-	// initialize BandChain client
-	a.initBandClient()
-
 	return nil
 }
 
 // initBandClient initializes BandChain client.
 func (a *App) initBandClient() {
 	a.BandClient = band.NewClient(nil, a.Log, &a.Config.BandChain, a.Alert)
+	if a.Config.BandChain.TunnelsRefreshInterval > 0 {
+		a.BandClient = band.NewCachedClient(a.BandClient, a.Log, &a.Config.BandChain)
+	}
+
+	// verify the signings of the packets before relaying them with all chain providers
+	if a.Config.BandChain.VerifySignatures {
+		a.SigningVerifier = chains.NewTSSSigningVerifier(a.Log, a.BandClient)
+	} else {
+		a.SigningVerifier = nil
+	}
 }
 
 // connectBandClient establishes connection to rpc endpoints.
@@ -100,26 +133,40 @@ func (a *App) InitTargetChain(chainName string) error {
 		return fmt.Errorf("chain config not found: %s", chainName)
 	}
 
+	cp, err := a.newChainProvider(chainName, chainConfig)
+	if err != nil {
+		return err
+	}
+
+	a.TargetChains[chainName] = cp
+
+	return nil
+}
+
+// newChainProvider creates the chain provider of the given chain from its config.
+func (a *App) newChainProvider(
+	chainName string,
+	chainConfig chains.ChainProviderConfig,
+) (chains.ChainProvider, error) {
 	wallet, err := a.Store.NewWallet(chainConfig.GetChainType(), chainName, a.Passphrase)
 	if err != nil {
 		a.Log.Error("Wallet registry not found",
 			"chain_name", chainName,
 			err,
 		)
-		return err
+		return nil, err
 	}
-	cp, err := chainConfig.NewChainProvider(chainName, a.Log, wallet, a.Alert)
+
+	cp, err := chainConfig.NewChainProvider(chainName, a.Log, wallet, a.Alert, a.SigningVerifier)
 	if err != nil {
 		a.Log.Error("Cannot create chain provider",
 			"chain_name", chainName,
 			err,
 		)
-		return err
+		return nil, err
 	}
 
-	a.TargetChains[chainName] = cp
-
-	return nil
+	return cp, nil
 }
 
 // initTargetChains initializes the target chains.
@@ -222,6 +269,35 @@ func (a *App) QueryTunnelPacketInfo(ctx context.Context, tunnelID uint64, sequen
 	return a.BandClient.GetTunnelPacket(ctx, tunnelID, sequence)
 }
 
+// QueryDecodedTunnelPacket queries the packet of the tunnel and decodes its TSS message by the
+// encoder of the tunnel route, cross-checking it against the signal prices of the packet.
+func (a *App) QueryDecodedTunnelPacket(
+	ctx context.Context,
+	tunnelID uint64,
+	sequence uint64,
+) (*types.DecodedPacket, error) {
+	if a.Config == nil {
+		return nil, fmt.Errorf("config is not initialized")
+	}
+
+	// connect BandChain client
+	if err := a.connectBandClient(ctx); err != nil {
+		return nil, err
+	}
+
+	tunnel, err := a.BandClient.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		return nil, err
+	}
+
+	packet, err := a.BandClient.GetTunnelPacket(ctx, tunnelID, sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodePacket(packet, tunnel.Encoder)
+}
+
 // AddChainConfig adds a new chain configuration to the config file.
 func (a *App) AddChainConfig(chainName string, filePath string) error {
 	if a.Config == nil {
@@ -424,14 +500,138 @@ func (a *App) QueryBalance(ctx context.Context, chainName string, keyName string
 	return cp.QueryBalance(ctx, signer.GetAddress())
 }
 
+// SweepKeys transfers the balances of the given keys, or of every key if no key name is
+// given, to the destination. A key that fails to be swept is reported in its output and
+// does not stop the other keys from being swept.
+func (a *App) SweepKeys(
+	ctx context.Context,
+	chainName string,
+	keyNames []string,
+	destination string,
+	dryRun bool,
+) ([]*types.SweepOutput, error) {
+	cp, err := a.getChainProvider(chainName)
+	if err != nil {
+		return nil, err
+	}
+
+	sweeper, ok := cp.(chains.SignerSweeper)
+	if !ok {
+		return nil, fmt.Errorf("keys sweep is not supported for chain type: %s", cp.ChainType())
+	}
+
+	var signers []wallet.Signer
+	if len(keyNames) == 0 {
+		signers = cp.GetWallet().GetSigners()
+		slices.SortFunc(signers, func(a, b wallet.Signer) int {
+			return strings.Compare(a.GetName(), b.GetName())
+		})
+	}
+
+	for _, keyName := range keyNames {
+		signer, ok := cp.GetWallet().GetSigner(keyName)
+		if !ok {
+			return nil, fmt.Errorf("key name does not exist: %s", keyName)
+		}
+		signers = append(signers, signer)
+	}
+
+	outputs := make([]*types.SweepOutput, 0, len(signers))
+	for _, signer := range signers {
+		output := types.NewSweepOutput(signer.GetName(), signer.GetAddress(), destination)
+		outputs = append(outputs, output)
+
+		if strings.EqualFold(signer.GetAddress(), destination) {
+			output.Error = "destination is the address of the key"
+			continue
+		}
+
+		result, err := sweeper.SweepSigner(ctx, signer, destination, dryRun)
+		if err != nil {
+			output.Error = err.Error()
+			continue
+		}
+
+		output.Balance = result.Balance.String()
+		output.Fee = result.Fee.String()
+		output.Reserve = result.Reserve.String()
+		output.Amount = result.Amount.String()
+		output.TxHash = result.TxHash
+		if result.TxHash != "" {
+			output.Status = result.Status.String()
+		}
+	}
+
+	return outputs, nil
+}
+
+// QueryTransactions retrieves the relayed transactions matching the given filter from the database.
+func (a *App) QueryTransactions(filter db.TransactionFilter) ([]db.Transaction, error) {
+	database, err := a.getDatabase()
+	if err != nil {
+		return nil, err
+	}
+	defer a.closeDatabaseConnection(database)
+
+	return database.GetTransactions(filter)
+}
+
+// QueryTransaction retrieves the relayed transaction of the given hash from the database.
+func (a *App) QueryTransaction(txHash string) (*db.Transaction, error) {
+	database, err := a.getDatabase()
+	if err != nil {
+		return nil, err
+	}
+	defer a.closeDatabaseConnection(database)
+
+	return database.GetTransactionByHash(txHash)
+}
+
+// MigrateDatabase applies all pending migrations to the database.
+func (a *App) MigrateDatabase(ctx context.Context) ([]db.MigrationResult, error) {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return nil, err
+	}
+	defer migrator.Close()
+
+	return migrator.Migrate(ctx)
+}
+
+// RollbackDatabase rolls back the latest applied migration of the database.
+func (a *App) RollbackDatabase(ctx context.Context) (db.MigrationResult, error) {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return db.MigrationResult{}, err
+	}
+	defer migrator.Close()
+
+	return migrator.Rollback(ctx)
+}
+
+// GetDatabaseStatus retrieves the status of the migrations of the database.
+func (a *App) GetDatabaseStatus(ctx context.Context) ([]db.MigrationStatus, error) {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return nil, err
+	}
+	defer migrator.Close()
+
+	return migrator.Status(ctx)
+}
+
 // Start starts the tunnel relayer program.
-func (a *App) Start(ctx context.Context, tunnelIDs []uint64, tunnelCreator string) error {
+func (a *App) Start(ctx context.Context, tunnelIDs []uint64, tunnelCreator string) (err error) {
+	// send the queued alerts before exiting
+	defer alert.Close(a.Alert)
+
 	// connect BandChain client
 	if err := a.connectBandClient(ctx); err != nil {
 		return err
 	}
 
 	a.Log.Info("Starting tunnel relayer")
+	startedAt := time.Now()
 
 	// validate passphrase
 	if err := a.Store.ValidatePassphrase(a.Passphrase); err != nil {
@@ -440,8 +640,11 @@ func (a *App) Start(ctx context.Context, tunnelIDs []uint64, tunnelCreator strin
 
 	// init database
 	var database db.Database
-	var err error
 	if a.DbPath != "" {
+		if err := a.ensureDatabaseSchema(ctx); err != nil {
+			return err
+		}
+
 		database, err = a.InitDatabase(a.DbPath)
 		if err != nil {
 			return err
@@ -449,21 +652,37 @@ func (a *App) Start(ctx context.Context, tunnelIDs []uint64, tunnelCreator strin
 		a.Log.Info("Connected to Database")
 	}
 
+	// close the database once the tunnel relayers are stopped, or if they fail to start
+	defer func() {
+		if closeErr := a.closeDatabase(database, startedAt); err == nil {
+			err = closeErr
+		}
+	}()
+
 	// initialize target chain providers
 	for chainName, chainProvider := range a.TargetChains {
-		if err := chainProvider.Init(ctx); err != nil {
-			a.Log.Error("Cannot initialize chain provider",
-				"chain_name", chainName,
-				err,
-			)
+		rc, err := a.prepareTargetChain(ctx, chainName, chainProvider, a.Config.TargetChains, database)
+		if err != nil {
 			return err
 		}
 
-		chainProvider.SetDatabase(database)
+		a.runTargetChain(chainName, rc)
 	}
 
+	a.reconcilePendingTransactions(ctx, database, startedAt)
+
+	a.registerHealthChecks(database)
+
 	// start the tunnel relayers
-	scheduler := NewScheduler(a.Log, a.Config, a.BandClient, a.TargetChains, tunnelCreator, a.Alert)
+	scheduler := NewScheduler(
+		a.Log,
+		a.Config,
+		a.BandClient,
+		a.TargetChains,
+		tunnelCreator,
+		a.Alert,
+		a.getCheckpointStore(database),
+	)
 
 	// if tunnelIDs is provided, set the tunnels to the scheduler
 	if len(tunnelIDs) > 0 {
@@ -475,12 +694,148 @@ func (a *App) Start(ctx context.Context, tunnelIDs []uint64, tunnelCreator strin
 		scheduler = scheduler.WithTunnels(tunnels)
 	}
 
+	// start the admin server to control the tunnel relayers, if configured
+	if a.Config.Global.AdminListenAddr != "" {
+		if err := relayeradmin.StartAdminServer(
+			ctx,
+			a.Log,
+			a.Config.Global.AdminListenAddr,
+			a.Config.Global.AdminToken,
+			adminController{Scheduler: scheduler, app: a},
+		); err != nil {
+			return err
+		}
+	}
+
+	// allow reloading the config of the running relayer
+	a.reloadMu.Lock()
+	a.runCtx = ctx
+	a.database = database
+	a.scheduler = scheduler
+	a.reloadMu.Unlock()
+
+	go a.reloadOnSignal(ctx)
+
 	isSyncTunnels := len(tunnelIDs) == 0
 	return scheduler.Start(ctx, isSyncTunnels)
 }
 
+// reconcilePendingTransactions reconciles the transactions created before the given time that
+// are still pending, i.e. left by a previous run that was stopped before they were confirmed.
+// Their status is looked up on the chain if the chain provider supports it; otherwise they are
+// marked as timed out, as no relayer waits for them anymore.
+func (a *App) reconcilePendingTransactions(ctx context.Context, database db.Database, before time.Time) {
+	if database == nil {
+		return
+	}
+
+	pendingTxs, err := database.GetTransactions(db.TransactionFilter{
+		Status: chaintypes.TX_STATUS_PENDING,
+		To:     &before,
+	})
+	if err != nil {
+		a.Log.Error("Failed to get the pending transactions", err)
+		return
+	}
+
+	for _, tx := range pendingTxs {
+		log := a.Log.With(
+			"chain_name", tx.ChainName,
+			"tunnel_id", tx.TunnelID,
+			"sequence", tx.Sequence,
+			"tx_hash", tx.TxHash,
+		)
+
+		if reconciler, ok := a.TargetChains[tx.ChainName].(chains.TxReconciler); ok {
+			tx, err = reconciler.ReconcileTransaction(ctx, tx)
+			if err != nil {
+				log.Error("Failed to reconcile the pending transaction", err)
+				continue
+			}
+		} else {
+			tx.Status = chaintypes.TX_STATUS_TIMEOUT
+		}
+
+		if tx.Status == chaintypes.TX_STATUS_PENDING {
+			log.Warn("Transaction of the previous run is still pending")
+			continue
+		}
+
+		// only the status of the transaction is updated; the signal prices are already stored.
+		tx.SignalPrices = nil
+		tx.UpdatedAt = time.Now()
+		if err := database.AddOrUpdateTransaction(&tx); err != nil {
+			log.Error("Failed to save the reconciled transaction", err)
+			continue
+		}
+
+		log.Info("Reconciled the pending transaction of the previous run", "status", tx.Status)
+	}
+}
+
+// closeDatabase logs the transactions broadcasted since the given time that are still pending
+// once the relays are drained, so that they can be reconciled on the next start, and closes
+// the database.
+func (a *App) closeDatabase(database db.Database, since time.Time) error {
+	if database == nil {
+		return nil
+	}
+
+	pendingTxs, err := database.GetTransactions(db.TransactionFilter{
+		Status: chaintypes.TX_STATUS_PENDING,
+		From:   &since,
+	})
+	if err != nil {
+		a.Log.Error("Failed to get the pending transactions", err)
+	}
+
+	for _, tx := range pendingTxs {
+		a.Log.Warn(
+			"Transaction is still pending on shutdown",
+			"chain_name", tx.ChainName,
+			"tunnel_id", tx.TunnelID,
+			"sequence", tx.Sequence,
+			"tx_hash", tx.TxHash,
+		)
+	}
+
+	if err := database.Close(); err != nil {
+		a.Log.Error("Failed to close the database", err)
+		return err
+	}
+	a.Log.Info("Closed the database")
+
+	return nil
+}
+
+// registerHealthChecks registers the health checks of the BandChain client and the database,
+// served by the readiness probe. They are not part of the liveness probe, as restarting the
+// relayer does not help when BandChain or the database is unreachable.
+func (a *App) registerHealthChecks(database db.Database) {
+	relayerhealth.RegisterReadiness("bandchain", relayerhealth.CheckerFunc(
+		func(context.Context) relayerhealth.ComponentHealth { return a.BandClient.GetHealth() },
+	))
+	relayerhealth.RegisterReadiness("bandchain_subscription", relayerhealth.CheckerFunc(
+		func(context.Context) relayerhealth.ComponentHealth { return a.BandClient.GetSubscriptionHealth() },
+	))
+
+	if database != nil {
+		relayerhealth.RegisterReadiness("database", relayerhealth.CheckerFunc(
+			func(ctx context.Context) relayerhealth.ComponentHealth {
+				if err := database.Ping(ctx); err != nil {
+					return relayerhealth.Down(err, nil)
+				}
+				return relayerhealth.Up(nil)
+			},
+		))
+	}
+}
+
 // Relay relays the packet from the source chain to the destination chain.
 func (a *App) Relay(ctx context.Context, tunnelID uint64, isForce bool) error {
+	// send the queued alerts before exiting
+	defer alert.Close(a.Alert)
+
 	// connect BandChain client
 	if err := a.connectBandClient(ctx); err != nil {
 		return err
@@ -501,6 +856,20 @@ func (a *App) Relay(ctx context.Context, tunnelID uint64, isForce bool) error {
 		return fmt.Errorf("target chain provider not found: %s", tunnel.TargetChainID)
 	}
 
+	var database db.Database
+	if a.DbPath != "" {
+		if err := a.ensureDatabaseSchema(ctx); err != nil {
+			return err
+		}
+
+		database, err = a.InitDatabase(a.DbPath)
+		if err != nil {
+			return err
+		}
+		defer a.closeDatabaseConnection(database)
+	}
+
+	chainCfg := getBaseChainConfig(a.Config.TargetChains, tunnel.TargetChainID)
 	tr := NewTunnelRelayer(
 		a.Log,
 		tunnel.ID,
@@ -508,6 +877,11 @@ func (a *App) Relay(ctx context.Context, tunnelID uint64, isForce bool) error {
 		a.BandClient,
 		chainProvider,
 		a.Alert,
+		a.getCheckpointStore(database),
+		a.Config.Global.CatchUpWindow,
+		a.Config.Global.SigningMaxWait,
+		chainCfg.MinTunnelBalance,
+		chainCfg.PauseOnLowTunnelBalance,
 	)
 
 	_, err = tr.CheckAndRelay(ctx, isForce)
@@ -541,6 +915,82 @@ func (a *App) getChainProvider(chainName string) (chains.ChainProvider, error) {
 	return cp, nil
 }
 
+// getDatabase connects to the database of the given db path. The caller must close the
+// connection once done, see closeDatabaseConnection.
+func (a *App) getDatabase() (db.Database, error) {
+	if a.DbPath == "" {
+		return nil, fmt.Errorf("database is not configured")
+	}
+
+	return a.InitDatabase(a.DbPath)
+}
+
+// closeDatabaseConnection closes the connection of the given database opened by the command.
+func (a *App) closeDatabaseConnection(database db.Database) {
+	if err := database.Close(); err != nil {
+		a.Log.Error("Failed to close the database", err)
+	}
+}
+
+// getMigrator creates a migrator of the database of the given db path.
+func (a *App) getMigrator() (*db.Migrator, error) {
+	if a.DbPath == "" {
+		return nil, fmt.Errorf("database is not configured")
+	}
+
+	return db.NewMigrator(a.DbPath)
+}
+
+// ensureDatabaseSchema checks that the schema of the database is up to date. If the schema
+// is behind the embedded migrations, the pending migrations are applied when auto_migrate
+// is enabled; otherwise, an error is returned.
+func (a *App) ensureDatabaseSchema(ctx context.Context) error {
+	migrator, err := a.getMigrator()
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	current, latest, err := migrator.Versions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if current >= latest {
+		return nil
+	}
+
+	if a.Config == nil || !a.Config.Global.AutoMigrate {
+		return fmt.Errorf(
+			"database schema version %d is behind the latest version %d; run `falcon db migrate` or enable auto_migrate",
+			current,
+			latest,
+		)
+	}
+
+	a.Log.Info("Migrating database", "current_version", current, "latest_version", latest)
+	results, err := migrator.Migrate(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		a.Log.Info("Applied migration", "version", result.Version, "name", result.Name)
+	}
+
+	return nil
+}
+
+// getCheckpointStore returns the store of relay checkpoints; the given database if it is
+// configured, otherwise the application's store under the home directory.
+func (a *App) getCheckpointStore(database db.Database) db.CheckpointStore {
+	if database != nil {
+		return database
+	}
+
+	return a.Store
+}
+
 // generateMnemonic creates a BIP-39 mnemonic with the requested entropy size.
 func generateMnemonic(bitSize int) (string, error) {
 	entropy, err := bip39.NewEntropy(bitSize)
@@ -3,18 +3,23 @@ package relayer_test
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math/big"
 	"os"
 	"path"
 	"testing"
 	"time"
 
 	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 
+	feedstypes "github.com/bandprotocol/falcon/internal/bandchain/feeds"
 	tsstypes "github.com/bandprotocol/falcon/internal/bandchain/tss"
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/internal/relayertest"
 	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
 	"github.com/bandprotocol/falcon/relayer"
@@ -25,6 +30,7 @@ import (
 	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
 	"github.com/bandprotocol/falcon/relayer/chains/xrpl"
 	"github.com/bandprotocol/falcon/relayer/config"
+	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/logger"
 	"github.com/bandprotocol/falcon/relayer/types"
 	"github.com/bandprotocol/falcon/relayer/wallet"
@@ -483,6 +489,95 @@ func (s *AppTestSuite) TestQueryTunnelPacketInfo() {
 	s.Require().Equal(expected, packet)
 }
 
+func (s *AppTestSuite) TestQueryDecodedTunnelPacket() {
+	// TickABI-encoded TSS message with sequence=3, prices=[{CS:BAND-USD, tick 0xf188}], createdAt=123
+	message, err := hex.DecodeString(
+		"0000000000000000000000000000000000000000000000000000000000000000" +
+			"0000000000000000000000000000000000000000" +
+			"db99b2b3" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000003" +
+			"0000000000000000000000000000000000000000000000000000000000000060" +
+			"000000000000000000000000000000000000000000000000000000000000007b" +
+			"0000000000000000000000000000000000000000000000000000000000000001" +
+			"00000000000000000000000000000000000000000043533a42414e442d555344" +
+			"000000000000000000000000000000000000000000000000000000000000f188",
+	)
+	s.Require().NoError(err)
+
+	signing := bandtypes.NewSigning(
+		1,
+		message,
+		bandtypes.NewEVMSignature(cmbytes.HexBytes("0x1234"), cmbytes.HexBytes("0xabcd")),
+		tsstypes.SIGNING_STATUS_SUCCESS,
+	)
+
+	testcases := []struct {
+		name         string
+		encoder      feedstypes.Encoder
+		signalPrices []bandtypes.SignalPrice
+		mismatches   []string
+		err          string
+	}{
+		{
+			name:         "success",
+			encoder:      feedstypes.ENCODER_TICK_ABI,
+			signalPrices: []bandtypes.SignalPrice{{SignalID: "CS:BAND-USD", Price: 2}},
+			mismatches:   []string{},
+		},
+		{
+			name:         "mismatched encoder and price",
+			encoder:      feedstypes.ENCODER_FIXED_POINT_ABI,
+			signalPrices: []bandtypes.SignalPrice{{SignalID: "CS:BAND-USD", Price: 2}},
+			mismatches: []string{
+				"message is encoded by ENCODER_TICK_ABI instead of ENCODER_FIXED_POINT_ABI of the tunnel",
+				"price 61832 of signal CS:BAND-USD does not match 2 of the packet",
+			},
+		},
+		{
+			name:         "mismatched signal",
+			encoder:      feedstypes.ENCODER_TICK_ABI,
+			signalPrices: []bandtypes.SignalPrice{{SignalID: "CS:ETH-USD", Price: 2}},
+			mismatches:   []string{"signal CS:BAND-USD differs from CS:ETH-USD of the packet"},
+		},
+		{
+			name:    "unsupported encoder",
+			encoder: feedstypes.ENCODER_UNSPECIFIED,
+			err:     "unsupported encoder",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			tunnel := bandtypes.NewTunnel(1, 3, "0xe00F1f85abDB2aF6760759547d450da68CE66Bb1", "testnet_evm", true, "")
+			tunnel.Encoder = tc.encoder
+
+			s.client.EXPECT().GetTunnel(gomock.Any(), uint64(1)).Return(tunnel, nil)
+			s.client.EXPECT().
+				GetTunnelPacket(gomock.Any(), uint64(1), uint64(3)).
+				Return(bandtypes.NewPacket(1, 3, tc.signalPrices, signing, nil, 123), nil)
+
+			packet, err := s.app.QueryDecodedTunnelPacket(context.Background(), 1, 3)
+			if tc.err != "" {
+				s.Require().ErrorContains(err, tc.err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), packet.TunnelID)
+			s.Require().Equal(uint64(1), packet.SigningID)
+			s.Require().Equal(tc.encoder.String(), packet.Encoder)
+			s.Require().Equal(hex.EncodeToString(make([]byte, 32)), packet.OriginatorHash)
+			s.Require().Equal(uint64(3), packet.Sequence)
+			s.Require().Equal(time.Unix(123, 0).UTC(), packet.CreatedAt)
+			s.Require().Len(packet.SignalPrices, 1)
+			s.Require().Equal("CS:BAND-USD", packet.SignalPrices[0].SignalID)
+			s.Require().Equal(uint64(0xf188), packet.SignalPrices[0].EncodedPrice)
+			s.Require().Equal(tc.mismatches, packet.Mismatches)
+		})
+	}
+}
+
 func (s *AppTestSuite) TestInitPassphrase() {
 	ctrl := gomock.NewController(s.T())
 	newStoreMock := mocks.NewMockStore(ctrl)
@@ -827,3 +922,166 @@ func (s *AppTestSuite) TestShowKey() {
 		})
 	}
 }
+
+func (s *AppTestSuite) TestSweepKeys() {
+	destination := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+
+	// the chain provider does not support sweeping keys.
+	s.chainProvider.EXPECT().ChainType().Return(chainstypes.ChainTypeEVM)
+	_, err := s.app.SweepKeys(context.Background(), "testnet_evm", nil, destination, true)
+	s.Require().ErrorContains(err, "keys sweep is not supported for chain type: evm")
+
+	sweeper := mocks.NewMockSignerSweeper(s.ctrl)
+	sweeper.EXPECT().GetWallet().Return(s.wallet).AnyTimes()
+	s.app.TargetChains["testnet_evm"] = sweeper
+
+	signers := make([]wallet.Signer, 0, 3)
+	for i, address := range []string{
+		"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		destination,
+	} {
+		signer := mocks.NewMockSigner(s.ctrl)
+		signer.EXPECT().GetName().Return(fmt.Sprintf("key%d", 3-i)).AnyTimes()
+		signer.EXPECT().GetAddress().Return(address).AnyTimes()
+		signers = append(signers, signer)
+	}
+	s.wallet.EXPECT().GetSigners().Return(signers)
+
+	sweeper.EXPECT().
+		SweepSigner(gomock.Any(), gomock.Any(), destination, false).
+		DoAndReturn(func(_ context.Context, signer wallet.Signer, _ string, _ bool) (chains.SweepResult, error) {
+			if signer.GetName() == "key2" {
+				return chains.SweepResult{}, fmt.Errorf("failed to broadcast tx")
+			}
+			return chains.NewSweepResult(big.NewInt(1000), big.NewInt(100), big.NewInt(0)), nil
+		}).
+		Times(2)
+
+	outputs, err := s.app.SweepKeys(context.Background(), "testnet_evm", nil, destination, false)
+	s.Require().NoError(err)
+
+	// the outputs are sorted by key name and the key of the destination is not swept.
+	s.Require().Equal([]*types.SweepOutput{
+		{
+			KeyName:     "key1",
+			Address:     destination,
+			Destination: destination,
+			Error:       "destination is the address of the key",
+		},
+		{
+			KeyName:     "key2",
+			Address:     "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+			Destination: destination,
+			Error:       "failed to broadcast tx",
+		},
+		{
+			KeyName:     "key3",
+			Address:     "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+			Destination: destination,
+			Balance:     "1000",
+			Fee:         "100",
+			Reserve:     "0",
+			Amount:      "900",
+		},
+	}, outputs)
+
+	s.wallet.EXPECT().GetSigner("unknown").Return(nil, false)
+	_, err = s.app.SweepKeys(context.Background(), "testnet_evm", []string{"unknown"}, destination, false)
+	s.Require().ErrorContains(err, "key name does not exist: unknown")
+}
+
+func (s *AppTestSuite) TestStartWithOutdatedDatabaseSchema() {
+	s.store.EXPECT().ValidatePassphrase(s.passphrase).Return(nil).AnyTimes()
+	s.app.DbPath = "sqlite:///" + path.Join(s.T().TempDir(), "falcon.db")
+
+	// refuse to start if auto_migrate is disabled.
+	err := s.app.Start(context.Background(), []uint64{}, "")
+	s.Require().ErrorContains(err, "run `falcon db migrate`")
+
+	statuses, err := s.app.GetDatabaseStatus(context.Background())
+	s.Require().NoError(err)
+	for _, status := range statuses {
+		s.Require().False(status.Applied)
+	}
+
+	// migrate the database before initializing the chain providers if auto_migrate is enabled.
+	s.app.Config.Global.AutoMigrate = true
+	s.chainProvider.EXPECT().Init(gomock.Any()).Return(fmt.Errorf("stop"))
+
+	err = s.app.Start(context.Background(), []uint64{}, "")
+	s.Require().ErrorContains(err, "stop")
+
+	statuses, err = s.app.GetDatabaseStatus(context.Background())
+	s.Require().NoError(err)
+	for _, status := range statuses {
+		s.Require().True(status.Applied)
+	}
+}
+
+func (s *AppTestSuite) TestReconcilePendingTransactions() {
+	s.app.DbPath = "sqlite:///" + path.Join(s.T().TempDir(), "falcon.db")
+	_, err := s.app.MigrateDatabase(context.Background())
+	s.Require().NoError(err)
+
+	database, err := s.app.InitDatabase(s.app.DbPath)
+	s.Require().NoError(err)
+	defer database.Close()
+
+	startedAt := time.Now()
+	newTx := func(txHash string, createdAt time.Time) *db.Transaction {
+		tx := db.NewTransaction(
+			txHash,
+			1,
+			1,
+			"testnet_evm",
+			chainstypes.ChainTypeEVM,
+			"0xsender",
+			chainstypes.TX_STATUS_PENDING,
+			decimal.NullDecimal{},
+			decimal.NullDecimal{},
+			decimal.NullDecimal{},
+			[]db.SignalPrice{*db.NewSignalPrice("signal1", 100)},
+			nil,
+			nil,
+		)
+		tx.CreatedAt = createdAt
+		return tx
+	}
+
+	s.Require().NoError(database.AddOrUpdateTransaction(newTx("0xprevious", startedAt.Add(-time.Minute))))
+	s.Require().NoError(database.AddOrUpdateTransaction(newTx("0xcurrent", startedAt.Add(time.Second))))
+
+	// the chain provider cannot look up its transactions, so the transaction of the previous
+	// run is marked as timed out.
+	s.app.ReconcilePendingTransactions(context.Background(), database, startedAt)
+
+	tx, err := database.GetTransactionByHash("0xprevious")
+	s.Require().NoError(err)
+	s.Require().Equal(chainstypes.TX_STATUS_TIMEOUT, tx.Status)
+	s.Require().Len(tx.SignalPrices, 1)
+
+	tx, err = database.GetTransactionByHash("0xcurrent")
+	s.Require().NoError(err)
+	s.Require().Equal(chainstypes.TX_STATUS_PENDING, tx.Status)
+}
+
+func (s *AppTestSuite) TestRegisterHealthChecks() {
+	s.client.EXPECT().GetHealth().Return(relayerhealth.Down(fmt.Errorf("connection refused"), nil)).AnyTimes()
+	s.client.EXPECT().GetSubscriptionHealth().Return(relayerhealth.Up(nil)).AnyTimes()
+
+	s.app.RegisterHealthChecks(nil)
+	defer func() {
+		relayerhealth.Unregister("bandchain")
+		relayerhealth.Unregister("bandchain_subscription")
+	}()
+
+	// the relayer stays live while BandChain is unreachable, but is not ready.
+	liveness := relayerhealth.DefaultRegistry().Liveness(context.Background())
+	s.Require().Equal(relayerhealth.StatusUp, liveness.Status)
+	s.Require().NotContains(liveness.Components, "bandchain")
+
+	readiness := relayerhealth.DefaultRegistry().Readiness(context.Background())
+	s.Require().Equal(relayerhealth.StatusDown, readiness.Status)
+	s.Require().Equal("connection refused", readiness.Components["bandchain"].Error)
+}
@@ -0,0 +1,352 @@
+package band
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tsstypes "github.com/bandprotocol/falcon/internal/bandchain/tss"
+	"github.com/bandprotocol/falcon/internal/relayermetrics"
+	"github.com/bandprotocol/falcon/relayer/band/subscriber"
+	"github.com/bandprotocol/falcon/relayer/band/types"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+const (
+	tunnelCacheName = "tunnel"
+	packetCacheName = "packet"
+)
+
+var (
+	_ Client             = &cachedClient{}
+	_ SigningInvalidator = &cachedClient{}
+)
+
+// SigningInvalidator is a Client caching the packets whose signing is not resolved yet, which
+// are invalidated once the signing is resolved.
+type SigningInvalidator interface {
+	// InvalidateSigning invalidates the cached packets waiting for the signing of the given ID.
+	InvalidateSigning(signingID uint64)
+}
+
+// packetKey is the key of a packet in the cache.
+type packetKey struct {
+	tunnelID uint64
+	sequence uint64
+}
+
+// pendingPacket is a packet whose signing is not successful yet, as of the given block height.
+type pendingPacket struct {
+	packet *types.Packet
+	height int64
+}
+
+// cachedClient is a Client sharing the BandChain queries of all tunnel relayers. It serves
+// the tunnels from a snapshot of all tunnels, which is refreshed by a single query once a new
+// block is produced or the snapshot is older than the refresh interval. The packets are cached
+// within a block until their signing is successful, and then for good.
+//
+// The tunnel snapshot and the pending packets are bypassed while the client is not subscribed
+// to the new blocks, as it cannot tell whether they are up-to-date.
+type cachedClient struct {
+	Client
+
+	log                logger.Logger
+	refreshInterval    time.Duration
+	packetCacheSize    int
+	newBlockSubscriber subscriber.Subscriber
+	isSubscribed       func() bool
+
+	latestHeight atomic.Int64
+
+	// refreshMu serializes the refreshes of the tunnel snapshot, so that the tunnel relayers
+	// waiting for it share a single query.
+	refreshMu sync.Mutex
+
+	// mu guards the tunnel snapshot and the packets.
+	mu                 sync.RWMutex
+	tunnels            map[uint64]types.Tunnel
+	tunnelsHeight      int64
+	tunnelsRefreshedAt time.Time
+	signedPackets      map[packetKey]*types.Packet
+	signedPacketKeys   []packetKey
+	pendingPackets     map[packetKey]pendingPacket
+	// resolvedSignings are the signings resolved within the latest block, whose packets are not
+	// cached as pending anymore.
+	resolvedSignings map[uint64]struct{}
+}
+
+// NewCachedClient creates a new Client caching the BandChain queries of the given client. The
+// defaults are used for the refresh interval and the packet cache size that are not set.
+func NewCachedClient(client Client, log logger.Logger, bandChainCfg *Config) Client {
+	refreshInterval := bandChainCfg.TunnelsRefreshInterval
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultTunnelsRefreshInterval
+	}
+
+	packetCacheSize := bandChainCfg.PacketCacheSize
+	if packetCacheSize <= 0 {
+		packetCacheSize = DefaultPacketCacheSize
+	}
+
+	c := &cachedClient{
+		Client:          client,
+		log:             log.With("component", "band_cache"),
+		refreshInterval: refreshInterval,
+		packetCacheSize: packetCacheSize,
+		signedPackets:   make(map[packetKey]*types.Packet),
+		pendingPackets:  make(map[packetKey]pendingPacket),
+
+		resolvedSignings: make(map[uint64]struct{}),
+	}
+
+	newBlockSubscriber := subscriber.NewNewBlockSubscriber(c.log, c.onNewBlock, bandChainCfg.Timeout)
+	c.newBlockSubscriber = newBlockSubscriber
+	c.isSubscribed = newBlockSubscriber.IsSubscribed
+
+	return c
+}
+
+// Init initializes the BandChain client and starts handling the new blocks.
+func (c *cachedClient) Init(ctx context.Context) error {
+	if err := c.Client.Init(ctx); err != nil {
+		return err
+	}
+
+	go c.newBlockSubscriber.HandleEvent(ctx)
+	return nil
+}
+
+// SetSubscribers sets the subscribers for the BandChain client, along with the subscriber of
+// the new blocks of the cache.
+func (c *cachedClient) SetSubscribers(subscribers []subscriber.Subscriber) {
+	c.Client.SetSubscribers(append(slices.Clone(subscribers), c.newBlockSubscriber))
+}
+
+// GetTunnel returns the tunnel with the given tunnelID from the tunnel snapshot. It falls back
+// to BandChain if the tunnel is not in the snapshot.
+func (c *cachedClient) GetTunnel(ctx context.Context, tunnelID uint64) (*types.Tunnel, error) {
+	if !c.isSubscribed() {
+		return c.Client.GetTunnel(ctx, tunnelID)
+	}
+
+	tunnels, isHit, err := c.getTunnels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tunnel, ok := tunnels[tunnelID]
+	if !ok {
+		incCacheRequestsCount(tunnelCacheName, false)
+		return c.Client.GetTunnel(ctx, tunnelID)
+	}
+
+	incCacheRequestsCount(tunnelCacheName, isHit)
+	return &tunnel, nil
+}
+
+// GetTunnels returns all tunnels in BandChain from the tunnel snapshot, sorted by tunnel ID.
+func (c *cachedClient) GetTunnels(ctx context.Context) ([]types.Tunnel, error) {
+	if !c.isSubscribed() {
+		return c.Client.GetTunnels(ctx)
+	}
+
+	tunnels, isHit, err := c.getTunnels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	incCacheRequestsCount(tunnelCacheName, isHit)
+	return slices.SortedFunc(maps.Values(tunnels), func(a, b types.Tunnel) int {
+		return cmp.Compare(a.ID, b.ID)
+	}), nil
+}
+
+// GetTunnelPacket returns the packet with the given tunnelID and sequence from the cache, or
+// from BandChain if it is not cached or its signing has changed since.
+func (c *cachedClient) GetTunnelPacket(ctx context.Context, tunnelID uint64, sequence uint64) (*types.Packet, error) {
+	key := packetKey{tunnelID: tunnelID, sequence: sequence}
+	height := c.latestHeight.Load()
+
+	if packet, ok := c.getCachedPacket(key, height); ok {
+		incCacheRequestsCount(packetCacheName, true)
+		return packet, nil
+	}
+
+	incCacheRequestsCount(packetCacheName, false)
+	packet, err := c.Client.GetTunnelPacket(ctx, tunnelID, sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setCachedPacket(key, packet, height)
+
+	// the caller may modify the returned packet, e.g. to set its target address
+	cachedPacket := *packet
+	return &cachedPacket, nil
+}
+
+// onNewBlock invalidates the tunnel snapshot and the pending packets on a new block.
+func (c *cachedClient) onNewBlock(height int64) {
+	if height <= c.latestHeight.Load() {
+		return
+	}
+	c.latestHeight.Store(height)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.pendingPackets)
+	clear(c.resolvedSignings)
+}
+
+// InvalidateSigning invalidates the pending packets waiting for the signing of the given ID,
+// so that the relayers woken by the signing event query the resolved signing from BandChain.
+func (c *cachedClient) InvalidateSigning(signingID uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.resolvedSignings[signingID] = struct{}{}
+	maps.DeleteFunc(c.pendingPackets, func(_ packetKey, pending pendingPacket) bool {
+		return hasSigning(pending.packet, signingID)
+	})
+}
+
+// getTunnels returns the tunnel snapshot, refreshing it from BandChain if it is outdated. It
+// also returns whether the snapshot is served from the cache.
+func (c *cachedClient) getTunnels(ctx context.Context) (map[uint64]types.Tunnel, bool, error) {
+	if tunnels, ok := c.getFreshTunnels(); ok {
+		return tunnels, true, nil
+	}
+
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	// the snapshot may have been refreshed while waiting for the lock
+	if tunnels, ok := c.getFreshTunnels(); ok {
+		return tunnels, true, nil
+	}
+
+	height := c.latestHeight.Load()
+	tunnelList, err := c.Client.GetTunnels(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	tunnels := make(map[uint64]types.Tunnel, len(tunnelList))
+	for _, tunnel := range tunnelList {
+		tunnels[tunnel.ID] = tunnel
+	}
+
+	c.mu.Lock()
+	c.tunnels = tunnels
+	c.tunnelsHeight = height
+	c.tunnelsRefreshedAt = time.Now()
+	c.mu.Unlock()
+
+	c.log.Debug("Refreshed the tunnel snapshot", "height", height, "tunnels", len(tunnels))
+
+	return tunnels, false, nil
+}
+
+// getFreshTunnels returns the tunnel snapshot if it is taken at the latest block and within
+// the refresh interval.
+func (c *cachedClient) getFreshTunnels() (map[uint64]types.Tunnel, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.tunnels == nil ||
+		c.tunnelsHeight < c.latestHeight.Load() ||
+		time.Since(c.tunnelsRefreshedAt) >= c.refreshInterval {
+		return nil, false
+	}
+
+	return c.tunnels, true
+}
+
+// getCachedPacket returns a copy of the cached packet of the given key. A packet whose signing
+// is not successful is only returned within the block at the given height.
+func (c *cachedClient) getCachedPacket(key packetKey, height int64) (*types.Packet, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	packet, ok := c.signedPackets[key]
+	if !ok {
+		pending, isPending := c.pendingPackets[key]
+		if !isPending || height == 0 || pending.height != height || !c.isSubscribed() {
+			return nil, false
+		}
+		packet = pending.packet
+	}
+
+	cachedPacket := *packet
+	return &cachedPacket, true
+}
+
+// setCachedPacket caches the packet of the given key queried at the given block height,
+// evicting the oldest signed packets beyond the packet cache size.
+func (c *cachedClient) setCachedPacket(key packetKey, packet *types.Packet, height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !isSigningSuccess(packet) {
+		// the packet may be queried before its signing is resolved within the same block
+		if height > 0 && height == c.latestHeight.Load() && !c.isSigningResolved(packet) {
+			c.pendingPackets[key] = pendingPacket{packet: packet, height: height}
+		}
+		return
+	}
+
+	delete(c.pendingPackets, key)
+	if _, ok := c.signedPackets[key]; ok {
+		return
+	}
+
+	c.signedPackets[key] = packet
+	c.signedPacketKeys = append(c.signedPacketKeys, key)
+	if len(c.signedPacketKeys) > c.packetCacheSize {
+		delete(c.signedPackets, c.signedPacketKeys[0])
+		c.signedPacketKeys = c.signedPacketKeys[1:]
+	}
+}
+
+// isSigningResolved checks if any signing of the packet is resolved within the latest block.
+func (c *cachedClient) isSigningResolved(packet *types.Packet) bool {
+	for signingID := range c.resolvedSignings {
+		if hasSigning(packet, signingID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasSigning checks if the packet is signed by the signing of the given ID in either group.
+func hasSigning(packet *types.Packet, signingID uint64) bool {
+	return (packet.CurrentGroupSigning != nil && packet.CurrentGroupSigning.ID == signingID) ||
+		(packet.IncomingGroupSigning != nil && packet.IncomingGroupSigning.ID == signingID)
+}
+
+// isSigningSuccess checks if the signing of the packet is successful, in which case the packet
+// does not change anymore.
+func isSigningSuccess(packet *types.Packet) bool {
+	signing := packet.CurrentGroupSigning
+	if signing == nil || signing.SigningStatus == tsstypes.SIGNING_STATUS_FALLEN {
+		signing = packet.IncomingGroupSigning
+	}
+
+	return signing != nil && signing.SigningStatus == tsstypes.SIGNING_STATUS_SUCCESS
+}
+
+// incCacheRequestsCount increments the count of the requests served by the given cache.
+func incCacheRequestsCount(cache string, isHit bool) {
+	if isHit {
+		relayermetrics.IncBandCacheRequestsCount(cache, relayermetrics.HitCacheResult)
+	} else {
+		relayermetrics.IncBandCacheRequestsCount(cache, relayermetrics.MissCacheResult)
+	}
+}
@@ -0,0 +1,176 @@
+package band_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	tsstypes "github.com/bandprotocol/falcon/internal/bandchain/tss"
+	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
+	"github.com/bandprotocol/falcon/relayer/band"
+	"github.com/bandprotocol/falcon/relayer/band/subscriber"
+	bandclienttypes "github.com/bandprotocol/falcon/relayer/band/types"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+type CachedClientTestSuite struct {
+	suite.Suite
+
+	ctx        context.Context
+	client     *mocks.MockClient
+	cached     band.Client
+	onNewBlock func(height int64)
+}
+
+func TestCachedClientTestSuite(t *testing.T) {
+	suite.Run(t, new(CachedClientTestSuite))
+}
+
+// SetupTest sets up the cached client of a mock client, subscribed to the new blocks.
+func (s *CachedClientTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	s.ctx = context.Background()
+	s.client = mocks.NewMockClient(ctrl)
+	s.cached, s.onNewBlock = band.NewCachedClientWithNewBlocks(
+		s.client,
+		logger.NewZapLogWrapper(zap.NewNop().Sugar()),
+		&band.Config{TunnelsRefreshInterval: time.Minute, PacketCacheSize: 1},
+	)
+	s.onNewBlock(1)
+}
+
+func (s *CachedClientTestSuite) TestSetSubscribers() {
+	s.client.EXPECT().SetSubscribers(gomock.Len(2))
+
+	s.cached.SetSubscribers([]subscriber.Subscriber{&subscriber.PacketSuccessSubscriber{}})
+}
+
+func (s *CachedClientTestSuite) TestGetTunnel() {
+	tunnels := []bandclienttypes.Tunnel{
+		*bandclienttypes.NewTunnel(1, 10, "0xtarget", "testnet_evm", true, ""),
+		*bandclienttypes.NewTunnel(2, 20, "0xtarget", "testnet_evm", false, ""),
+	}
+	s.client.EXPECT().GetTunnels(gomock.Any()).Return(tunnels, nil)
+
+	// the tunnels are served from a single snapshot within a block.
+	for _, expected := range tunnels {
+		tunnel, err := s.cached.GetTunnel(s.ctx, expected.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, *tunnel)
+	}
+
+	actual, err := s.cached.GetTunnels(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(tunnels, actual)
+
+	// the tunnels not in the snapshot are queried from BandChain.
+	s.client.EXPECT().GetTunnel(gomock.Any(), uint64(3)).Return(nil, fmt.Errorf("unsupported route type"))
+	_, err = s.cached.GetTunnel(s.ctx, 3)
+	s.Require().ErrorContains(err, "unsupported route type")
+
+	// a new block invalidates the snapshot.
+	tunnels[0].LatestSequence = 11
+	s.client.EXPECT().GetTunnels(gomock.Any()).Return(tunnels, nil)
+	s.onNewBlock(2)
+
+	tunnel, err := s.cached.GetTunnel(s.ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(11), tunnel.LatestSequence)
+}
+
+func (s *CachedClientTestSuite) TestGetTunnelError() {
+	s.client.EXPECT().GetTunnels(gomock.Any()).Return(nil, fmt.Errorf("connection refused"))
+
+	_, err := s.cached.GetTunnel(s.ctx, 1)
+	s.Require().ErrorContains(err, "connection refused")
+}
+
+func (s *CachedClientTestSuite) TestGetTunnelPacket() {
+	waiting := mockPacket(1, tsstypes.SIGNING_STATUS_WAITING)
+	s.client.EXPECT().GetTunnelPacket(gomock.Any(), uint64(1), uint64(1)).Return(waiting, nil)
+
+	// a packet waiting for its signing is cached within a block.
+	for range 2 {
+		packet, err := s.cached.GetTunnelPacket(s.ctx, 1, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(waiting, packet)
+	}
+
+	// a signed packet is cached for good.
+	signed := mockPacket(1, tsstypes.SIGNING_STATUS_SUCCESS)
+	s.client.EXPECT().GetTunnelPacket(gomock.Any(), uint64(1), uint64(1)).Return(signed, nil)
+	s.onNewBlock(2)
+
+	for range 2 {
+		packet, err := s.cached.GetTunnelPacket(s.ctx, 1, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(signed, packet)
+
+		// the returned packet is a copy of the cached one.
+		packet.TargetAddress = "0xtarget"
+	}
+	s.onNewBlock(3)
+
+	packet, err := s.cached.GetTunnelPacket(s.ctx, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Empty(packet.TargetAddress)
+
+	// the oldest signed packets are evicted beyond the cache size.
+	s.client.EXPECT().
+		GetTunnelPacket(gomock.Any(), uint64(1), uint64(2)).
+		Return(mockPacket(2, tsstypes.SIGNING_STATUS_SUCCESS), nil)
+	s.client.EXPECT().GetTunnelPacket(gomock.Any(), uint64(1), uint64(1)).Return(signed, nil)
+
+	_, err = s.cached.GetTunnelPacket(s.ctx, 1, 2)
+	s.Require().NoError(err)
+	_, err = s.cached.GetTunnelPacket(s.ctx, 1, 1)
+	s.Require().NoError(err)
+}
+
+func (s *CachedClientTestSuite) TestInvalidateSigning() {
+	waiting := mockPacket(1, tsstypes.SIGNING_STATUS_WAITING)
+	s.client.EXPECT().GetTunnelPacket(gomock.Any(), uint64(1), uint64(1)).Return(waiting, nil)
+
+	_, err := s.cached.GetTunnelPacket(s.ctx, 1, 1)
+	s.Require().NoError(err)
+
+	// the resolved signing invalidates its waiting packet within the same block.
+	s.cached.(band.SigningInvalidator).InvalidateSigning(1)
+
+	signed := mockPacket(1, tsstypes.SIGNING_STATUS_SUCCESS)
+	s.client.EXPECT().GetTunnelPacket(gomock.Any(), uint64(1), uint64(1)).Return(signed, nil)
+
+	packet, err := s.cached.GetTunnelPacket(s.ctx, 1, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(signed, packet)
+
+	// a waiting packet queried before its signing is resolved is not cached afterwards.
+	s.cached.(band.SigningInvalidator).InvalidateSigning(2)
+	s.client.EXPECT().
+		GetTunnelPacket(gomock.Any(), uint64(1), uint64(2)).
+		Return(mockPacket(2, tsstypes.SIGNING_STATUS_WAITING), nil).
+		Times(2)
+
+	for range 2 {
+		_, err = s.cached.GetTunnelPacket(s.ctx, 1, 2)
+		s.Require().NoError(err)
+	}
+}
+
+// mockPacket returns a mock packet of tunnel 1 with the given sequence and signing status.
+func mockPacket(sequence uint64, signingStatus tsstypes.SigningStatus) *bandclienttypes.Packet {
+	return bandclienttypes.NewPacket(
+		1,
+		sequence,
+		nil,
+		&bandclienttypes.Signing{ID: sequence, SigningStatus: signingStatus},
+		nil,
+		0,
+	)
+}
@@ -3,8 +3,10 @@ package band
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
 	httpclient "github.com/cometbft/cometbft/rpc/client/http"
 	cosmosclient "github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,6 +14,7 @@ import (
 
 	bandtsstypes "github.com/bandprotocol/falcon/internal/bandchain/bandtss"
 	tunneltypes "github.com/bandprotocol/falcon/internal/bandchain/tunnel"
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/band/subscriber"
 	"github.com/bandprotocol/falcon/relayer/band/types"
@@ -40,6 +43,19 @@ type Client interface {
 
 	// GetTunnels returns all tunnel in BandChain.
 	GetTunnels(ctx context.Context) ([]types.Tunnel, error)
+
+	// GetCurrentGroup returns the current TSS group on BandChain.
+	GetCurrentGroup(ctx context.Context) (*types.Group, error)
+
+	// GetIncomingGroup returns the incoming TSS group on BandChain, which replaces the current
+	// group during a group transition.
+	GetIncomingGroup(ctx context.Context) (*types.Group, error)
+
+	// GetHealth returns the health of the connection to BandChain.
+	GetHealth() relayerhealth.ComponentHealth
+
+	// GetSubscriptionHealth returns the health of the event subscriptions to BandChain.
+	GetSubscriptionHealth() relayerhealth.ComponentHealth
 }
 
 // client is the BandChain client struct.
@@ -52,6 +68,11 @@ type client struct {
 
 	selectedRPCEndpoint string
 	alert               alert.Alert
+
+	// mu guards the selected RPC endpoint and the liveliness result read by the health check.
+	mu                    sync.RWMutex
+	lastLivelinessCheckAt time.Time
+	lastLivelinessErr     error
 }
 
 // NewClient creates a new BandChain client instance.
@@ -74,7 +95,9 @@ func NewClient(queryClient QueryClient, log logger.Logger, bandChainCfg *Config,
 // Init initializes the BandChain client by connecting to the chain and starting
 // periodic liveliness checks.
 func (c *client) Init(ctx context.Context) error {
-	if err := c.connect(); err != nil {
+	err := c.connect()
+	c.setLivelinessResult(err)
+	if err != nil {
 		c.Log.Error("Failed to connect to BandChain", err)
 		return err
 	}
@@ -171,7 +194,9 @@ func (c *client) connect() error {
 	}
 
 	// Setup the client with the best connection
+	c.mu.Lock()
 	c.selectedRPCEndpoint = bestConnection.endpoint
+	c.mu.Unlock()
 	c.Context.Client = bestConnection.httpclient
 	c.Context.NodeURI = bestConnection.endpoint
 	c.QueryClient = NewBandQueryClient(c.Context)
@@ -193,13 +218,14 @@ func (c *client) startLivelinessCheck(ctx context.Context) {
 			c.Log.Info("Stopping liveliness check")
 			return
 		case <-ticker.C:
-			if _, err := c.Context.Client.Status(ctx); err != nil {
+			_, err := c.Context.Client.Status(ctx)
+			if err != nil {
 				c.Log.Error(
 					"BandChain client disconnected",
 					"rpcEndpoint", c.Context.NodeURI,
 					err,
 				)
-				if err := c.connect(); err != nil {
+				if err = c.connect(); err != nil {
 					c.Log.Error("Liveliness check: unable to reconnect to any endpoints", err)
 				}
 
@@ -207,10 +233,70 @@ func (c *client) startLivelinessCheck(ctx context.Context) {
 					c.Log.Error("Liveliness check: unable to subscribe BandChain", err)
 				}
 			}
+
+			c.setLivelinessResult(err)
 		}
 	}
 }
 
+// setLivelinessResult records the result of the latest liveliness check.
+func (c *client) setLivelinessResult(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastLivelinessCheckAt = time.Now()
+	c.lastLivelinessErr = err
+}
+
+// GetHealth returns the health of the connection to BandChain from the selected RPC endpoint
+// and the result of the latest liveliness check.
+func (c *client) GetHealth() relayerhealth.ComponentHealth {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	detail := map[string]string{
+		"selected_endpoint": c.selectedRPCEndpoint,
+		"last_checked_at":   relayerhealth.FormatTime(c.lastLivelinessCheckAt),
+	}
+
+	if c.selectedRPCEndpoint == "" {
+		return relayerhealth.Down(fmt.Errorf("not connected to any endpoint"), detail)
+	}
+
+	if c.lastLivelinessErr != nil {
+		return relayerhealth.Down(c.lastLivelinessErr, detail)
+	}
+
+	return relayerhealth.Up(detail)
+}
+
+// GetSubscriptionHealth returns the health of the event subscriptions to BandChain.
+func (c *client) GetSubscriptionHealth() relayerhealth.ComponentHealth {
+	subscribed := 0
+	for _, subscriber := range c.Subscribers {
+		if subscriber.IsSubscribed() {
+			subscribed++
+		}
+	}
+
+	detail := map[string]string{
+		"subscribed": fmt.Sprintf("%d/%d", subscribed, len(c.Subscribers)),
+	}
+
+	if len(c.Subscribers) == 0 {
+		return relayerhealth.Down(fmt.Errorf("no subscriber"), detail)
+	}
+
+	if subscribed < len(c.Subscribers) {
+		return relayerhealth.Down(
+			fmt.Errorf("%d of %d subscriptions are not active", len(c.Subscribers)-subscribed, len(c.Subscribers)),
+			detail,
+		)
+	}
+
+	return relayerhealth.Up(detail)
+}
+
 // GetTunnel gets tunnel info from band client
 func (c *client) GetTunnel(ctx context.Context, tunnelID uint64) (*types.Tunnel, error) {
 	// check connection to bandchain
@@ -241,14 +327,17 @@ func (c *client) GetTunnel(ctx context.Context, tunnelID uint64) (*types.Tunnel,
 		return nil, fmt.Errorf("unsupported route type: %T", route)
 	}
 
-	return types.NewTunnel(
+	tunnel := types.NewTunnel(
 		res.Tunnel.ID,
 		res.Tunnel.Sequence,
 		tssRoute.DestinationContractAddress,
 		tssRoute.DestinationChainID,
 		res.Tunnel.IsActive,
 		res.Tunnel.Creator,
-	), nil
+	)
+	tunnel.Encoder = tssRoute.Encoder
+
+	return tunnel, nil
 }
 
 // GetTunnelPacket gets tunnel packet info from band client
@@ -347,14 +436,17 @@ func (c *client) GetTunnels(ctx context.Context) ([]types.Tunnel, error) {
 				continue
 			}
 
-			tunnels = append(tunnels, *types.NewTunnel(
+			bandTunnel := types.NewTunnel(
 				tunnel.ID,
 				tunnel.Sequence,
 				tssRoute.DestinationContractAddress,
 				tssRoute.DestinationChainID,
 				tunnel.IsActive,
 				tunnel.Creator,
-			))
+			)
+			bandTunnel.Encoder = tssRoute.Encoder
+
+			tunnels = append(tunnels, *bandTunnel)
 		}
 
 		nextKey = res.GetPagination().GetNextKey()
@@ -366,6 +458,37 @@ func (c *client) GetTunnels(ctx context.Context) ([]types.Tunnel, error) {
 	return tunnels, nil
 }
 
+// GetCurrentGroup returns the current TSS group on BandChain.
+func (c *client) GetCurrentGroup(ctx context.Context) (*types.Group, error) {
+	// check connection to bandchain
+	if c.QueryClient == nil {
+		return nil, fmt.Errorf("cannot connect to BandChain")
+	}
+
+	res, err := c.QueryClient.CurrentGroup(ctx, &bandtsstypes.QueryCurrentGroupRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGroup(uint64(res.GroupID), cmbytes.HexBytes(res.PubKey)), nil
+}
+
+// GetIncomingGroup returns the incoming TSS group on BandChain, which replaces the current
+// group during a group transition.
+func (c *client) GetIncomingGroup(ctx context.Context) (*types.Group, error) {
+	// check connection to bandchain
+	if c.QueryClient == nil {
+		return nil, fmt.Errorf("cannot connect to BandChain")
+	}
+
+	res, err := c.QueryClient.IncomingGroup(ctx, &bandtsstypes.QueryIncomingGroupRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGroup(uint64(res.GroupID), cmbytes.HexBytes(res.PubKey)), nil
+}
+
 // UnpackAny unpacks the provided *codectypes.Any into the specified interface.
 func (c *client) UnpackAny(any *codectypes.Any, target interface{}) error {
 	err := c.Context.InterfaceRegistry.UnpackAny(any, target)
@@ -20,8 +20,10 @@ import (
 	feedstypes "github.com/bandprotocol/falcon/internal/bandchain/feeds"
 	tsstypes "github.com/bandprotocol/falcon/internal/bandchain/tss"
 	tunneltypes "github.com/bandprotocol/falcon/internal/bandchain/tunnel"
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
 	"github.com/bandprotocol/falcon/relayer/band"
+	"github.com/bandprotocol/falcon/relayer/band/subscriber"
 	bandclienttypes "github.com/bandprotocol/falcon/relayer/band/types"
 	"github.com/bandprotocol/falcon/relayer/logger"
 )
@@ -401,3 +403,40 @@ func (s *ClientTestSuite) TestGetTunnels() {
 		})
 	}
 }
+
+// fakeSubscriber is a subscriber reporting the given subscription state.
+type fakeSubscriber struct {
+	isSubscribed bool
+}
+
+func (f fakeSubscriber) Subscribe(context.Context, string) error { return nil }
+func (f fakeSubscriber) HandleEvent(context.Context)             {}
+func (f fakeSubscriber) IsSubscribed() bool                      { return f.isSubscribed }
+
+func (s *ClientTestSuite) TestGetHealth() {
+	health := s.client.GetHealth()
+	s.Require().Equal(relayerhealth.StatusDown, health.Status)
+	s.Require().Equal("not connected to any endpoint", health.Error)
+}
+
+func (s *ClientTestSuite) TestGetSubscriptionHealth() {
+	health := s.client.GetSubscriptionHealth()
+	s.Require().Equal(relayerhealth.StatusDown, health.Status)
+	s.Require().Equal("no subscriber", health.Error)
+
+	s.client.SetSubscribers([]subscriber.Subscriber{
+		fakeSubscriber{isSubscribed: true},
+		fakeSubscriber{isSubscribed: false},
+	})
+
+	health = s.client.GetSubscriptionHealth()
+	s.Require().Equal(relayerhealth.StatusDown, health.Status)
+	s.Require().Equal("1 of 2 subscriptions are not active", health.Error)
+	s.Require().Equal(map[string]string{"subscribed": "1/2"}, health.Detail)
+
+	s.client.SetSubscribers([]subscriber.Subscriber{fakeSubscriber{isSubscribed: true}})
+
+	health = s.client.GetSubscriptionHealth()
+	s.Require().Equal(relayerhealth.StatusUp, health.Status)
+	s.Require().Equal(map[string]string{"subscribed": "1/1"}, health.Detail)
+}
@@ -2,9 +2,19 @@ package band
 
 import "time"
 
+const (
+	// DefaultTunnelsRefreshInterval is the default interval to refresh the tunnel snapshot.
+	DefaultTunnelsRefreshInterval = time.Minute
+	// DefaultPacketCacheSize is the default number of the signed packets to be cached.
+	DefaultPacketCacheSize = 1000
+)
+
 // Config defines the configuration for the BandChain client.
 type Config struct {
 	RpcEndpoints               []string      `mapstructure:"rpc_endpoints"                toml:"rpc_endpoints"`
 	Timeout                    time.Duration `mapstructure:"timeout"                      toml:"timeout"`
 	LivelinessCheckingInterval time.Duration `mapstructure:"liveliness_checking_interval" toml:"liveliness_checking_interval"`
+	TunnelsRefreshInterval     time.Duration `mapstructure:"tunnels_refresh_interval"     toml:"tunnels_refresh_interval"`
+	PacketCacheSize            int           `mapstructure:"packet_cache_size"            toml:"packet_cache_size"`
+	VerifySignatures           bool          `mapstructure:"verify_signatures"            toml:"verify_signatures"`
 }
@@ -0,0 +1,12 @@
+package band
+
+import "github.com/bandprotocol/falcon/relayer/logger"
+
+// NewCachedClientWithNewBlocks creates a cached client of the given client for testing, which
+// is subscribed to the new blocks notified by the returned function.
+func NewCachedClientWithNewBlocks(client Client, log logger.Logger, cfg *Config) (Client, func(height int64)) {
+	c := NewCachedClient(client, log, cfg).(*cachedClient)
+	c.isSubscribed = func() bool { return true }
+
+	return c, c.onNewBlock
+}
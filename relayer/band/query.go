@@ -36,6 +36,18 @@ type QueryClient interface {
 		in *bandtsstypes.QuerySigningRequest,
 		opts ...grpc.CallOption,
 	) (*bandtsstypes.QuerySigningResponse, error)
+
+	CurrentGroup(
+		ctx context.Context,
+		in *bandtsstypes.QueryCurrentGroupRequest,
+		opts ...grpc.CallOption,
+	) (*bandtsstypes.QueryCurrentGroupResponse, error)
+
+	IncomingGroup(
+		ctx context.Context,
+		in *bandtsstypes.QueryIncomingGroupRequest,
+		opts ...grpc.CallOption,
+	) (*bandtsstypes.QueryIncomingGroupResponse, error)
 }
 
 type BandQueryClient struct {
@@ -97,3 +109,29 @@ func (c *BandQueryClient) Signing(
 	}
 	return out, nil
 }
+
+func (c *BandQueryClient) CurrentGroup(
+	ctx context.Context,
+	in *bandtsstypes.QueryCurrentGroupRequest,
+	opts ...grpc.CallOption,
+) (*bandtsstypes.QueryCurrentGroupResponse, error) {
+	out := new(bandtsstypes.QueryCurrentGroupResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Query/CurrentGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *BandQueryClient) IncomingGroup(
+	ctx context.Context,
+	in *bandtsstypes.QueryIncomingGroupRequest,
+	opts ...grpc.CallOption,
+) (*bandtsstypes.QueryIncomingGroupResponse, error) {
+	out := new(bandtsstypes.QueryIncomingGroupResponse)
+	err := c.cc.Invoke(ctx, "/band.bandtss.v1beta1.Query/IncomingGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,61 @@
+package subscriber
+
+import (
+	"context"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+var _ Subscriber = &NewBlockSubscriber{}
+
+// NewBlockSubscriber is an object for handling the new block event.
+type NewBlockSubscriber struct {
+	*Subscription
+}
+
+// NewNewBlockSubscriber creates a new NewBlockSubscriber, calling onNewBlock with the height of
+// every new block.
+func NewNewBlockSubscriber(
+	log logger.Logger,
+	onNewBlock func(height int64),
+	timeout time.Duration,
+) *NewBlockSubscriber {
+	name := "new_block"
+
+	subscriptionQuery := "tm.event='NewBlock'"
+
+	l := log.With("subscriber", name)
+	onEventReceived := onHandleNewBlockEvent(onNewBlock, l)
+
+	subscription := NewSubscription(
+		name,
+		subscriptionQuery,
+		onEventReceived,
+		timeout,
+		l,
+	)
+
+	return &NewBlockSubscriber{
+		Subscription: subscription,
+	}
+}
+
+// onHandleNewBlockEvent handles the new block event.
+func onHandleNewBlockEvent(
+	onNewBlock func(height int64),
+	log logger.Logger,
+) func(ctx context.Context, msg coretypes.ResultEvent) {
+	return func(ctx context.Context, msg coretypes.ResultEvent) {
+		data, ok := msg.Data.(cmttypes.EventDataNewBlock)
+		if !ok || data.Block == nil {
+			log.Error("Missing block in event new_block")
+			return
+		}
+
+		onNewBlock(data.Block.Height)
+	}
+}
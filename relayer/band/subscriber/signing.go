@@ -0,0 +1,86 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	tsstypes "github.com/bandprotocol/falcon/internal/bandchain/tss"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+var _ Subscriber = &SigningSubscriber{}
+
+// SigningSubscriber is an object for handling the signing success or failed event.
+type SigningSubscriber struct {
+	*Subscription
+}
+
+// NewSigningSubscriber creates a new SigningSubscriber of the given signing event type, either
+// signing_success or signing_failed, calling onSigning with the ID of every resolved signing.
+func NewSigningSubscriber(
+	log logger.Logger,
+	eventType string,
+	onSigning func(signingID uint64),
+	timeout time.Duration,
+) *SigningSubscriber {
+	name := eventType
+
+	subscriptionQuery := fmt.Sprintf(
+		"tm.event='NewBlock' AND %s.%s EXISTS",
+		eventType,
+		tsstypes.AttributeKeySigningID,
+	)
+
+	l := log.With("subscriber", name)
+	onEventReceived := onHandleSigningEvent(eventType, onSigning, l)
+
+	subscription := NewSubscription(
+		name,
+		subscriptionQuery,
+		onEventReceived,
+		timeout,
+		l,
+	)
+
+	return &SigningSubscriber{
+		Subscription: subscription,
+	}
+}
+
+// onHandleSigningEvent handles the signing event of the given type.
+func onHandleSigningEvent(
+	eventType string,
+	onSigning func(signingID uint64),
+	log logger.Logger,
+) func(ctx context.Context, msg coretypes.ResultEvent) {
+	return func(ctx context.Context, msg coretypes.ResultEvent) {
+		attrs := msg.Events
+
+		// key for the signingID attribute
+		key := fmt.Sprintf("%s.%s", eventType, tsstypes.AttributeKeySigningID)
+
+		emittedSigningIDs := attrs[key]
+		if len(emittedSigningIDs) == 0 {
+			log.Error("Missing signing_id in event " + eventType)
+			return
+		}
+
+		// parse the signing IDs from the event
+		for _, idStr := range emittedSigningIDs {
+			signingID, err := strconv.ParseUint(idStr, 10, 64)
+			if err != nil {
+				log.Error(
+					"Failed to parse signing_id in the event "+eventType,
+					"signing_id", idStr,
+					err,
+				)
+				continue
+			}
+			onSigning(signingID)
+		}
+	}
+}
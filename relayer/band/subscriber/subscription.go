@@ -2,6 +2,7 @@ package subscriber
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -21,6 +22,9 @@ type Subscription struct {
 	stopCh            chan struct{}
 	eventCh           chan coretypes.ResultEvent
 	onEventReceived   func(ctx context.Context, msg coretypes.ResultEvent)
+
+	// mu guards rpcClient, which is also read by the health check.
+	mu sync.RWMutex
 }
 
 // NewSubscription creates a new Subscription object.
@@ -81,7 +85,9 @@ func (s *Subscription) Subscribe(ctx context.Context, endpoint string) error {
 		}
 	}()
 
+	s.mu.Lock()
 	s.rpcClient = client
+	s.mu.Unlock()
 
 	return nil
 }
@@ -112,11 +118,21 @@ func (s *Subscription) unsubscribeAndStopPreviousClient(ctx context.Context) {
 	}
 
 	close(s.stopCh)
+	s.mu.Lock()
 	s.rpcClient = nil
+	s.mu.Unlock()
 
 	s.log.Debug("Unsubscribe and stop HTTP client successfully")
 }
 
+// IsSubscribed checks if the subscription has a running RPC client subscribed to the event.
+func (s *Subscription) IsSubscribed() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.rpcClient != nil && s.rpcClient.IsRunning()
+}
+
 // HandleEvent handles the event from the subscribed channel.
 func (s *Subscription) HandleEvent(ctx context.Context) {
 	for msg := range s.eventCh {
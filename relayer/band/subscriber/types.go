@@ -10,4 +10,7 @@ type Subscriber interface {
 
 	// HandleEvent handles the event from the subscribed channel.
 	HandleEvent(ctx context.Context)
+
+	// IsSubscribed checks if the subscriber is currently subscribed to the event.
+	IsSubscribed() bool
 }
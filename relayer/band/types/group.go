@@ -0,0 +1,19 @@
+package types
+
+import (
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+)
+
+// Group stores an information of the TSS group on BandChain.
+type Group struct {
+	ID     uint64           `json:"id"`
+	PubKey cmbytes.HexBytes `json:"pub_key"`
+}
+
+// NewGroup creates a new group instance.
+func NewGroup(id uint64, pubKey cmbytes.HexBytes) *Group {
+	return &Group{
+		ID:     id,
+		PubKey: pubKey,
+	}
+}
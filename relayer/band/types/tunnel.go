@@ -1,13 +1,18 @@
 package types
 
+import (
+	feedstypes "github.com/bandprotocol/falcon/internal/bandchain/feeds"
+)
+
 // Tunnel stores an information of the tunnel.
 type Tunnel struct {
-	ID             uint64 `json:"-"`
-	LatestSequence uint64 `json:"latest_sequence"`
-	TargetAddress  string `json:"target_address"`
-	TargetChainID  string `json:"target_chain_id"`
-	IsActive       bool   `json:"is_active"`
-	Creator        string `json:"creator"`
+	ID             uint64             `json:"-"`
+	LatestSequence uint64             `json:"latest_sequence"`
+	TargetAddress  string             `json:"target_address"`
+	TargetChainID  string             `json:"target_chain_id"`
+	IsActive       bool               `json:"is_active"`
+	Creator        string             `json:"creator"`
+	Encoder        feedstypes.Encoder `json:"-"`
 }
 
 // NewTunnel creates a new tunnel instance.
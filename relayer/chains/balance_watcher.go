@@ -0,0 +1,210 @@
+package chains
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bandprotocol/falcon/internal/relayermetrics"
+	"github.com/bandprotocol/falcon/relayer/alert"
+	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/wallet"
+)
+
+const DefaultSignerBalanceCheckInterval = time.Minute
+
+// SignerBalanceWatcher periodically queries the balances of the signers of a chain provider.
+// It triggers an alert if any signer is below the minimum balance, and removes the signers
+// below the floor from the signer pool of the provider until they are refunded. The last
+// signer is never removed, so that the relays fail with insufficient funds instead of
+// waiting for a signer forever.
+type SignerBalanceWatcher struct {
+	Log           logger.Logger
+	ChainProvider ChainProvider
+	Alert         alert.Alert
+
+	MinBalance  *big.Int
+	Floor       *big.Int
+	Interval    time.Duration
+	TreasuryKey string
+
+	mu sync.Mutex
+	// quarantined contains the addresses of the signers below the floor.
+	quarantined map[string]struct{}
+	// held contains the quarantined signers taken out of the signer pool, by address.
+	held map[string]wallet.Signer
+}
+
+// NewSignerBalanceWatcher creates a new SignerBalanceWatcher of the given chain provider.
+func NewSignerBalanceWatcher(
+	log logger.Logger,
+	chainProvider ChainProvider,
+	alert alert.Alert,
+	cfg BaseChainProviderConfig,
+) *SignerBalanceWatcher {
+	interval := cfg.SignerBalanceCheckInterval
+	if interval == 0 {
+		interval = DefaultSignerBalanceCheckInterval
+	}
+
+	return &SignerBalanceWatcher{
+		Log:           log.With("chain_name", chainProvider.GetChainName()),
+		ChainProvider: chainProvider,
+		Alert:         alert,
+		MinBalance:    amountOrZero(cfg.MinSignerBalance),
+		Floor:         amountOrZero(cfg.SignerBalanceFloor),
+		Interval:      interval,
+		TreasuryKey:   cfg.TreasuryKey,
+		quarantined:   make(map[string]struct{}),
+		held:          make(map[string]wallet.Signer),
+	}
+}
+
+// Start checks the signer balances every interval until the context is done.
+func (w *SignerBalanceWatcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.CheckBalances(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckBalances queries the balance of every signer, updates the metrics and the alert,
+// and moves the signers in or out of the signer pool based on the floor.
+func (w *SignerBalanceWatcher) CheckBalances(ctx context.Context) {
+	chainName := w.ChainProvider.GetChainName()
+	chainType := w.ChainProvider.ChainType().String()
+
+	signers := GetRelaySigners(w.ChainProvider.GetWallet(), w.excludedKeyNames()...)
+
+	var lowSigners []string
+	for _, signer := range signers {
+		address := signer.GetAddress()
+
+		balance, err := w.ChainProvider.QueryBalance(ctx, address)
+		if err != nil {
+			w.Log.Warn("Failed to query signer balance", "signer", address, err)
+			continue
+		}
+
+		balanceFloat, _ := new(big.Float).SetInt(balance).Float64()
+		relayermetrics.SetSignerBalance(chainName, chainType, address, balanceFloat)
+
+		if balance.Cmp(w.MinBalance) < 0 {
+			lowSigners = append(lowSigners, fmt.Sprintf("%s (%s)", address, balance))
+		}
+
+		w.updateQuarantine(address, balance)
+	}
+
+	topic := alert.NewTopic(alert.LowSignerBalanceMsg).WithChainName(chainName)
+	if len(lowSigners) > 0 {
+		w.Log.Warn("Signer balance is below threshold", "min_signer_balance", w.MinBalance.String(), "signers", lowSigners)
+		alert.HandleAlert(
+			w.Alert,
+			topic,
+			fmt.Sprintf("signers below %s: %s", w.MinBalance, strings.Join(lowSigners, ", ")),
+		)
+	} else {
+		alert.HandleReset(w.Alert, topic)
+	}
+
+	w.updatePool(len(signers))
+}
+
+// IsQuarantined checks whether the signer of the given address is removed from the signer pool.
+func (w *SignerBalanceWatcher) IsQuarantined(address string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, ok := w.quarantined[address]
+	return ok
+}
+
+// excludedKeyNames returns the key names of the wallet that are not used for relaying.
+func (w *SignerBalanceWatcher) excludedKeyNames() []string {
+	if w.TreasuryKey == "" {
+		return nil
+	}
+
+	return []string{w.TreasuryKey}
+}
+
+// updateQuarantine quarantines the signer if its balance is below the floor, or
+// releases it if it has been refunded.
+func (w *SignerBalanceWatcher) updateQuarantine(address string, balance *big.Int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, isQuarantined := w.quarantined[address]
+	isBelowFloor := balance.Cmp(w.Floor) < 0
+
+	switch {
+	case isBelowFloor && !isQuarantined:
+		w.Log.Warn("Quarantine signer below balance floor",
+			"signer", address,
+			"balance", balance.String(),
+			"signer_balance_floor", w.Floor.String(),
+		)
+		w.quarantined[address] = struct{}{}
+	case !isBelowFloor && isQuarantined:
+		w.Log.Info("Release refunded signer", "signer", address, "balance", balance.String())
+		delete(w.quarantined, address)
+	}
+}
+
+// updatePool takes the quarantined signers out of the signer pool and puts the released
+// signers back. Signers in use are taken out on the next check after they are returned,
+// and at least one of the given number of signers is left in the pool.
+func (w *SignerBalanceWatcher) updatePool(signerCount int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	freeSigners := w.ChainProvider.GetFreeSigners()
+
+	var available []wallet.Signer
+DrainLoop:
+	for {
+		select {
+		case signer := <-freeSigners:
+			available = append(available, signer)
+		default:
+			break DrainLoop
+		}
+	}
+
+	for _, signer := range available {
+		address := signer.GetAddress()
+		if _, ok := w.quarantined[address]; ok {
+			if len(w.held)+1 < signerCount {
+				w.held[address] = signer
+				continue
+			}
+			w.Log.Warn("Keep the last signer in the pool despite its balance", "signer", address)
+		}
+		freeSigners <- signer
+	}
+
+	for address, signer := range w.held {
+		if _, ok := w.quarantined[address]; !ok {
+			delete(w.held, address)
+			freeSigners <- signer
+		}
+	}
+
+	relayermetrics.SetQuarantinedSignersCount(
+		w.ChainProvider.GetChainName(),
+		w.ChainProvider.ChainType().String(),
+		len(w.quarantined),
+	)
+}
@@ -0,0 +1,178 @@
+package chains_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
+	"github.com/bandprotocol/falcon/relayer/alert"
+	"github.com/bandprotocol/falcon/relayer/chains"
+	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/wallet"
+)
+
+// recordedAlert records the latest detail of each triggered topic.
+type recordedAlert struct {
+	triggered map[string]string
+}
+
+func (a *recordedAlert) Trigger(topic, detail string) { a.triggered[topic] = detail }
+func (a *recordedAlert) Reset(topic string)           { delete(a.triggered, topic) }
+
+type SignerBalanceWatcherTestSuite struct {
+	suite.Suite
+
+	chainProvider *mocks.MockChainProvider
+	alert         *recordedAlert
+	freeSigners   chan wallet.Signer
+	balances      map[string]*big.Int
+	watcher       *chains.SignerBalanceWatcher
+}
+
+func TestSignerBalanceWatcherTestSuite(t *testing.T) {
+	suite.Run(t, new(SignerBalanceWatcherTestSuite))
+}
+
+func (s *SignerBalanceWatcherTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	log := logger.NewZapLogWrapper(zap.NewNop().Sugar())
+
+	signers := make([]wallet.Signer, 0, 2)
+	for _, address := range []string{address1, address2} {
+		signer := mocks.NewMockSigner(ctrl)
+		signer.EXPECT().GetAddress().Return(address).AnyTimes()
+		signers = append(signers, signer)
+	}
+
+	w := mocks.NewMockWallet(ctrl)
+	w.EXPECT().GetSigners().Return(signers).AnyTimes()
+
+	s.freeSigners = make(chan wallet.Signer, len(signers))
+	for _, signer := range signers {
+		s.freeSigners <- signer
+	}
+
+	s.balances = map[string]*big.Int{
+		address1: big.NewInt(1000),
+		address2: big.NewInt(1000),
+	}
+
+	s.chainProvider = mocks.NewMockChainProvider(ctrl)
+	s.chainProvider.EXPECT().GetChainName().Return("testnet").AnyTimes()
+	s.chainProvider.EXPECT().ChainType().Return(chainstypes.ChainTypeEVM).AnyTimes()
+	s.chainProvider.EXPECT().GetWallet().Return(w).AnyTimes()
+	s.chainProvider.EXPECT().GetFreeSigners().Return(s.freeSigners).AnyTimes()
+	s.chainProvider.EXPECT().
+		QueryBalance(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, address string) (*big.Int, error) {
+			return s.balances[address], nil
+		}).
+		AnyTimes()
+
+	s.alert = &recordedAlert{triggered: make(map[string]string)}
+	s.watcher = chains.NewSignerBalanceWatcher(log, s.chainProvider, s.alert, chains.BaseChainProviderConfig{
+		MinSignerBalance:   big.NewInt(500),
+		SignerBalanceFloor: big.NewInt(100),
+	})
+}
+
+// freeAddresses drains the signer pool and returns the addresses of the free signers.
+func (s *SignerBalanceWatcherTestSuite) freeAddresses() []string {
+	var addresses []string
+	var signers []wallet.Signer
+	for len(s.freeSigners) > 0 {
+		signer := <-s.freeSigners
+		signers = append(signers, signer)
+		addresses = append(addresses, signer.GetAddress())
+	}
+
+	for _, signer := range signers {
+		s.freeSigners <- signer
+	}
+
+	return addresses
+}
+
+func (s *SignerBalanceWatcherTestSuite) TestCheckBalancesHealthy() {
+	s.watcher.CheckBalances(context.Background())
+
+	s.Require().Empty(s.alert.triggered)
+	s.Require().ElementsMatch([]string{address1, address2}, s.freeAddresses())
+}
+
+func (s *SignerBalanceWatcherTestSuite) TestCheckBalancesBelowMinimum() {
+	s.balances[address1] = big.NewInt(200)
+
+	s.watcher.CheckBalances(context.Background())
+
+	topic := alert.NewTopic(alert.LowSignerBalanceMsg).WithChainName("testnet").GetFullTopic()
+	s.Require().Contains(s.alert.triggered, topic)
+	s.Require().Contains(s.alert.triggered[topic], address1)
+	s.Require().NotContains(s.alert.triggered[topic], address2)
+
+	// the signer is still in the pool as it is above the floor.
+	s.Require().False(s.watcher.IsQuarantined(address1))
+	s.Require().ElementsMatch([]string{address1, address2}, s.freeAddresses())
+
+	// the alert is reset once the signer is refunded.
+	s.balances[address1] = big.NewInt(1000)
+	s.watcher.CheckBalances(context.Background())
+	s.Require().Empty(s.alert.triggered)
+}
+
+func (s *SignerBalanceWatcherTestSuite) TestQuarantineAndRelease() {
+	s.balances[address2] = big.NewInt(50)
+
+	s.watcher.CheckBalances(context.Background())
+
+	s.Require().True(s.watcher.IsQuarantined(address2))
+	s.Require().Equal([]string{address1}, s.freeAddresses())
+
+	// the signer stays out of the pool while it is below the floor.
+	s.watcher.CheckBalances(context.Background())
+	s.Require().Equal([]string{address1}, s.freeAddresses())
+
+	// the signer is put back once it is refunded.
+	s.balances[address2] = big.NewInt(1000)
+	s.watcher.CheckBalances(context.Background())
+
+	s.Require().False(s.watcher.IsQuarantined(address2))
+	s.Require().ElementsMatch([]string{address1, address2}, s.freeAddresses())
+}
+
+func (s *SignerBalanceWatcherTestSuite) TestQuarantineSignerInUse() {
+	s.balances[address1] = big.NewInt(50)
+
+	// the signer is in use while its balance is checked.
+	inUse := <-s.freeSigners
+	s.Require().Equal(address1, inUse.GetAddress())
+
+	s.watcher.CheckBalances(context.Background())
+	s.Require().True(s.watcher.IsQuarantined(address1))
+
+	// the signer is taken out on the next check after it is returned to the pool.
+	s.freeSigners <- inUse
+	s.watcher.CheckBalances(context.Background())
+	s.Require().Equal([]string{address2}, s.freeAddresses())
+}
+
+func (s *SignerBalanceWatcherTestSuite) TestKeepLastSignerInPool() {
+	s.balances[address1] = big.NewInt(50)
+	s.balances[address2] = big.NewInt(50)
+
+	s.watcher.CheckBalances(context.Background())
+
+	// both signers are quarantined, but one is left in the pool to fail the relays fast.
+	s.Require().True(s.watcher.IsQuarantined(address1))
+	s.Require().True(s.watcher.IsQuarantined(address2))
+	s.Require().Len(s.freeAddresses(), 1)
+
+	s.watcher.CheckBalances(context.Background())
+	s.Require().Len(s.freeAddresses(), 1)
+}
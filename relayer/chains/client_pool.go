@@ -3,6 +3,9 @@ package chains
 import (
 	"fmt"
 	"sync"
+	"time"
+
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 )
 
 // ClientPool is a thread-safe pool of RPC clients keyed by endpoint URL.
@@ -11,6 +14,8 @@ type ClientPool[T any] struct {
 	mu               sync.RWMutex
 	selectedEndpoint string
 	clients          map[string]*T
+	lastCheckedAt    time.Time
+	lastCheckErr     error
 }
 
 // NewClientPool creates and returns a new ClientPool with no entries.
@@ -70,3 +75,34 @@ func (p *ClientPool[T]) GetSelectedClient() (*T, error) {
 
 	return selectedClient, nil
 }
+
+// SetCheckResult records the result of the latest connection check of the pool.
+func (p *ClientPool[T]) SetCheckResult(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastCheckedAt = time.Now()
+	p.lastCheckErr = err
+}
+
+// Health returns the health of the pool from its selected endpoint and the result of the
+// latest connection check.
+func (p *ClientPool[T]) Health() relayerhealth.ComponentHealth {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	detail := map[string]string{
+		"selected_endpoint": p.selectedEndpoint,
+		"last_checked_at":   relayerhealth.FormatTime(p.lastCheckedAt),
+	}
+
+	if p.selectedEndpoint == "" {
+		return relayerhealth.Down(fmt.Errorf("no selected endpoint"), detail)
+	}
+
+	if p.lastCheckErr != nil {
+		return relayerhealth.Down(p.lastCheckErr, detail)
+	}
+
+	return relayerhealth.Up(detail)
+}
@@ -0,0 +1,36 @@
+package chains_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
+	"github.com/bandprotocol/falcon/relayer/chains"
+)
+
+func TestClientPoolHealth(t *testing.T) {
+	pool := chains.NewClientPool[struct{}]()
+
+	health := pool.Health()
+	require.Equal(t, relayerhealth.StatusDown, health.Status)
+	require.Equal(t, "no selected endpoint", health.Error)
+
+	pool.SetClient("http://localhost:8545", &struct{}{})
+	pool.SetSelectedEndpoint("http://localhost:8545")
+	pool.SetCheckResult(nil)
+
+	health = pool.Health()
+	require.Equal(t, relayerhealth.StatusUp, health.Status)
+	require.Equal(t, "http://localhost:8545", health.Detail["selected_endpoint"])
+	require.NotEmpty(t, health.Detail["last_checked_at"])
+
+	// the selected endpoint is kept when reconnecting fails, but the pool is down.
+	pool.SetCheckResult(fmt.Errorf("failed to connect to any endpoints"))
+
+	health = pool.Health()
+	require.Equal(t, relayerhealth.StatusDown, health.Status)
+	require.Equal(t, "failed to connect to any endpoints", health.Error)
+	require.Equal(t, "http://localhost:8545", health.Detail["selected_endpoint"])
+}
@@ -1,6 +1,7 @@
 package chains
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/bandprotocol/falcon/relayer/alert"
@@ -9,15 +10,33 @@ import (
 	"github.com/bandprotocol/falcon/relayer/wallet"
 )
 
-// BaseChainProviderConfig contains common field for particular chain provider.
+// BaseChainProviderConfig contains common field for particular chain provider. The balances
+// and amounts are in the smallest unit of the native token, e.g. wei, and are written in the
+// config file as decimal strings, as they may exceed uint64.
 type BaseChainProviderConfig struct {
-	Endpoints                  []string        `mapstructure:"endpoints"                    toml:"endpoints"`
-	ChainType                  types.ChainType `mapstructure:"chain_type"                   toml:"chain_type"`
-	MaxRetry                   int             `mapstructure:"max_retry"                    toml:"max_retry"`
-	QueryTimeout               time.Duration   `mapstructure:"query_timeout"                toml:"query_timeout"`
-	ExecuteTimeout             time.Duration   `mapstructure:"execute_timeout"              toml:"execute_timeout"`
-	ChainID                    uint64          `mapstructure:"chain_id"                     toml:"chain_id"`
-	LivelinessCheckingInterval time.Duration   `mapstructure:"liveliness_checking_interval" toml:"liveliness_checking_interval"`
+	Endpoints                  []string        `mapstructure:"endpoints"                     toml:"endpoints"`
+	ChainType                  types.ChainType `mapstructure:"chain_type"                    toml:"chain_type"`
+	MaxRetry                   int             `mapstructure:"max_retry"                     toml:"max_retry"`
+	QueryTimeout               time.Duration   `mapstructure:"query_timeout"                 toml:"query_timeout"`
+	ExecuteTimeout             time.Duration   `mapstructure:"execute_timeout"               toml:"execute_timeout"`
+	ChainID                    uint64          `mapstructure:"chain_id"                      toml:"chain_id"`
+	LivelinessCheckingInterval time.Duration   `mapstructure:"liveliness_checking_interval"  toml:"liveliness_checking_interval"`
+	MinTunnelBalance           *big.Int        `mapstructure:"min_tunnel_balance"            toml:"min_tunnel_balance,omitempty"`
+	PauseOnLowTunnelBalance    bool            `mapstructure:"pause_on_low_tunnel_balance"   toml:"pause_on_low_tunnel_balance,omitempty"`
+	MinSignerBalance           *big.Int        `mapstructure:"min_signer_balance"            toml:"min_signer_balance,omitempty"`
+	SignerBalanceFloor         *big.Int        `mapstructure:"signer_balance_floor"          toml:"signer_balance_floor,omitempty"`
+	SignerBalanceCheckInterval time.Duration   `mapstructure:"signer_balance_check_interval" toml:"signer_balance_check_interval,omitempty"`
+	TreasuryKey                string          `mapstructure:"treasury_key"                  toml:"treasury_key,omitempty"`
+	TopUpLowWaterMark          *big.Int        `mapstructure:"top_up_low_water_mark"         toml:"top_up_low_water_mark,omitempty"`
+	TopUpHighWaterMark         *big.Int        `mapstructure:"top_up_high_water_mark"        toml:"top_up_high_water_mark,omitempty"`
+	TopUpDailyCap              *big.Int        `mapstructure:"top_up_daily_cap"              toml:"top_up_daily_cap,omitempty"`
+	TopUpCheckInterval         time.Duration   `mapstructure:"top_up_check_interval"         toml:"top_up_check_interval,omitempty"`
+	MaxConcurrentRelays        int             `mapstructure:"max_concurrent_relays"         toml:"max_concurrent_relays,omitempty"`
+}
+
+// GetBaseConfig returns the common config of the chain provider.
+func (c BaseChainProviderConfig) GetBaseConfig() BaseChainProviderConfig {
+	return c
 }
 
 // ChainProviderConfigs is a collection of ChainProviderConfig interfaces (mapped by chainName)
@@ -30,7 +49,19 @@ type ChainProviderConfig interface {
 		log logger.Logger,
 		wallet wallet.Wallet,
 		alert alert.Alert,
+		signingVerifier SigningVerifier,
 	) (ChainProvider, error)
 
 	GetChainType() types.ChainType
+
+	GetBaseConfig() BaseChainProviderConfig
+}
+
+// amountOrZero returns a copy of the given amount of the config, or zero if it is not set.
+func amountOrZero(amount *big.Int) *big.Int {
+	if amount == nil {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Set(amount)
 }
@@ -13,6 +13,7 @@ import (
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/logger"
@@ -32,16 +33,20 @@ var _ Client = &client{}
 type Client interface {
 	Connect(ctx context.Context) error
 	CheckAndConnect(ctx context.Context) error
+	GetHealth() relayerhealth.ComponentHealth
 	StartLivelinessCheck(ctx context.Context, interval time.Duration)
 	NonceAt(ctx context.Context, address gethcommon.Address) (uint64, error)
+	PendingNonceAt(ctx context.Context, address gethcommon.Address) (uint64, error)
 	GetBlockHeight(ctx context.Context) (uint64, error)
 	GetHeaderBlock(ctx context.Context, height *big.Int) (*gethtypes.Header, error)
 	GetTxReceipt(ctx context.Context, txHash string) (*TxReceipt, error)
+	GetTxByHash(ctx context.Context, txHash string) (*gethtypes.Transaction, bool, error)
 	Query(ctx context.Context, gethAddr gethcommon.Address, data []byte) ([]byte, error)
 	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
 	EstimateGasPrice(ctx context.Context) (*big.Int, error)
 	EstimateBaseFee(ctx context.Context) (*big.Int, error)
 	EstimateGasTipCap(ctx context.Context) (*big.Int, error)
+	FeeHistory(ctx context.Context, blockCount uint64, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
 	BroadcastTx(ctx context.Context, tx *gethtypes.Transaction) (string, error)
 	GetBalance(ctx context.Context, gethAddr gethcommon.Address, blockNumber *big.Int) (*big.Int, error)
 }
@@ -112,6 +117,7 @@ func (c *client) Connect(ctx context.Context) error {
 
 	wg.Wait()
 	res, err := c.getClientWithMaxHeight(ctx)
+	c.clients.SetCheckResult(err)
 	if err != nil {
 		c.Log.Error("Failed to connect to EVM chain", err)
 		return err
@@ -172,6 +178,32 @@ func (c *client) NonceAt(ctx context.Context, address gethcommon.Address) (uint6
 	return nonce, nil
 }
 
+// PendingNonceAt retrieves the account nonce for the given address including
+// the transactions in the pending state of the selected endpoint.
+func (c *client) PendingNonceAt(ctx context.Context, address gethcommon.Address) (uint64, error) {
+	newCtx, cancel := context.WithTimeout(ctx, c.QueryTimeout)
+	defer cancel()
+
+	client, err := c.clients.GetSelectedClient()
+	if err != nil {
+		c.Log.Error("Failed to get client", "endpoint", c.clients.GetSelectedEndpoint(), err)
+		return 0, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	nonce, err := client.PendingNonceAt(newCtx, address)
+	if err != nil {
+		c.Log.Error(
+			"Failed to get pending nonce",
+			"endpoint", c.clients.GetSelectedEndpoint(),
+			"evm_address", address.Hex(),
+			err,
+		)
+		return 0, fmt.Errorf("failed to get pending nonce: %w", err)
+	}
+
+	return nonce, nil
+}
+
 // GetBlockHeight returns the current block height of the EVM chain on the selected endpoint.
 func (c *client) GetBlockHeight(ctx context.Context) (uint64, error) {
 	newCtx, cancel := context.WithTimeout(ctx, c.QueryTimeout)
@@ -416,6 +448,35 @@ func (c *client) EstimateBaseFee(ctx context.Context) (*big.Int, error) {
 	return estimatedBaseFee, nil
 }
 
+// FeeHistory retrieves the base fees and the priority fees at the given reward percentiles
+// of the latest blocks on the EVM chain.
+func (c *client) FeeHistory(
+	ctx context.Context,
+	blockCount uint64,
+	rewardPercentiles []float64,
+) (*ethereum.FeeHistory, error) {
+	newCtx, cancel := context.WithTimeout(ctx, c.QueryTimeout)
+	defer cancel()
+
+	client, err := c.clients.GetSelectedClient()
+	if err != nil {
+		c.Log.Error("Failed to get client", "endpoint", c.clients.GetSelectedEndpoint(), err)
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	feeHistory, err := client.FeeHistory(newCtx, blockCount, nil, rewardPercentiles)
+	if err != nil {
+		c.Log.Error(
+			"Failed to get fee history",
+			"endpoint", c.clients.GetSelectedEndpoint(),
+			err,
+		)
+		return nil, fmt.Errorf("failed to get fee history: %w", err)
+	}
+
+	return feeHistory, nil
+}
+
 // EstimateGasTipCap estimates the current gas tip cap on the EVM chain.
 func (c *client) EstimateGasTipCap(ctx context.Context) (*big.Int, error) {
 	newCtx, cancel := context.WithTimeout(ctx, c.QueryTimeout)
@@ -559,6 +620,11 @@ func (c *client) CheckAndConnect(ctx context.Context) error {
 	return nil
 }
 
+// GetHealth returns the health of the connection to the EVM chain.
+func (c *client) GetHealth() relayerhealth.ComponentHealth {
+	return c.clients.Health()
+}
+
 // GetBalance get the balance of specific account the EVM chain.
 func (c *client) GetBalance(ctx context.Context, gethAddr gethcommon.Address, blockNumber *big.Int) (*big.Int, error) {
 	newCtx, cancel := context.WithTimeout(ctx, c.QueryTimeout)
@@ -20,6 +20,8 @@ type EVMChainProviderConfig struct {
 	BlockConfirmation   uint64        `mapstructure:"block_confirmation"    toml:"block_confirmation"`
 	WaitingTxDuration   time.Duration `mapstructure:"waiting_tx_duration"   toml:"waiting_tx_duration"`
 	CheckingTxInterval  time.Duration `mapstructure:"checking_tx_interval"  toml:"checking_tx_interval"`
+	ReplacementInterval time.Duration `mapstructure:"replacement_interval"  toml:"replacement_interval,omitempty"`
+	MaxReplacements     int           `mapstructure:"max_replacements"      toml:"max_replacements,omitempty"`
 	GasLimit            uint64        `mapstructure:"gas_limit"             toml:"gas_limit,omitempty"`
 
 	GasType         GasType       `mapstructure:"gas_type"          toml:"gas_type"`
@@ -28,6 +30,16 @@ type EVMChainProviderConfig struct {
 	MaxPriorityFee  uint64        `mapstructure:"max_priority_fee"  toml:"max_priority_fee,omitempty"`
 	GasMultiplier   float64       `mapstructure:"gas_multiplier"    toml:"gas_multiplier"`
 	QueryGasTimeout time.Duration `mapstructure:"query_gas_timeout" toml:"query_gas_timeout,omitempty"`
+
+	GasOracle                  GasOracle `mapstructure:"gas_oracle"                    toml:"gas_oracle,omitempty"`
+	FeeHistoryBlockCount       uint64    `mapstructure:"fee_history_block_count"       toml:"fee_history_block_count,omitempty"`
+	FeeHistoryRewardPercentile float64   `mapstructure:"fee_history_reward_percentile" toml:"fee_history_reward_percentile,omitempty"`
+	BaseFeeMultiplier          float64   `mapstructure:"base_fee_multiplier"           toml:"base_fee_multiplier,omitempty"`
+	StaticGasPrice             uint64    `mapstructure:"static_gas_price"              toml:"static_gas_price,omitempty"`
+	StaticBaseFee              uint64    `mapstructure:"static_base_fee"               toml:"static_base_fee,omitempty"`
+	StaticPriorityFee          uint64    `mapstructure:"static_priority_fee"           toml:"static_priority_fee,omitempty"`
+
+	ProfitabilityPolicy ProfitabilityPolicy `mapstructure:"profitability_policy" toml:"profitability_policy,omitempty"`
 }
 
 // NewProvider creates a new EVM chain provider.
@@ -36,10 +48,11 @@ func (cpc *EVMChainProviderConfig) NewChainProvider(
 	log logger.Logger,
 	wallet wallet.Wallet,
 	alert alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) (chains.ChainProvider, error) {
 	client := NewClient(chainName, cpc, log, alert)
 
-	return NewEVMChainProvider(chainName, client, cpc, log, wallet, alert)
+	return NewEVMChainProvider(chainName, client, cpc, log, wallet, alert, signingVerifier)
 }
 
 func (cpc *EVMChainProviderConfig) GetChainType() types.ChainType {
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 type GasType int
@@ -87,3 +89,63 @@ func NewGasEIP1559Info(gasPriorityFee, gasBaseFee *big.Int) GasInfo {
 		GasFeeCap:      new(big.Int).Add(gasPriorityFee, gasBaseFee),
 	}
 }
+
+// minReplacementGasMultiplier is the minimum multiplier of the gas of a transaction that
+// replaces another one with the same nonce; nodes reject a replacement that pays less than
+// 10% more than the replaced transaction.
+const minReplacementGasMultiplier = 1.1
+
+// isGasBumped checks whether the new gas information pays at least 10% more than the old one,
+// which is required for a transaction with the same nonce to replace the old one. For EIP-1559
+// transactions, both the fee cap and the priority fee must be bumped.
+func isGasBumped(oldGasInfo, newGasInfo GasInfo) bool {
+	switch newGasInfo.Type {
+	case GasTypeLegacy:
+		return isBumpedByTenPercent(oldGasInfo.GasPrice, newGasInfo.GasPrice)
+	case GasTypeEIP1559:
+		return isBumpedByTenPercent(oldGasInfo.GasFeeCap, newGasInfo.GasFeeCap) &&
+			isBumpedByTenPercent(oldGasInfo.GasPriorityFee, newGasInfo.GasPriorityFee)
+	default:
+		return false
+	}
+}
+
+// gasInfoFromTx returns the gas information paid by the given transaction.
+func gasInfoFromTx(tx *gethtypes.Transaction) GasInfo {
+	if tx.Type() == gethtypes.DynamicFeeTxType {
+		return NewGasEIP1559Info(tx.GasTipCap(), new(big.Int).Sub(tx.GasFeeCap(), tx.GasTipCap()))
+	}
+
+	return NewGasLegacyInfo(tx.GasPrice())
+}
+
+// maxGasInfo returns the gas information paying the higher of each fee of the given ones of
+// the same gas type.
+func maxGasInfo(a, b GasInfo) GasInfo {
+	switch a.Type {
+	case GasTypeLegacy:
+		return NewGasLegacyInfo(maxBigInt(a.GasPrice, b.GasPrice))
+	case GasTypeEIP1559:
+		return NewGasEIP1559Info(maxBigInt(a.GasPriorityFee, b.GasPriorityFee), maxBigInt(a.GasBaseFee, b.GasBaseFee))
+	default:
+		return a
+	}
+}
+
+// maxBigInt returns the higher of the given values.
+func maxBigInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// isBumpedByTenPercent checks whether the new value is at least 10% more than the old one.
+func isBumpedByTenPercent(oldValue, newValue *big.Int) bool {
+	if newValue.Cmp(oldValue) <= 0 {
+		return false
+	}
+
+	required := new(big.Int).Mul(oldValue, big.NewInt(11))
+	return new(big.Int).Mul(newValue, big.NewInt(10)).Cmp(required) >= 0
+}
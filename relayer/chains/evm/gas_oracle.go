@@ -0,0 +1,161 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+)
+
+const (
+	DefaultFeeHistoryBlockCount       = 20
+	DefaultFeeHistoryRewardPercentile = 50
+	DefaultBaseFeeMultiplier          = 1.125
+)
+
+// GasOracle is the source of the gas fee estimation.
+type GasOracle string
+
+const (
+	// GasOracleRPC estimates the gas fee from eth_gasPrice, eth_maxPriorityFeePerGas and the
+	// latest block header. It is the default gas oracle.
+	GasOracleRPC GasOracle = "rpc"
+	// GasOracleFeeHistory estimates the gas fee from eth_feeHistory of the latest blocks.
+	GasOracleFeeHistory GasOracle = "fee_history"
+	// GasOracleStatic uses the gas fee given in the configuration.
+	GasOracleStatic GasOracle = "static"
+)
+
+// validateGasOracle checks whether the gas oracle of the config is supported, and that the
+// static gas oracle is given nonzero fees for the gas type.
+func validateGasOracle(cfg *EVMChainProviderConfig) error {
+	switch cfg.GasOracle {
+	case "", GasOracleRPC, GasOracleFeeHistory:
+		return nil
+	case GasOracleStatic:
+		switch cfg.GasType {
+		case GasTypeLegacy:
+			if cfg.StaticGasPrice == 0 {
+				return fmt.Errorf("static gas oracle requires static_gas_price")
+			}
+		case GasTypeEIP1559:
+			if cfg.StaticBaseFee == 0 || cfg.StaticPriorityFee == 0 {
+				return fmt.Errorf("static gas oracle requires static_base_fee and static_priority_fee")
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported gas oracle: %s", cfg.GasOracle)
+	}
+}
+
+// estimateGasFeeFromRPC estimates the gas fee from the gas price RPC methods of the node.
+func (cp *EVMChainProvider) estimateGasFeeFromRPC(ctx context.Context) (GasInfo, error) {
+	switch cp.GasType {
+	case GasTypeLegacy:
+		gasPrice, err := cp.Client.EstimateGasPrice(ctx)
+		if err != nil {
+			return GasInfo{}, err
+		}
+
+		return NewGasLegacyInfo(gasPrice), nil
+	case GasTypeEIP1559:
+		priorityFee, err := cp.Client.EstimateGasTipCap(ctx)
+		if err != nil {
+			return GasInfo{}, err
+		}
+
+		baseFee, err := cp.Client.EstimateBaseFee(ctx)
+		if err != nil {
+			return GasInfo{}, err
+		}
+
+		return NewGasEIP1559Info(priorityFee, baseFee), nil
+	default:
+		return GasInfo{}, fmt.Errorf("unsupported gas type: %v", cp.GasType)
+	}
+}
+
+// estimateGasFeeFromFeeHistory estimates the gas fee from the fee history of the latest blocks.
+// The priority fee is the median of the priority fees at the configured reward percentile, and
+// the base fee is the base fee of the next block multiplied by the base fee multiplier.
+func (cp *EVMChainProvider) estimateGasFeeFromFeeHistory(ctx context.Context) (GasInfo, error) {
+	blockCount := cp.Config.FeeHistoryBlockCount
+	if blockCount == 0 {
+		blockCount = DefaultFeeHistoryBlockCount
+	}
+
+	percentile := cp.Config.FeeHistoryRewardPercentile
+	if percentile == 0 {
+		percentile = DefaultFeeHistoryRewardPercentile
+	}
+
+	multiplier := cp.Config.BaseFeeMultiplier
+	if multiplier == 0 {
+		multiplier = DefaultBaseFeeMultiplier
+	}
+
+	feeHistory, err := cp.Client.FeeHistory(ctx, blockCount, []float64{percentile})
+	if err != nil {
+		return GasInfo{}, err
+	}
+
+	if len(feeHistory.BaseFee) == 0 {
+		return GasInfo{}, fmt.Errorf("fee history has no base fee")
+	}
+
+	var rewards []*big.Int
+	for _, reward := range feeHistory.Reward {
+		if len(reward) > 0 && reward[0] != nil {
+			rewards = append(rewards, reward[0])
+		}
+	}
+
+	if len(rewards) == 0 {
+		return GasInfo{}, fmt.Errorf("fee history has no reward")
+	}
+
+	priorityFee := medianBigInt(rewards)
+
+	// the last base fee in the fee history is the base fee of the next block.
+	baseFee := MultiplyBigIntWithFloat64(feeHistory.BaseFee[len(feeHistory.BaseFee)-1], multiplier)
+
+	switch cp.GasType {
+	case GasTypeLegacy:
+		return NewGasLegacyInfo(new(big.Int).Add(baseFee, priorityFee)), nil
+	case GasTypeEIP1559:
+		return NewGasEIP1559Info(priorityFee, baseFee), nil
+	default:
+		return GasInfo{}, fmt.Errorf("unsupported gas type: %v", cp.GasType)
+	}
+}
+
+// estimateGasFeeFromStatic returns the gas fee given in the configuration.
+func (cp *EVMChainProvider) estimateGasFeeFromStatic() (GasInfo, error) {
+	switch cp.GasType {
+	case GasTypeLegacy:
+		return NewGasLegacyInfo(new(big.Int).SetUint64(cp.Config.StaticGasPrice)), nil
+	case GasTypeEIP1559:
+		return NewGasEIP1559Info(
+			new(big.Int).SetUint64(cp.Config.StaticPriorityFee),
+			new(big.Int).SetUint64(cp.Config.StaticBaseFee),
+		), nil
+	default:
+		return GasInfo{}, fmt.Errorf("unsupported gas type: %v", cp.GasType)
+	}
+}
+
+// medianBigInt returns the median of the given non-empty values.
+func medianBigInt(values []*big.Int) *big.Int {
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b *big.Int) int { return a.Cmp(b) })
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Int).Set(sorted[mid])
+	}
+
+	sum := new(big.Int).Add(sorted[mid-1], sorted[mid])
+	return sum.Div(sum, big.NewInt(2))
+}
@@ -0,0 +1,313 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// nonceErrorMsgs are the substrings of the node errors indicating that the local nonce
+// of a signer is out of sync with the chain.
+var nonceErrorMsgs = []string{
+	"nonce too low",
+	"nonce too high",
+	"invalid nonce",
+	"already known",
+}
+
+// IsNonceError checks whether the given error is caused by an out-of-sync nonce.
+func IsNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, m := range nonceErrorMsgs {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// signerNonces is the nonce state of a single signer tracked by NonceManager.
+type signerNonces struct {
+	synced bool
+	// next is the next nonce to be handed out if there is no gap to fill.
+	next uint64
+	// reserved contains the nonces handed out but not broadcasted yet; the value is true
+	// if the nonce is handed out to replace a stuck transaction.
+	reserved map[uint64]bool
+	// pending maps the nonces of the broadcasted transactions to their tx hashes.
+	pending map[uint64]string
+	// gaps contains the nonces below next that are not used by any transaction, sorted ascending.
+	gaps []uint64
+	// stuck contains the nonces of the transactions that are not mined in time and must be
+	// replaced before the later nonces can be mined, sorted ascending.
+	stuck []uint64
+	// broadcasted maps the pending and the stuck nonces to the transactions broadcasted with them.
+	broadcasted map[uint64]BroadcastedTx
+}
+
+// BroadcastedTx contains the variants of a transaction broadcasted with the same nonce.
+type BroadcastedTx struct {
+	// TxHashes are the hashes of the variants, the latest last.
+	TxHashes []string
+	// GasInfo is the gas information of the latest variant; nodes reject a replacement
+	// that does not pay enough more than it.
+	GasInfo GasInfo
+}
+
+// NonceManager hands out sequential nonces per signer and tracks the pending transactions
+// of each signer. Nonces that are released without being used, and nonces of the stuck
+// transactions, are handed out again before new ones, and the state is resynced from the
+// chain whenever the node reports a nonce error. A signer is held by a single relay until
+// its transaction is confirmed, so the only transactions of a signer in the mempool at the
+// same time are the stuck ones waiting to be replaced.
+type NonceManager struct {
+	client Client
+
+	mu      sync.Mutex
+	signers map[gethcommon.Address]*signerNonces
+}
+
+// NewNonceManager creates a new NonceManager.
+func NewNonceManager(client Client) *NonceManager {
+	return &NonceManager{
+		client:  client,
+		signers: make(map[gethcommon.Address]*signerNonces),
+	}
+}
+
+// Next returns the next nonce of the given signer. The lowest stuck nonce or gap is filled
+// first; the state is synced from the chain on the first call. If the nonce is the one of a
+// stuck transaction, the transaction is returned as well, as it must be replaced with higher
+// fees; otherwise, the returned transaction is nil.
+func (m *NonceManager) Next(ctx context.Context, addr gethcommon.Address) (uint64, *BroadcastedTx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state := m.getState(addr)
+	if !state.synced {
+		if err := m.resync(ctx, addr, state); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	var nonce uint64
+	isStuck := false
+	switch {
+	case len(state.stuck) > 0 && (len(state.gaps) == 0 || state.stuck[0] < state.gaps[0]):
+		nonce = state.stuck[0]
+		state.stuck = state.stuck[1:]
+		isStuck = true
+	case len(state.gaps) > 0:
+		nonce = state.gaps[0]
+		state.gaps = state.gaps[1:]
+	default:
+		nonce = state.next
+		state.next++
+	}
+
+	state.reserved[nonce] = isStuck
+	if !isStuck {
+		return nonce, nil, nil
+	}
+
+	stuckTx := state.broadcasted[nonce]
+	stuckTx.TxHashes = slices.Clone(stuckTx.TxHashes)
+
+	return nonce, &stuckTx, nil
+}
+
+// MarkPending records that the transaction with the given nonce and gas information has been
+// broadcasted. A transaction replacing another one with the same nonce is recorded as its
+// latest variant.
+func (m *NonceManager) MarkPending(addr gethcommon.Address, nonce uint64, txHash string, gasInfo GasInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state := m.getState(addr)
+	delete(state.reserved, nonce)
+	state.pending[nonce] = txHash
+
+	tx := state.broadcasted[nonce]
+	if !slices.Contains(tx.TxHashes, txHash) {
+		tx.TxHashes = append(slices.Clone(tx.TxHashes), txHash)
+	}
+	tx.GasInfo = gasInfo
+	state.broadcasted[nonce] = tx
+}
+
+// MarkDone records that the transaction with the given nonce has been mined, regardless
+// of its execution status.
+func (m *NonceManager) MarkDone(addr gethcommon.Address, nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state := m.getState(addr)
+	delete(state.reserved, nonce)
+	delete(state.pending, nonce)
+	delete(state.broadcasted, nonce)
+	state.stuck = removeNonce(state.stuck, nonce)
+}
+
+// MarkStuck records that the transaction with the given nonce is not mined in time and is
+// no longer being replaced, so that the nonce is handed out again by the next call of Next
+// to replace the transaction.
+func (m *NonceManager) MarkStuck(addr gethcommon.Address, nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state := m.getState(addr)
+	delete(state.reserved, nonce)
+	delete(state.pending, nonce)
+	state.stuck = insertNonce(state.stuck, nonce)
+}
+
+// Release returns the given nonce that is not used by a new transaction on the chain,
+// so that it is handed out again by the next call of Next. A nonce handed out to replace
+// a stuck transaction stays stuck.
+func (m *NonceManager) Release(addr gethcommon.Address, nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state := m.getState(addr)
+	isStuck := state.reserved[nonce]
+	delete(state.reserved, nonce)
+	delete(state.pending, nonce)
+
+	switch {
+	case isStuck:
+		state.stuck = insertNonce(state.stuck, nonce)
+	case nonce < state.next:
+		delete(state.broadcasted, nonce)
+		state.gaps = insertNonce(state.gaps, nonce)
+	}
+}
+
+// Resync resyncs the nonce state of the given signer from the chain.
+func (m *NonceManager) Resync(ctx context.Context, addr gethcommon.Address) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.resync(ctx, addr, m.getState(addr))
+}
+
+// PendingTxs returns the tx hashes of the pending transactions of the given signer by nonce.
+func (m *NonceManager) PendingTxs(addr gethcommon.Address) map[uint64]string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return maps.Clone(m.getState(addr).pending)
+}
+
+// TxHashes returns the hashes of the variants broadcasted with the given pending or stuck
+// nonce of the signer, the latest last.
+func (m *NonceManager) TxHashes(addr gethcommon.Address, nonce uint64) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.getState(addr).broadcasted[nonce].TxHashes)
+}
+
+// resync updates the state from the latest and the pending nonce of the signer on the chain.
+// Mined transactions are removed from the pending and the stuck sets, and the nonces between
+// the pending nonce of the chain and the next local nonce that are not in use are treated as
+// gaps. The stuck nonces not mined yet are kept, as their transactions are still in the
+// mempool and must be replaced.
+func (m *NonceManager) resync(ctx context.Context, addr gethcommon.Address, state *signerNonces) error {
+	pendingNonce, err := m.client.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("failed to get pending nonce: %w", err)
+	}
+
+	if state.synced {
+		latestNonce, err := m.client.NonceAt(ctx, addr)
+		if err != nil {
+			return fmt.Errorf("failed to get nonce: %w", err)
+		}
+
+		for nonce := range state.pending {
+			if nonce < latestNonce {
+				delete(state.pending, nonce)
+			}
+		}
+
+		state.stuck = slices.DeleteFunc(state.stuck, func(n uint64) bool { return n < latestNonce })
+		maps.DeleteFunc(state.broadcasted, func(n uint64, _ BroadcastedTx) bool { return n < latestNonce })
+	}
+
+	state.gaps = slices.DeleteFunc(state.gaps, func(n uint64) bool { return n < pendingNonce })
+
+	if !state.synced || pendingNonce >= state.next {
+		state.next = pendingNonce
+	} else {
+		for nonce := pendingNonce; nonce < state.next; nonce++ {
+			if state.isUsed(nonce) {
+				continue
+			}
+			state.gaps = insertNonce(state.gaps, nonce)
+		}
+	}
+
+	state.synced = true
+
+	return nil
+}
+
+// getState returns the state of the given signer, creating it if not exists.
+func (m *NonceManager) getState(addr gethcommon.Address) *signerNonces {
+	state, ok := m.signers[addr]
+	if !ok {
+		state = &signerNonces{
+			reserved:    make(map[uint64]bool),
+			pending:     make(map[uint64]string),
+			broadcasted: make(map[uint64]BroadcastedTx),
+		}
+		m.signers[addr] = state
+	}
+
+	return state
+}
+
+// isUsed checks whether the nonce is reserved, pending, stuck or already a gap.
+func (s *signerNonces) isUsed(nonce uint64) bool {
+	if _, ok := s.reserved[nonce]; ok {
+		return true
+	}
+	if _, ok := s.pending[nonce]; ok {
+		return true
+	}
+	if _, ok := slices.BinarySearch(s.stuck, nonce); ok {
+		return true
+	}
+	_, ok := slices.BinarySearch(s.gaps, nonce)
+	return ok
+}
+
+// insertNonce inserts the nonce into the sorted nonces, if not exists.
+func insertNonce(nonces []uint64, nonce uint64) []uint64 {
+	i, ok := slices.BinarySearch(nonces, nonce)
+	if ok {
+		return nonces
+	}
+
+	return slices.Insert(nonces, i, nonce)
+}
+
+// removeNonce removes the nonce from the sorted nonces, if exists.
+func removeNonce(nonces []uint64, nonce uint64) []uint64 {
+	i, ok := slices.BinarySearch(nonces, nonce)
+	if !ok {
+		return nonces
+	}
+
+	return slices.Delete(nonces, i, i+1)
+}
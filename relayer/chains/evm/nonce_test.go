@@ -0,0 +1,193 @@
+package evm_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
+	"github.com/bandprotocol/falcon/relayer/chains/evm"
+)
+
+type NonceManagerTestSuite struct {
+	suite.Suite
+
+	client  *mocks.MockEVMClient
+	manager *evm.NonceManager
+	addr    common.Address
+	gasInfo evm.GasInfo
+}
+
+func TestNonceManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(NonceManagerTestSuite))
+}
+
+func (s *NonceManagerTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.client = mocks.NewMockEVMClient(ctrl)
+	s.manager = evm.NewNonceManager(s.client)
+	s.addr = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	s.gasInfo = evm.NewGasLegacyInfo(big.NewInt(10_000_000_000))
+}
+
+func (s *NonceManagerTestSuite) next() uint64 {
+	nonce, _, err := s.manager.Next(context.Background(), s.addr)
+	s.Require().NoError(err)
+	return nonce
+}
+
+func (s *NonceManagerTestSuite) TestNextSequential() {
+	s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(5), nil).Times(1)
+
+	s.Require().Equal(uint64(5), s.next())
+	s.Require().Equal(uint64(6), s.next())
+	s.Require().Equal(uint64(7), s.next())
+}
+
+func (s *NonceManagerTestSuite) TestNextSyncError() {
+	s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(0), fmt.Errorf("connection refused"))
+
+	_, _, err := s.manager.Next(context.Background(), s.addr)
+	s.Require().ErrorContains(err, "failed to get pending nonce")
+}
+
+func (s *NonceManagerTestSuite) TestReleaseFillsGap() {
+	s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(5), nil).Times(1)
+
+	s.Require().Equal(uint64(5), s.next())
+	s.Require().Equal(uint64(6), s.next())
+	s.Require().Equal(uint64(7), s.next())
+
+	s.manager.Release(s.addr, 6)
+	s.manager.Release(s.addr, 5)
+
+	s.Require().Equal(uint64(5), s.next())
+	s.Require().Equal(uint64(6), s.next())
+	s.Require().Equal(uint64(8), s.next())
+}
+
+func (s *NonceManagerTestSuite) TestPendingTxs() {
+	s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(5), nil).Times(1)
+
+	s.manager.MarkPending(s.addr, s.next(), "0xabc", s.gasInfo)
+	s.manager.MarkPending(s.addr, s.next(), "0xdef", s.gasInfo)
+	s.Require().Equal(map[uint64]string{5: "0xabc", 6: "0xdef"}, s.manager.PendingTxs(s.addr))
+
+	s.manager.MarkDone(s.addr, 5)
+	s.Require().Equal(map[uint64]string{6: "0xdef"}, s.manager.PendingTxs(s.addr))
+}
+
+func (s *NonceManagerTestSuite) TestResyncNonceTooLow() {
+	gomock.InOrder(
+		s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(5), nil),
+		s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(10), nil),
+	)
+	s.client.EXPECT().NonceAt(gomock.Any(), s.addr).Return(uint64(9), nil)
+
+	s.manager.MarkPending(s.addr, s.next(), "0xabc", s.gasInfo)
+	s.Require().Equal(uint64(6), s.next())
+
+	// the signer is used by another process; the chain is ahead of the local state.
+	err := s.manager.Resync(context.Background(), s.addr)
+	s.Require().NoError(err)
+
+	s.Require().Empty(s.manager.PendingTxs(s.addr))
+	s.Require().Equal(uint64(10), s.next())
+}
+
+func (s *NonceManagerTestSuite) TestResyncDetectsGaps() {
+	gomock.InOrder(
+		s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(5), nil),
+		s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(6), nil),
+	)
+	s.client.EXPECT().NonceAt(gomock.Any(), s.addr).Return(uint64(5), nil)
+
+	s.manager.MarkPending(s.addr, s.next(), "0xabc", s.gasInfo)
+	s.Require().Equal(uint64(6), s.next())
+	s.Require().Equal(uint64(7), s.next())
+	s.manager.MarkPending(s.addr, 7, "0xdef", s.gasInfo)
+
+	// nonce 6 is no longer tracked locally, but the chain has not used it.
+	s.manager.MarkDone(s.addr, 6)
+	err := s.manager.Resync(context.Background(), s.addr)
+	s.Require().NoError(err)
+
+	s.Require().Equal(uint64(6), s.next())
+	s.Require().Equal(uint64(8), s.next())
+	s.Require().Equal(map[uint64]string{5: "0xabc", 7: "0xdef"}, s.manager.PendingTxs(s.addr))
+}
+
+func (s *NonceManagerTestSuite) TestMarkStuckReusesNonce() {
+	gomock.InOrder(
+		s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(5), nil),
+		s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(7), nil),
+		s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(7), nil),
+	)
+	gomock.InOrder(
+		s.client.EXPECT().NonceAt(gomock.Any(), s.addr).Return(uint64(5), nil),
+		s.client.EXPECT().NonceAt(gomock.Any(), s.addr).Return(uint64(6), nil),
+	)
+
+	s.manager.MarkPending(s.addr, s.next(), "0xabc", s.gasInfo)
+	s.manager.MarkPending(s.addr, s.next(), "0xdef", s.gasInfo)
+
+	// the transaction of nonce 5 is timed out; the next relay replaces it.
+	s.manager.MarkStuck(s.addr, 5)
+	s.Require().Equal(map[uint64]string{6: "0xdef"}, s.manager.PendingTxs(s.addr))
+	s.Require().Equal(uint64(5), s.next())
+
+	// the replacement fails to be broadcasted; the nonce stays stuck.
+	s.manager.Release(s.addr, 5)
+
+	// the stuck transaction is still in the mempool, so the resync keeps the nonce.
+	err := s.manager.Resync(context.Background(), s.addr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), s.next())
+	s.manager.Release(s.addr, 5)
+
+	// the stuck transaction is mined in the meantime.
+	err = s.manager.Resync(context.Background(), s.addr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(7), s.next())
+}
+
+func (s *NonceManagerTestSuite) TestNextReturnsStuckTx() {
+	s.client.EXPECT().PendingNonceAt(gomock.Any(), s.addr).Return(uint64(5), nil)
+
+	// the transaction of nonce 5 is replaced once, then timed out.
+	s.manager.MarkPending(s.addr, s.next(), "0xabc", s.gasInfo)
+	bumpedGasInfo := evm.NewGasLegacyInfo(big.NewInt(11_000_000_000))
+	s.manager.MarkPending(s.addr, 5, "0xdef", bumpedGasInfo)
+	s.manager.MarkStuck(s.addr, 5)
+
+	nonce, stuckTx, err := s.manager.Next(context.Background(), s.addr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), nonce)
+	s.Require().Equal(&evm.BroadcastedTx{TxHashes: []string{"0xabc", "0xdef"}, GasInfo: bumpedGasInfo}, stuckTx)
+
+	// a new nonce does not replace any transaction.
+	nonce, stuckTx, err = s.manager.Next(context.Background(), s.addr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6), nonce)
+	s.Require().Nil(stuckTx)
+
+	// the fees of the stuck transaction are kept until it is replaced.
+	s.manager.Release(s.addr, 5)
+	_, stuckTx, err = s.manager.Next(context.Background(), s.addr)
+	s.Require().NoError(err)
+	s.Require().Equal(bumpedGasInfo, stuckTx.GasInfo)
+}
+
+func TestIsNonceError(t *testing.T) {
+	require.True(t, evm.IsNonceError(fmt.Errorf("failed to broadcast: nonce too low")))
+	require.True(t, evm.IsNonceError(fmt.Errorf("Nonce too high")))
+	require.True(t, evm.IsNonceError(fmt.Errorf("already known")))
+	require.False(t, evm.IsNonceError(fmt.Errorf("replacement transaction underpriced")))
+	require.False(t, evm.IsNonceError(nil))
+}
@@ -0,0 +1,145 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/bandprotocol/falcon/internal/relayermetrics"
+	"github.com/bandprotocol/falcon/relayer/alert"
+	"github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/penalty"
+)
+
+// ErrUnprofitableRelay is returned when a relay is skipped because its estimated gas cost
+// exceeds the reimbursement from the tunnel router.
+var ErrUnprofitableRelay = errors.New("relay gas cost exceeds reimbursement")
+
+// ProfitabilityPolicy defines how the provider handles a relay whose estimated gas cost
+// exceeds the reimbursement from the tunnel router.
+type ProfitabilityPolicy string
+
+const (
+	// ProfitabilityPolicyAlways relays the packet without checking the profitability.
+	// It is the default policy.
+	ProfitabilityPolicyAlways ProfitabilityPolicy = "always"
+	// ProfitabilityPolicySkipIfUnprofitable skips relaying an unprofitable packet.
+	ProfitabilityPolicySkipIfUnprofitable ProfitabilityPolicy = "skip_if_unprofitable"
+	// ProfitabilityPolicyAlertIfUnprofitable relays an unprofitable packet and triggers an alert.
+	ProfitabilityPolicyAlertIfUnprofitable ProfitabilityPolicy = "alert_if_unprofitable"
+)
+
+// validateProfitabilityPolicy checks whether the given policy is supported.
+func validateProfitabilityPolicy(policy ProfitabilityPolicy) error {
+	switch policy {
+	case "", ProfitabilityPolicyAlways, ProfitabilityPolicySkipIfUnprofitable, ProfitabilityPolicyAlertIfUnprofitable:
+		return nil
+	default:
+		return fmt.Errorf("unsupported profitability policy: %s", policy)
+	}
+}
+
+// RelayProfitability is the estimated cost and reimbursement of a relay transaction.
+type RelayProfitability struct {
+	Cost          *big.Int
+	Reimbursement *big.Int
+}
+
+// Profit returns the estimated reimbursement minus the estimated cost.
+func (p RelayProfitability) Profit() *big.Int {
+	return new(big.Int).Sub(p.Reimbursement, p.Cost)
+}
+
+// IsProfitable checks whether the reimbursement covers the cost.
+func (p RelayProfitability) IsProfitable() bool {
+	return p.Reimbursement.Cmp(p.Cost) >= 0
+}
+
+// EstimateRelayProfitability estimates the cost of the transaction as gasLimit * its effective
+// gas price and the reimbursement as gasLimit * the relayer gas fee of the tunnel router. The
+// effective gas price of an EIP-1559 transaction is min(gasFeeCap, baseFee + gasTipCap) at the
+// estimated base fee, as the transaction never pays its whole fee cap.
+func (cp *EVMChainProvider) EstimateRelayProfitability(
+	ctx context.Context,
+	tx *gethtypes.Transaction,
+) (RelayProfitability, error) {
+	relayerGasFee, err := cp.queryRelayerGasFee(ctx)
+	if err != nil {
+		return RelayProfitability{}, err
+	}
+
+	gasPrice := tx.GasPrice()
+	if tx.Type() == gethtypes.DynamicFeeTxType {
+		baseFee, err := cp.Client.EstimateBaseFee(ctx)
+		if err != nil {
+			return RelayProfitability{}, err
+		}
+
+		gasPrice = new(big.Int).Add(baseFee, tx.GasTipCap())
+		if gasPrice.Cmp(tx.GasFeeCap()) > 0 {
+			gasPrice = tx.GasFeeCap()
+		}
+	}
+
+	gasLimit := new(big.Int).SetUint64(tx.Gas())
+
+	return RelayProfitability{
+		Cost:          new(big.Int).Mul(gasLimit, gasPrice),
+		Reimbursement: new(big.Int).Mul(gasLimit, relayerGasFee),
+	}, nil
+}
+
+// checkProfitability applies the profitability policy of the chain to the transaction.
+// It returns ErrUnprofitableRelay if the transaction should not be broadcasted. If the
+// profitability cannot be estimated, the transaction is relayed as usual.
+func (cp *EVMChainProvider) checkProfitability(
+	ctx context.Context,
+	tunnelID uint64,
+	tx *gethtypes.Transaction,
+	log logger.Logger,
+) error {
+	policy := cp.Config.ProfitabilityPolicy
+	if policy != ProfitabilityPolicySkipIfUnprofitable && policy != ProfitabilityPolicyAlertIfUnprofitable {
+		return nil
+	}
+
+	profitability, err := cp.EstimateRelayProfitability(ctx, tx)
+	if err != nil {
+		log.Error("Failed to estimate relay profitability", err)
+		return nil
+	}
+
+	profit, _ := new(big.Float).SetInt(profitability.Profit()).Float64()
+	relayermetrics.SetEstimatedRelayProfit(tunnelID, cp.ChainName, types.ChainTypeEVM.String(), profit)
+
+	topic := alert.NewTopic(alert.UnprofitableRelayMsg).WithTunnelID(tunnelID).WithChainName(cp.ChainName)
+	if profitability.IsProfitable() {
+		alert.HandleReset(cp.Alert, topic)
+		return nil
+	}
+
+	relayermetrics.IncUnprofitableRelaysCount(tunnelID, cp.ChainName, types.ChainTypeEVM.String(), string(policy))
+
+	detail := fmt.Sprintf(
+		"estimated gas cost %s exceeds reimbursement %s",
+		profitability.Cost,
+		profitability.Reimbursement,
+	)
+	log.Warn(
+		"Relay is unprofitable",
+		"policy", policy,
+		"estimated_cost", profitability.Cost.String(),
+		"estimated_reimbursement", profitability.Reimbursement.String(),
+	)
+	alert.HandleAlert(cp.Alert, topic, detail)
+
+	if policy == ProfitabilityPolicySkipIfUnprofitable {
+		return penalty.NewError(penalty.ClassUnprofitable, fmt.Errorf("%w: %s", ErrUnprofitableRelay, detail))
+	}
+
+	return nil
+}
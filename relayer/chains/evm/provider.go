@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/shopspring/decimal"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/internal/relayermetrics"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
@@ -34,7 +36,8 @@ type EVMChainProvider struct {
 	Client  Client
 	GasType GasType
 
-	FreeSigners chan wallet.Signer
+	FreeSigners  chan wallet.Signer
+	NonceManager *NonceManager
 
 	TunnelRouterAddress gethcommon.Address
 	TunnelRouterABI     abi.ABI
@@ -43,8 +46,9 @@ type EVMChainProvider struct {
 
 	DB db.Database
 
-	Alert  alert.Alert
-	Wallet wallet.Wallet
+	Alert           alert.Alert
+	SigningVerifier chains.SigningVerifier
+	Wallet          wallet.Wallet
 }
 
 // NewEVMChainProvider creates a new EVM chain provider.
@@ -55,6 +59,7 @@ func NewEVMChainProvider(
 	log logger.Logger,
 	w wallet.Wallet,
 	a alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) (*EVMChainProvider, error) {
 	// load abis here
 	abi, err := abi.JSON(strings.NewReader(gasPriceTunnelRouterABI))
@@ -75,6 +80,14 @@ func NewEVMChainProvider(
 		return nil, fmt.Errorf("[EVMProvider] incorrect address: %w", err)
 	}
 
+	if err := validateProfitabilityPolicy(cfg.ProfitabilityPolicy); err != nil {
+		return nil, fmt.Errorf("[EVMProvider] %w", err)
+	}
+
+	if err := validateGasOracle(cfg); err != nil {
+		return nil, fmt.Errorf("[EVMProvider] %w", err)
+	}
+
 	return &EVMChainProvider{
 		Config:              cfg,
 		ChainName:           chainName,
@@ -84,7 +97,9 @@ func NewEVMChainProvider(
 		TunnelRouterABI:     abi,
 		Log:                 log.With("chain_name", chainName),
 		Alert:               a,
-		FreeSigners:         chains.LoadSigners(w),
+		SigningVerifier:     signingVerifier,
+		FreeSigners:         chains.LoadSigners(w, cfg.TreasuryKey),
+		NonceManager:        NewNonceManager(client),
 		Wallet:              w,
 	}, nil
 }
@@ -140,15 +155,20 @@ func (cp *EVMChainProvider) QueryTunnelInfo(
 }
 
 // RelayPacket relays the packet from the source chain to the destination chain.
-func (cp *EVMChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) error {
+func (cp *EVMChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) (string, error) {
 	if err := cp.Client.CheckAndConnect(ctx); err != nil {
 		cp.Log.Error("Connect client error", err)
-		return fmt.Errorf("[EVMProvider] failed to connect client: %w", err)
+		return "", fmt.Errorf("[EVMProvider] failed to connect client: %w", err)
 	}
 
 	// get a free signer
 	cp.Log.Debug("Waiting for a free signer...")
-	freeSigner := <-cp.FreeSigners
+	var freeSigner wallet.Signer
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("[EVMProvider] context canceled while waiting for signer: %w", ctx.Err())
+	case freeSigner = <-cp.FreeSigners:
+	}
 	defer func() { cp.FreeSigners <- freeSigner }()
 
 	log := cp.Log.With(
@@ -166,26 +186,65 @@ func (cp *EVMChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.P
 			alert.NewTopic(alert.EstimateGasFeeErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 			err.Error(),
 		)
-		return fmt.Errorf("[EVMProvider] failed to estimate gas fee: %w", err)
+		return "", fmt.Errorf("[EVMProvider] failed to estimate gas fee: %w", err)
 	}
 	alert.HandleReset(
 		cp.Alert,
 		alert.NewTopic(alert.EstimateGasFeeErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 	)
 
+	signerAddr := gethcommon.HexToAddress(freeSigner.GetAddress())
+
+	// stuckNonce is the nonce of the timed-out transaction of this packet, or of the stuck
+	// transaction of an earlier relay being replaced; the next attempt reuses it to replace
+	// the transaction instead of queuing a new one behind it. If the packet is not relayed,
+	// the nonce is left to the next relay of the signer.
+	var stuckNonce *uint64
+	defer func() {
+		if stuckNonce != nil {
+			cp.NonceManager.MarkStuck(signerAddr, *stuckNonce)
+		}
+	}()
+
+	// packetTxHashes are the hashes of all transactions broadcasted to relay this packet.
+	var packetTxHashes []string
+
 	var lastErr error
 	var bumpGasErr error
 	for retryCount := 1; retryCount <= cp.Config.MaxRetry; retryCount++ {
 		log.Info("Relaying a message", "retry_count", retryCount)
 
+		nonce, nonceGasInfo, isReplacement, err := cp.getRelayNonce(ctx, signerAddr, stuckNonce, gasInfo, log)
+		if err != nil {
+			lastErr = fmt.Errorf("get nonce error: %v", err)
+			log.Error("Failed to get nonce", "retry_count", retryCount, err)
+			continue
+		}
+		gasInfo = nonceGasInfo
+		if isReplacement {
+			stuckNonce = &nonce
+		}
+
 		// create and submit a transaction; if failed, retry, no need to bump gas.
-		signedTx, err := cp.createAndSignRelayTx(ctx, packet, freeSigner, gasInfo)
+		signedTx, err := cp.createAndSignRelayTx(ctx, packet, freeSigner, nonce, gasInfo)
 		if err != nil {
-			lastErr = fmt.Errorf("create and sign tx error: %v", err)
+			if stuckNonce == nil {
+				cp.NonceManager.Release(signerAddr, nonce)
+			}
+
+			lastErr = fmt.Errorf("create and sign tx error: %w", err)
 			log.Error("CreateAndSignTx error", "retry_count", retryCount, err)
 			continue
 		}
 
+		if err := cp.checkProfitability(ctx, packet.TunnelID, signedTx, log); err != nil {
+			if stuckNonce == nil {
+				cp.NonceManager.Release(signerAddr, nonce)
+			}
+
+			return "", fmt.Errorf("[EVMProvider] skip relaying packet: %w", err)
+		}
+
 		var balance *big.Int
 		if cp.DB != nil {
 			balance, err = cp.Client.GetBalance(ctx, gethcommon.HexToAddress(freeSigner.GetAddress()), nil)
@@ -202,12 +261,77 @@ func (cp *EVMChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.P
 		}
 
 		// submit the transaction, if failed, bump gas and retry
-		txHash, err := cp.Client.BroadcastTx(ctx, signedTx)
-		if err != nil {
+		createdAt := time.Now()
+		var txHash string
+		var txResult TxResult
+		broadcastedTxHash, err := cp.Client.BroadcastTx(ctx, signedTx)
+		switch {
+		case err == nil:
+			cp.NonceManager.MarkPending(signerAddr, nonce, broadcastedTxHash, gasInfo)
+			packetTxHashes = append(packetTxHashes, broadcastedTxHash)
+
+			log.Info(
+				"Submitted a message; checking transaction status",
+				"tx_hash", broadcastedTxHash,
+				"nonce", nonce,
+				"retry_count", retryCount,
+			)
+
+			// save pending tx in db
+			if cp.DB != nil {
+				tx := cp.prepareTransaction(
+					ctx,
+					broadcastedTxHash,
+					freeSigner.GetAddress(),
+					packet,
+					nil,
+					balance,
+					log,
+					retryCount,
+				)
+				chains.HandleSaveTransaction(cp.DB, cp.Alert, tx, log)
+			}
+
+			var replacementTxHashes []string
+			txHash, replacementTxHashes, txResult, gasInfo = cp.waitForConfirmedOrReplaceTx(
+				ctx,
+				packet,
+				freeSigner,
+				nonce,
+				gasInfo,
+				balance,
+				log,
+				retryCount,
+			)
+			packetTxHashes = append(packetTxHashes, replacementTxHashes...)
+		case stuckNonce != nil && IsNonceError(err):
+			// a variant of the stuck transaction is mined before being replaced.
+			log.Info("Stuck transaction is mined before being replaced", "nonce", nonce, "retry_count", retryCount)
+			txHash, txResult = cp.waitForConfirmedTxs(
+				ctx,
+				cp.NonceManager.TxHashes(signerAddr, nonce),
+				cp.Config.WaitingTxDuration,
+				log,
+			)
+			if txResult.Status == types.TX_STATUS_TIMEOUT {
+				// the nonce is used by a transaction that is not tracked.
+				cp.handleBroadcastNonce(ctx, signerAddr, nonce, true, err, log)
+				stuckNonce = nil
+
+				lastErr = fmt.Errorf("broadcast tx error: %v", err)
+				log.Error("HandleRelay error", "retry_count", retryCount, err)
+				continue
+			}
+		default:
+			cp.handleBroadcastNonce(ctx, signerAddr, nonce, stuckNonce != nil, err, log)
+			if IsNonceError(err) {
+				stuckNonce = nil
+			}
+
 			lastErr = fmt.Errorf("broadcast tx error: %v", err)
 			log.Error("HandleRelay error", "retry_count", retryCount, err)
 			// bump gas and retry
-			gasInfo, bumpGasErr = cp.BumpAndBoundGas(ctx, gasInfo, cp.Config.GasMultiplier)
+			gasInfo, bumpGasErr = cp.bumpGas(ctx, gasInfo, stuckNonce != nil)
 			if bumpGasErr != nil {
 				log.Error("Cannot bump gas", "retry_count", retryCount, bumpGasErr)
 			}
@@ -215,37 +339,42 @@ func (cp *EVMChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.P
 			continue
 		}
 
-		createdAt := time.Now()
-
-		log.Info(
-			"Submitted a message; checking transaction status",
-			"tx_hash", txHash,
-			"retry_count", retryCount,
+		isMined := txResult.Status == types.TX_STATUS_SUCCESS || txResult.Status == types.TX_STATUS_FAILED
+		isPacketTx := slices.Contains(packetTxHashes, txHash)
+		cp.saveRelayTxs(
+			ctx,
+			packet,
+			freeSigner,
+			cp.NonceManager.TxHashes(signerAddr, nonce),
+			packetTxHashes,
+			txHash,
+			txResult,
+			balance,
+			log,
+			retryCount,
 		)
-
-		// save pending tx in db
-		if cp.DB != nil {
-			tx := cp.prepareTransaction(ctx, txHash, freeSigner.GetAddress(), packet, nil, balance, log, retryCount)
-			chains.HandleSaveTransaction(cp.DB, cp.Alert, tx, log)
+		if isMined {
+			cp.NonceManager.MarkDone(signerAddr, nonce)
+			stuckNonce = nil
+		} else {
+			stuckNonce = &nonce
 		}
 
-		txResult := cp.WaitForConfirmedTx(ctx, txHash, log)
-
-		cp.handleMetrics(packet.TunnelID, createdAt, txResult)
-		if cp.DB != nil {
-			tx := cp.prepareTransaction(
-				ctx,
-				txHash,
-				freeSigner.GetAddress(),
-				packet,
-				&txResult,
-				balance,
-				log,
-				retryCount,
+		// the nonce is used by the stuck transaction of an earlier relay; relay the packet
+		// with a new nonce.
+		if !isPacketTx {
+			lastErr = fmt.Errorf("nonce %d is used by the stuck transaction %s", nonce, txHash)
+			log.Warn(
+				"Stuck transaction of an earlier relay is mined before being replaced",
+				"tx_hash", txHash,
+				"nonce", nonce,
+				"retry_count", retryCount,
 			)
-			chains.HandleSaveTransaction(cp.DB, cp.Alert, tx, log)
+			continue
 		}
 
+		cp.handleMetrics(packet.TunnelID, createdAt, txResult)
+
 		if txResult.Status == types.TX_STATUS_SUCCESS {
 			log.Info(
 				"Packet is successfully relayed",
@@ -256,7 +385,7 @@ func (cp *EVMChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.P
 				cp.Alert,
 				alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 			)
-			return nil
+			return txHash, nil
 		}
 
 		lastErr = fmt.Errorf("%s", txResult.FailureReason)
@@ -269,7 +398,7 @@ func (cp *EVMChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.P
 		)
 
 		// bump gas and retry
-		gasInfo, bumpGasErr = cp.BumpAndBoundGas(ctx, gasInfo, cp.Config.GasMultiplier)
+		gasInfo, bumpGasErr = cp.bumpGas(ctx, gasInfo, stuckNonce != nil)
 		if bumpGasErr != nil {
 			log.Error("Cannot bump gas", "retry_count", retryCount, bumpGasErr)
 		}
@@ -289,7 +418,128 @@ func (cp *EVMChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.P
 		lastErr.Error(),
 	)
 
-	return fmt.Errorf("[EVMProvider] failed to relay packet after %d retries", cp.Config.MaxRetry)
+	return "", fmt.Errorf("[EVMProvider] failed to relay packet after %d retries: %w", cp.Config.MaxRetry, lastErr)
+}
+
+// getRelayNonce returns the nonce of the next relay attempt, the gas information to use and
+// whether the transaction replaces a stuck one. The nonce of the stuck transaction of this
+// packet is reused with the given gas information if given; otherwise, the nonce is taken
+// from the nonce manager, see nextNonce.
+func (cp *EVMChainProvider) getRelayNonce(
+	ctx context.Context,
+	signerAddr gethcommon.Address,
+	stuckNonce *uint64,
+	gasInfo GasInfo,
+	log logger.Logger,
+) (uint64, GasInfo, bool, error) {
+	if stuckNonce != nil {
+		return *stuckNonce, gasInfo, true, nil
+	}
+
+	return cp.nextNonce(ctx, signerAddr, gasInfo, true, log)
+}
+
+// nextNonce takes the next nonce of the signer from the nonce manager and returns it with the
+// gas information to use and whether the transaction replaces a stuck one. If the nonce is the
+// one of a stuck transaction, the gas must pay enough more than the stuck transaction, see
+// replacementGasInfo. A stuck transaction that cannot be replaced, as the gas reaches the cap
+// or must not be raised, is left pending to be mined and the next nonce is taken instead.
+func (cp *EVMChainProvider) nextNonce(
+	ctx context.Context,
+	addr gethcommon.Address,
+	gasInfo GasInfo,
+	canRaiseGas bool,
+	log logger.Logger,
+) (uint64, GasInfo, bool, error) {
+	for {
+		nonce, stuckTx, err := cp.NonceManager.Next(ctx, addr)
+		if err != nil {
+			return 0, GasInfo{}, false, err
+		}
+		if stuckTx == nil {
+			return nonce, gasInfo, false, nil
+		}
+
+		newGasInfo, ok, err := cp.replacementGasInfo(ctx, gasInfo, stuckTx.GasInfo, canRaiseGas)
+		if err != nil {
+			cp.NonceManager.Release(addr, nonce)
+			return 0, GasInfo{}, false, err
+		}
+		if ok {
+			return nonce, newGasInfo, true, nil
+		}
+
+		stuckTxHash := stuckTx.TxHashes[len(stuckTx.TxHashes)-1]
+		log.Warn(
+			"Cannot pay enough to replace the stuck transaction; waiting for it to be mined",
+			"nonce", nonce,
+			"tx_hash", stuckTxHash,
+		)
+		cp.NonceManager.MarkPending(addr, nonce, stuckTxHash, stuckTx.GasInfo)
+	}
+}
+
+// replacementGasInfo returns the gas information of a transaction replacing the stuck one with
+// the given gas information. The estimated gas is used if it pays enough more than the stuck
+// transaction; otherwise, if canRaiseGas is true, each fee is raised to at least the one of the
+// stuck transaction bumped by minReplacementGasMultiplier, bounded by the caps. It returns false
+// if the replacement cannot pay enough more than the stuck transaction.
+func (cp *EVMChainProvider) replacementGasInfo(
+	ctx context.Context,
+	gasInfo GasInfo,
+	stuckGasInfo GasInfo,
+	canRaiseGas bool,
+) (GasInfo, bool, error) {
+	// the fees of the stuck transaction are unknown; the node rejects the replacement if needed.
+	if stuckGasInfo.Type != gasInfo.Type {
+		return gasInfo, true, nil
+	}
+	if isGasBumped(stuckGasInfo, gasInfo) {
+		return gasInfo, true, nil
+	}
+	if !canRaiseGas {
+		return GasInfo{}, false, nil
+	}
+
+	bumpedGasInfo, err := cp.bumpGas(ctx, stuckGasInfo, true)
+	if err != nil {
+		return GasInfo{}, false, err
+	}
+
+	newGasInfo := maxGasInfo(gasInfo, bumpedGasInfo)
+	if !isGasBumped(stuckGasInfo, newGasInfo) {
+		return GasInfo{}, false, nil
+	}
+
+	return newGasInfo, true, nil
+}
+
+// handleBroadcastNonce updates the nonce manager after the transaction with the given nonce
+// failed to be broadcasted. A nonce that was not in use before is released, and the nonce
+// state is resynced from the chain if the node reports a nonce error.
+func (cp *EVMChainProvider) handleBroadcastNonce(
+	ctx context.Context,
+	signerAddr gethcommon.Address,
+	nonce uint64,
+	isReplacement bool,
+	broadcastErr error,
+	log logger.Logger,
+) {
+	switch {
+	case !isReplacement:
+		cp.NonceManager.Release(signerAddr, nonce)
+	case IsNonceError(broadcastErr):
+		// the transaction being replaced is already mined.
+		cp.NonceManager.MarkDone(signerAddr, nonce)
+	}
+
+	if !IsNonceError(broadcastErr) {
+		return
+	}
+
+	if err := cp.NonceManager.Resync(ctx, signerAddr); err != nil {
+		log.Error("Failed to resync nonce", "nonce", nonce, err)
+	}
 }
 
 // createAndSignRelayTx creates and signs the relay transaction.
@@ -297,14 +547,15 @@ func (cp *EVMChainProvider) createAndSignRelayTx(
 	ctx context.Context,
 	packet *bandtypes.Packet,
 	signer wallet.Signer,
+	nonce uint64,
 	gasInfo GasInfo,
 ) (*gethtypes.Transaction, error) {
-	calldata, err := cp.CreateCalldata(packet)
+	calldata, err := cp.CreateCalldata(ctx, packet)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create calldata: %w", err)
 	}
 
-	tx, err := cp.NewRelayTx(ctx, calldata, signer, gasInfo)
+	tx, err := cp.NewRelayTx(ctx, calldata, signer, nonce, gasInfo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create an evm transaction: %w", err)
 	}
@@ -317,6 +568,184 @@ func (cp *EVMChainProvider) createAndSignRelayTx(
 	return signedTx, nil
 }
 
+// waitForConfirmedOrReplaceTx waits for one of the variants broadcasted with the nonce to be
+// confirmed, including the ones of the earlier attempts and relays. If none of them is mined
+// within the replacement interval, the transaction is replaced by a new variant with the same
+// nonce and bumped fees, up to MaxReplacements times. It returns the hash and the result of
+// the mined variant (or of the latest variant if none is mined), the hashes of the broadcasted
+// replacements, and the latest gas information.
+func (cp *EVMChainProvider) waitForConfirmedOrReplaceTx(
+	ctx context.Context,
+	packet *bandtypes.Packet,
+	signer wallet.Signer,
+	nonce uint64,
+	gasInfo GasInfo,
+	balance *big.Int,
+	log logger.Logger,
+	retryCount int,
+) (string, []string, TxResult, GasInfo) {
+	interval := cp.Config.ReplacementInterval
+	if interval <= 0 {
+		interval = cp.Config.WaitingTxDuration
+	}
+
+	signerAddr := gethcommon.HexToAddress(signer.GetAddress())
+	txHashes := cp.NonceManager.TxHashes(signerAddr, nonce)
+	var replacementTxHashes []string
+	for replacementCount := 1; ; replacementCount++ {
+		waitingDuration := interval
+		if replacementCount > cp.Config.MaxReplacements {
+			waitingDuration = cp.Config.WaitingTxDuration
+		}
+
+		minedTxHash, txResult := cp.waitForConfirmedTxs(ctx, txHashes, waitingDuration, log)
+		if txResult.Status != types.TX_STATUS_TIMEOUT || replacementCount > cp.Config.MaxReplacements {
+			return minedTxHash, replacementTxHashes, txResult, gasInfo
+		}
+
+		newGasInfo, err := cp.bumpGas(ctx, gasInfo, true)
+		if err != nil {
+			log.Error("Cannot bump gas for replacement", "replacement_count", replacementCount, err)
+			continue
+		}
+		if !isGasBumped(gasInfo, newGasInfo) {
+			log.Warn("Gas reaches the cap; skip replacing the transaction", "replacement_count", replacementCount)
+			continue
+		}
+
+		// the transaction may fail to be created or broadcasted if one of the variants
+		// has been mined in the meantime; keep waiting for the variants in that case.
+		replacementTx, err := cp.createAndSignRelayTx(ctx, packet, signer, nonce, newGasInfo)
+		if err != nil {
+			log.Error("Failed to create a replacement tx", "replacement_count", replacementCount, err)
+			continue
+		}
+
+		replacementTxHash, err := cp.Client.BroadcastTx(ctx, replacementTx)
+		if err != nil {
+			log.Error("Failed to broadcast a replacement tx", "replacement_count", replacementCount, err)
+			continue
+		}
+
+		gasInfo = newGasInfo
+		txHashes = append(txHashes, replacementTxHash)
+		replacementTxHashes = append(replacementTxHashes, replacementTxHash)
+		cp.NonceManager.MarkPending(signerAddr, nonce, replacementTxHash, newGasInfo)
+
+		log.Info(
+			"Replaced a stuck transaction",
+			"tx_hash", replacementTxHash,
+			"replaced_tx_hash", txHashes[len(txHashes)-2],
+			"nonce", nonce,
+			"replacement_count", replacementCount,
+			"retry_count", retryCount,
+		)
+
+		if cp.DB != nil {
+			tx := cp.prepareTransaction(
+				ctx,
+				replacementTxHash,
+				signer.GetAddress(),
+				packet,
+				nil,
+				balance,
+				log,
+				retryCount,
+			)
+			chains.HandleSaveTransaction(cp.DB, cp.Alert, tx, log)
+		}
+	}
+}
+
+// saveRelayTxs saves the variants broadcasted with the nonce of the relay transaction, given
+// the hash and the result of the mined variant, or of the latest variant if none is mined.
+// Once a variant is mined, the others are saved as replaced, including the ones broadcasted
+// by an earlier relay; otherwise, the variants of this packet are saved with the result.
+func (cp *EVMChainProvider) saveRelayTxs(
+	ctx context.Context,
+	packet *bandtypes.Packet,
+	signer wallet.Signer,
+	variantTxHashes []string,
+	packetTxHashes []string,
+	txHash string,
+	txResult TxResult,
+	balance *big.Int,
+	log logger.Logger,
+	retryCount int,
+) {
+	if cp.DB == nil {
+		return
+	}
+
+	isMined := txResult.Status == types.TX_STATUS_SUCCESS || txResult.Status == types.TX_STATUS_FAILED
+	replacedResult := NewTxResult(
+		types.TX_STATUS_TIMEOUT,
+		decimal.NullDecimal{},
+		decimal.NullDecimal{},
+		nil,
+		fmt.Sprintf("transaction is replaced by %s", txHash),
+	)
+
+	for _, variantTxHash := range variantTxHashes {
+		isPacketTx := slices.Contains(packetTxHashes, variantTxHash)
+
+		result := txResult
+		switch {
+		case variantTxHash == txHash:
+		case isMined:
+			result = replacedResult
+		case !isPacketTx:
+			// the stuck transaction of an earlier relay may still be mined.
+			continue
+		}
+
+		if !isPacketTx {
+			cp.updateTransaction(ctx, variantTxHash, result, log)
+			continue
+		}
+
+		tx := cp.prepareTransaction(ctx, variantTxHash, signer.GetAddress(), packet, &result, balance, log, retryCount)
+		chains.HandleSaveTransaction(cp.DB, cp.Alert, tx, log)
+	}
+}
+
+// updateTransaction updates the saved transaction of the given hash, broadcasted by an
+// earlier relay, with the given result.
+func (cp *EVMChainProvider) updateTransaction(ctx context.Context, txHash string, txResult TxResult, log logger.Logger) {
+	tx, err := cp.DB.GetTransactionByHash(txHash)
+	if err != nil {
+		log.Error("Failed to get transaction", "tx_hash", txHash, err)
+		return
+	}
+
+	cp.applyTxResult(ctx, tx, txResult, log)
+	chains.HandleSaveTransaction(cp.DB, cp.Alert, tx, log)
+}
+
+// applyTxResult sets the status, the gas and the block timestamp of the saved transaction
+// from the given result.
+func (cp *EVMChainProvider) applyTxResult(ctx context.Context, tx *db.Transaction, txResult TxResult, log logger.Logger) {
+	tx.Status = txResult.Status
+	tx.GasUsed = txResult.GasUsed
+	tx.EffectiveGasPrice = txResult.EffectiveGasPrice
+	// only the status of the transaction is updated; the signal prices are already saved.
+	tx.SignalPrices = nil
+	tx.UpdatedAt = time.Now()
+
+	if txResult.BlockNumber == nil {
+		return
+	}
+
+	header, err := cp.Client.GetHeaderBlock(ctx, txResult.BlockNumber)
+	if err != nil {
+		log.Error("Failed to get header block", "tx_hash", tx.TxHash, err)
+		return
+	}
+
+	timestamp := time.Unix(int64(header.Time), 0).UTC()
+	tx.BlockTimestamp = &timestamp
+}
+
 // WaitForConfirmedTx polls the transaction until it reaches a terminal state.
 // It NEVER returns an error. Instead, it always returns a TxResult where:
 //   - Status == TX_STATUS_SUCCESS or TX_STATUS_FAILED when confirmed.
@@ -330,39 +759,55 @@ func (cp *EVMChainProvider) WaitForConfirmedTx(
 	txHash string,
 	log logger.Logger,
 ) TxResult {
+	_, result := cp.waitForConfirmedTxs(ctx, []string{txHash}, cp.Config.WaitingTxDuration, log)
+	return result
+}
+
+// waitForConfirmedTxs polls the given variants of a transaction until one of them reaches
+// a terminal state or the waiting duration elapses. It returns the hash of the variant that
+// reached a terminal state, or the latest variant on timeout, along with its result.
+func (cp *EVMChainProvider) waitForConfirmedTxs(
+	ctx context.Context,
+	txHashes []string,
+	waitingDuration time.Duration,
+	log logger.Logger,
+) (string, TxResult) {
+	latestTxHash := txHashes[len(txHashes)-1]
+
 	createdAt := time.Now()
 	var lastErr error
-	for time.Since(createdAt) <= cp.Config.WaitingTxDuration {
-		result, err := cp.CheckConfirmedTx(ctx, txHash)
-		if err != nil {
-			lastErr = err
-			log.Debug(
-				"Failed to check tx status",
-				"tx_hash", txHash,
-				err,
-			)
-		}
+	for time.Since(createdAt) <= waitingDuration {
+		for _, txHash := range txHashes {
+			result, err := cp.CheckConfirmedTx(ctx, txHash)
+			if err != nil {
+				lastErr = err
+				log.Debug(
+					"Failed to check tx status",
+					"tx_hash", txHash,
+					err,
+				)
+			}
 
-		switch result.Status {
-		case types.TX_STATUS_SUCCESS, types.TX_STATUS_FAILED:
-			return result
-		case types.TX_STATUS_PENDING:
-			log.Debug(
-				"Waiting for tx to be mined",
-				"tx_hash", txHash,
-			)
-			time.Sleep(cp.Config.CheckingTxInterval)
+			if result.Status == types.TX_STATUS_SUCCESS || result.Status == types.TX_STATUS_FAILED {
+				return txHash, result
+			}
 		}
+
+		log.Debug(
+			"Waiting for tx to be mined",
+			"tx_hash", latestTxHash,
+		)
+		time.Sleep(cp.Config.CheckingTxInterval)
 	}
 
 	failureReason := fmt.Sprintf("timed out waiting %s for tx %s to reach %d confirmations",
-		cp.Config.WaitingTxDuration, txHash, cp.Config.BlockConfirmation)
+		waitingDuration, strings.Join(txHashes, ", "), cp.Config.BlockConfirmation)
 
 	if lastErr != nil {
 		failureReason = fmt.Sprintf("%s: %v", failureReason, lastErr)
 	}
 
-	return NewTxResult(
+	return latestTxHash, NewTxResult(
 		types.TX_STATUS_TIMEOUT,
 		decimal.NullDecimal{},
 		decimal.NullDecimal{},
@@ -543,33 +988,52 @@ func (cp *EVMChainProvider) CheckConfirmedTx(
 	return NewTxResult(types.TX_STATUS_SUCCESS, gasUsed, gasPrice, receipt.BlockNumber, ""), nil
 }
 
-// EstimateGasFee estimates the gas for the transaction.
+// EstimateGasFee estimates the gas for the transaction from the configured gas oracle.
 func (cp *EVMChainProvider) EstimateGasFee(ctx context.Context) (GasInfo, error) {
-	switch cp.GasType {
-	case GasTypeLegacy:
-		gasPrice, err := cp.Client.EstimateGasPrice(ctx)
-		if err != nil {
-			return GasInfo{}, err
-		}
+	var gasInfo GasInfo
+	var err error
+
+	switch cp.Config.GasOracle {
+	case "", GasOracleRPC:
+		gasInfo, err = cp.estimateGasFeeFromRPC(ctx)
+	case GasOracleFeeHistory:
+		gasInfo, err = cp.estimateGasFeeFromFeeHistory(ctx)
+	case GasOracleStatic:
+		gasInfo, err = cp.estimateGasFeeFromStatic()
+	default:
+		return GasInfo{}, fmt.Errorf("unsupported gas oracle: %s", cp.Config.GasOracle)
+	}
+	if err != nil {
+		return GasInfo{}, err
+	}
 
-		// bound gas fee
-		return cp.BumpAndBoundGas(ctx, NewGasLegacyInfo(gasPrice), 1.0)
-	case GasTypeEIP1559:
-		priorityFee, err := cp.Client.EstimateGasTipCap(ctx)
-		if err != nil {
-			return GasInfo{}, err
-		}
+	// bound gas fee
+	return cp.BumpAndBoundGas(ctx, gasInfo, 1.0)
+}
 
-		baseFee, err := cp.Client.EstimateBaseFee(ctx)
-		if err != nil {
-			return GasInfo{}, err
-		}
+// bumpGas bumps the gas by the gas multiplier of the config. If the transaction replaces
+// another one with the same nonce, the gas is bumped by at least minReplacementGasMultiplier,
+// as nodes reject a replacement that does not pay enough more than the replaced transaction;
+// the base fee of an EIP-1559 transaction is bumped as well, so that its fee cap grows by
+// the multiplier.
+func (cp *EVMChainProvider) bumpGas(ctx context.Context, gasInfo GasInfo, isReplacement bool) (GasInfo, error) {
+	if !isReplacement {
+		return cp.BumpAndBoundGas(ctx, gasInfo, cp.Config.GasMultiplier)
+	}
 
-		// bound gas fee
-		return cp.BumpAndBoundGas(ctx, NewGasEIP1559Info(priorityFee, baseFee), 1.0)
-	default:
-		return GasInfo{}, fmt.Errorf("unsupported gas type: %v", cp.GasType)
+	multiplier := max(cp.Config.GasMultiplier, minReplacementGasMultiplier)
+	newGasInfo, err := cp.BumpAndBoundGas(ctx, gasInfo, multiplier)
+	if err != nil || newGasInfo.Type != GasTypeEIP1559 {
+		return newGasInfo, err
 	}
+
+	newBaseFee := MultiplyBigIntWithFloat64(gasInfo.GasBaseFee, multiplier)
+	maxBaseFee := big.NewInt(int64(cp.Config.MaxBaseFee))
+	if maxBaseFee.Cmp(big.NewInt(0)) > 0 && newBaseFee.Cmp(maxBaseFee) > 0 {
+		newBaseFee = maxBaseFee
+	}
+
+	return NewGasEIP1559Info(newGasInfo.GasPriorityFee, newBaseFee), nil
 }
 
 // BumpAndBoundGas bumps the gas price.
@@ -650,22 +1114,50 @@ func (cp *EVMChainProvider) queryTunnelInfo(
 	return &output.Info, nil
 }
 
-// NewRelayTx creates a new relay transaction.
+// NewRelayTx creates a new relay transaction with the given nonce.
 func (cp *EVMChainProvider) NewRelayTx(
 	ctx context.Context,
 	data []byte,
 	signer wallet.Signer,
+	nonce uint64,
 	gasInfo GasInfo,
 ) (*gethtypes.Transaction, error) {
 	addr := gethcommon.HexToAddress(signer.GetAddress())
-	nonce, err := cp.Client.NonceAt(ctx, addr)
+	return cp.newTx(ctx, addr, cp.TunnelRouterAddress, nil, data, nonce, gasInfo)
+}
+
+// newTx creates a new transaction from the given address with the given nonce; a nil value
+// means no value is transferred. The gas limit is estimated and capped by the configured gas limit.
+func (cp *EVMChainProvider) newTx(
+	ctx context.Context,
+	from gethcommon.Address,
+	to gethcommon.Address,
+	value *big.Int,
+	data []byte,
+	nonce uint64,
+	gasInfo GasInfo,
+) (*gethtypes.Transaction, error) {
+	gasLimit, err := cp.estimateGasLimit(ctx, from, to, value, data, gasInfo)
 	if err != nil {
 		return nil, err
 	}
 
+	return cp.buildTx(to, value, data, nonce, gasLimit, gasInfo)
+}
+
+// estimateGasLimit estimates the gas limit of the transaction, capped by the configured gas limit.
+func (cp *EVMChainProvider) estimateGasLimit(
+	ctx context.Context,
+	from gethcommon.Address,
+	to gethcommon.Address,
+	value *big.Int,
+	data []byte,
+	gasInfo GasInfo,
+) (uint64, error) {
 	callMsg := ethereum.CallMsg{
-		From:      addr,
-		To:        &cp.TunnelRouterAddress,
+		From:      from,
+		To:        &to,
+		Value:     value,
 		Data:      data,
 		GasPrice:  gasInfo.GasPrice,
 		GasFeeCap: gasInfo.GasFeeCap,
@@ -674,7 +1166,7 @@ func (cp *EVMChainProvider) NewRelayTx(
 
 	gasLimit, err := cp.Client.EstimateGas(ctx, callMsg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// apply gas limit cap
@@ -682,41 +1174,59 @@ func (cp *EVMChainProvider) NewRelayTx(
 		gasLimit = cp.Config.GasLimit
 	}
 
-	// set fee info
-	var tx *gethtypes.Transaction
+	return gasLimit, nil
+}
+
+// buildTx builds the transaction of the gas type of the chain with the given gas limit.
+func (cp *EVMChainProvider) buildTx(
+	to gethcommon.Address,
+	value *big.Int,
+	data []byte,
+	nonce uint64,
+	gasLimit uint64,
+	gasInfo GasInfo,
+) (*gethtypes.Transaction, error) {
 	switch cp.GasType {
 	case GasTypeLegacy:
-		tx = gethtypes.NewTx(&gethtypes.LegacyTx{
+		return gethtypes.NewTx(&gethtypes.LegacyTx{
 			Nonce:    nonce,
-			To:       &cp.TunnelRouterAddress,
-			Value:    decimal.NewFromInt(0).BigInt(),
+			To:       &to,
+			Value:    value,
 			Data:     data,
 			Gas:      gasLimit,
 			GasPrice: gasInfo.GasPrice,
-		})
+		}), nil
 
 	case GasTypeEIP1559:
-		tx = gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+		return gethtypes.NewTx(&gethtypes.DynamicFeeTx{
 			ChainID:   big.NewInt(int64(cp.Config.ChainID)),
 			Nonce:     nonce,
-			To:        &cp.TunnelRouterAddress,
-			Value:     decimal.NewFromInt(0).BigInt(),
+			To:        &to,
+			Value:     value,
 			Data:      data,
 			Gas:       gasLimit,
 			GasFeeCap: gasInfo.GasFeeCap,
 			GasTipCap: gasInfo.GasPriorityFee,
-		})
+		}), nil
 
 	default:
 		return nil, fmt.Errorf("unsupported gas type: %v", cp.GasType)
 	}
+}
+
+// maxTxFee returns the maximum fee paid by a transaction of the given gas limit.
+func (cp *EVMChainProvider) maxTxFee(gasLimit uint64, gasInfo GasInfo) *big.Int {
+	gasPrice := gasInfo.GasPrice
+	if cp.GasType == GasTypeEIP1559 {
+		gasPrice = gasInfo.GasFeeCap
+	}
 
-	return tx, nil
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), gasPrice)
 }
 
 // CreateCalldata creates the calldata for the relay transaction.
-func (cp *EVMChainProvider) CreateCalldata(packet *bandtypes.Packet) ([]byte, error) {
-	signing, err := chains.SelectSigning(packet)
+func (cp *EVMChainProvider) CreateCalldata(ctx context.Context, packet *bandtypes.Packet) ([]byte, error) {
+	signing, err := chains.SelectSigning(ctx, packet, cp.SigningVerifier)
 	if err != nil {
 		return nil, err
 	}
@@ -827,6 +1337,11 @@ func (cp *EVMChainProvider) GetChainName() string {
 	return cp.ChainName
 }
 
+// GetHealth retrieves the health of the connection to the chain from the chain provider.
+func (cp *EVMChainProvider) GetHealth() relayerhealth.ComponentHealth {
+	return cp.Client.GetHealth()
+}
+
 // ChainType retrieves the chain type from the chain provider.
 func (cp *EVMChainProvider) ChainType() types.ChainType {
 	return types.ChainTypeEVM
@@ -836,6 +1351,11 @@ func (cp *EVMChainProvider) GetWallet() wallet.Wallet {
 	return cp.Wallet
 }
 
+// GetFreeSigners retrieves the pool of the signers that are free to relay packets.
+func (cp *EVMChainProvider) GetFreeSigners() chan wallet.Signer {
+	return cp.FreeSigners
+}
+
 // queryRelayerGasFee queries the relayer gas fee being set on tunnel router.
 func (cp *EVMChainProvider) queryRelayerGasFee(ctx context.Context) (*big.Int, error) {
 	calldata, err := cp.TunnelRouterABI.Pack("gasFee")
@@ -5,7 +5,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -19,7 +21,10 @@ import (
 	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
 	"github.com/bandprotocol/falcon/relayer/chains/evm"
+	chaintypes "github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/penalty"
 	"github.com/bandprotocol/falcon/relayer/wallet"
 	walletevm "github.com/bandprotocol/falcon/relayer/wallet/evm"
 )
@@ -57,7 +62,7 @@ func (s *EIP1559ProviderTestSuite) SetupTest() {
 	s.Require().NoError(err)
 
 	log := logger.NewZapLogWrapper(zap.NewNop().Sugar())
-	chainProvider, err := evm.NewEVMChainProvider(s.chainName, s.client, &evmConfig, log, evmWallet, nil)
+	chainProvider, err := evm.NewEVMChainProvider(s.chainName, s.client, &evmConfig, log, evmWallet, nil, nil)
 	s.Require().NoError(err)
 	s.chainProvider = chainProvider
 
@@ -75,6 +80,7 @@ func (s *EIP1559ProviderTestSuite) SetupTest() {
 
 	s.relayingPacket = mockPacket()
 	s.relayingCalldata, err = s.chainProvider.CreateCalldata(
+		context.Background(),
 		&s.relayingPacket,
 	)
 	s.Require().NoError(err)
@@ -92,7 +98,7 @@ func (s *EIP1559ProviderTestSuite) MockDefaultResponses() {
 	s.client.EXPECT().CheckAndConnect(mockCtx).Return(nil).AnyTimes()
 	s.client.EXPECT().EstimateGasTipCap(mockCtx).Return(s.gasInfo.GasPriorityFee, nil).AnyTimes()
 	s.client.EXPECT().EstimateBaseFee(mockCtx).Return(s.gasInfo.GasBaseFee, nil).AnyTimes()
-	s.client.EXPECT().NonceAt(mockCtx, s.mockSignerAddress).Return(uint64(100), nil).AnyTimes()
+	s.client.EXPECT().PendingNonceAt(mockCtx, s.mockSignerAddress).Return(uint64(100), nil).AnyTimes()
 	s.client.EXPECT().
 		Query(mockCtx, s.chainProvider.TunnelRouterAddress, gasInfoCalldata).
 		Return(gasInfoResponse, nil).
@@ -121,7 +127,7 @@ func (s *EIP1559ProviderTestSuite) TestRelayPacketSuccess() {
 	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
 	s.MockDefaultResponses()
 
-	err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
 	s.Require().NoError(err)
 }
 
@@ -150,7 +156,7 @@ func (s *EIP1559ProviderTestSuite) TestRelayPacketSuccessWithoutQueryMaxGasFee()
 	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
 	s.MockDefaultResponses()
 
-	err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
 	s.Require().NoError(err)
 }
 
@@ -159,7 +165,7 @@ func (s *EIP1559ProviderTestSuite) TestRelayPacketFailedConnect() {
 	s.client.EXPECT().CheckAndConnect(gomock.Any()).Return(fmt.Errorf("failed to connect client"))
 	s.MockDefaultResponses()
 
-	err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
 	s.Require().ErrorContains(err, "failed to connect client")
 }
 
@@ -168,7 +174,7 @@ func (s *EIP1559ProviderTestSuite) TestRelayPacketFailedGasEstimation() {
 	s.client.EXPECT().EstimateGasTipCap(gomock.Any()).Return(nil, fmt.Errorf("failed to estimate gas tip cap"))
 	s.MockDefaultResponses()
 
-	err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
 	s.Require().ErrorContains(err, "failed to estimate gas tip cap")
 }
 
@@ -188,7 +194,7 @@ func (s *EIP1559ProviderTestSuite) TestRelayPacketFailedBroadcastTx() {
 		Times(s.chainProvider.Config.MaxRetry)
 	s.MockDefaultResponses()
 
-	err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
 	s.Require().ErrorContains(err, "failed to relay packet after")
 }
 
@@ -219,10 +225,398 @@ func (s *EIP1559ProviderTestSuite) TestRelayPacketFailedTxReceiptStatus() {
 		Times(s.chainProvider.Config.MaxRetry)
 	s.MockDefaultResponses()
 
-	err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
 	s.Require().ErrorContains(err, "failed to relay packet after")
 }
 
+func (s *EIP1559ProviderTestSuite) TestRelayPacketReplaceStuckTx() {
+	s.chainProvider.Config.MaxReplacements = 1
+	s.chainProvider.Config.ReplacementInterval = time.Second
+	// the replacement is bumped by at least 10% even if the gas multiplier is lower.
+	s.chainProvider.Config.GasMultiplier = 1.05
+
+	// mock client responses
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(200_000), nil).Times(2)
+
+	var txs []*gethtypes.Transaction
+	stuckTxHash := "0xabc123"
+	replacementTxHash := "0xdef456"
+	gomock.InOrder(
+		s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, tx *gethtypes.Transaction) (string, error) {
+				txs = append(txs, tx)
+				return stuckTxHash, nil
+			}),
+		s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, tx *gethtypes.Transaction) (string, error) {
+				txs = append(txs, tx)
+				return replacementTxHash, nil
+			}),
+	)
+
+	s.client.EXPECT().
+		GetTxReceipt(gomock.Any(), stuckTxHash).
+		Return(nil, fmt.Errorf("not found")).
+		AnyTimes()
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), replacementTxHash).Return(&evm.TxReceipt{
+		Status:            gethtypes.ReceiptStatusSuccessful,
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(20000),
+		BlockNumber:       big.NewInt(100),
+	}, nil)
+
+	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
+	s.MockDefaultResponses()
+
+	txHash, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	s.Require().NoError(err)
+	s.Require().Equal(replacementTxHash, txHash)
+
+	// the replacement tx uses the same nonce with bumped fees.
+	s.Require().Len(txs, 2)
+	s.Require().Equal(txs[0].Nonce(), txs[1].Nonce())
+	s.Require().Equal(big.NewInt(11_000_000_000), txs[1].GasTipCap())
+
+	minGasFeeCap := new(big.Int).Div(new(big.Int).Mul(txs[0].GasFeeCap(), big.NewInt(11)), big.NewInt(10))
+	s.Require().GreaterOrEqual(txs[1].GasFeeCap().Cmp(minGasFeeCap), 0)
+}
+
+func (s *EIP1559ProviderTestSuite) TestRelayPacketReusesNonceOfTimedOutTx() {
+	s.chainProvider.Config.MaxRetry = 1
+	s.chainProvider.Config.WaitingTxDuration = time.Second
+
+	// mock client responses
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(200_000), nil).Times(2)
+
+	var txs []*gethtypes.Transaction
+	stuckTxHash := "0xabc123"
+	replacementTxHash := "0xdef456"
+	gomock.InOrder(
+		s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, tx *gethtypes.Transaction) (string, error) {
+				txs = append(txs, tx)
+				return stuckTxHash, nil
+			}),
+		s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, tx *gethtypes.Transaction) (string, error) {
+				txs = append(txs, tx)
+				return replacementTxHash, nil
+			}),
+	)
+
+	s.client.EXPECT().
+		GetTxReceipt(gomock.Any(), stuckTxHash).
+		Return(nil, fmt.Errorf("not found")).
+		AnyTimes()
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), replacementTxHash).Return(&evm.TxReceipt{
+		Status:            gethtypes.ReceiptStatusSuccessful,
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(20000),
+		BlockNumber:       big.NewInt(100),
+	}, nil)
+
+	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
+	s.MockDefaultResponses()
+
+	// the transaction of the first packet is timed out after all retries.
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	s.Require().ErrorContains(err, "failed to relay packet after")
+
+	// the next packet replaces the stuck transaction instead of queuing behind it.
+	nextPacket := s.relayingPacket
+	nextPacket.Sequence++
+	txHash, err := s.chainProvider.RelayPacket(context.Background(), &nextPacket)
+	s.Require().NoError(err)
+	s.Require().Equal(replacementTxHash, txHash)
+
+	s.Require().Len(txs, 2)
+	s.Require().Equal(uint64(100), txs[0].Nonce())
+	s.Require().Equal(txs[0].Nonce(), txs[1].Nonce())
+
+	// the replacement pays at least 10% more than the stuck transaction, although the
+	// estimated fees are unchanged.
+	s.Require().Equal(big.NewInt(11_000_000_000), txs[1].GasTipCap())
+	minGasFeeCap := new(big.Int).Div(new(big.Int).Mul(txs[0].GasFeeCap(), big.NewInt(11)), big.NewInt(10))
+	s.Require().GreaterOrEqual(txs[1].GasFeeCap().Cmp(minGasFeeCap), 0)
+}
+
+func (s *EIP1559ProviderTestSuite) TestRelayPacketWaitsForStuckTxIfCannotReplace() {
+	s.chainProvider.Config.MaxRetry = 1
+	s.chainProvider.Config.WaitingTxDuration = time.Second
+	// the priority fee cannot be bumped above the one of the stuck transaction.
+	s.chainProvider.Config.MaxPriorityFee = 10_000_000_000
+
+	// mock client responses
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(200_000), nil).Times(2)
+
+	var txs []*gethtypes.Transaction
+	stuckTxHash := "0xabc123"
+	nextTxHash := "0xdef456"
+	gomock.InOrder(
+		s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, tx *gethtypes.Transaction) (string, error) {
+				txs = append(txs, tx)
+				return stuckTxHash, nil
+			}),
+		s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, tx *gethtypes.Transaction) (string, error) {
+				txs = append(txs, tx)
+				return nextTxHash, nil
+			}),
+	)
+
+	s.client.EXPECT().
+		GetTxReceipt(gomock.Any(), stuckTxHash).
+		Return(nil, fmt.Errorf("not found")).
+		AnyTimes()
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), nextTxHash).Return(&evm.TxReceipt{
+		Status:            gethtypes.ReceiptStatusSuccessful,
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(20000),
+		BlockNumber:       big.NewInt(100),
+	}, nil)
+
+	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
+	s.MockDefaultResponses()
+
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	s.Require().ErrorContains(err, "failed to relay packet after")
+
+	// the stuck transaction is left to be mined, and the next packet takes a new nonce
+	// instead of broadcasting an underpriced replacement.
+	nextPacket := s.relayingPacket
+	nextPacket.Sequence++
+	txHash, err := s.chainProvider.RelayPacket(context.Background(), &nextPacket)
+	s.Require().NoError(err)
+	s.Require().Equal(nextTxHash, txHash)
+
+	s.Require().Len(txs, 2)
+	s.Require().Equal(uint64(100), txs[0].Nonce())
+	s.Require().Equal(uint64(101), txs[1].Nonce())
+	s.Require().Equal(map[uint64]string{100: stuckTxHash}, s.chainProvider.NonceManager.PendingTxs(s.mockSignerAddress))
+}
+
+// setupDatabase sets a new sqlite database to the chain provider and returns it.
+func (s *EIP1559ProviderTestSuite) setupDatabase() db.Database {
+	dbPath := "sqlite:///" + filepath.Join(s.T().TempDir(), "falcon.db")
+
+	migrator, err := db.NewMigrator(dbPath)
+	s.Require().NoError(err)
+	defer migrator.Close()
+
+	_, err = migrator.Migrate(context.Background())
+	s.Require().NoError(err)
+
+	database, err := db.NewSQL(dbPath)
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { _ = database.Close() })
+
+	s.chainProvider.SetDatabase(database)
+
+	s.client.EXPECT().GetBalance(gomock.Any(), s.mockSignerAddress, gomock.Any()).Return(big.NewInt(1e18), nil).AnyTimes()
+	s.client.EXPECT().GetHeaderBlock(gomock.Any(), gomock.Any()).Return(&gethtypes.Header{Time: 1_700_000_000}, nil).AnyTimes()
+
+	return database
+}
+
+// mockBroadcastTxs mocks the broadcast of the given tx hashes in order, and records the
+// broadcasted transactions.
+func (s *EIP1559ProviderTestSuite) mockBroadcastTxs(txHashes ...string) *[]*gethtypes.Transaction {
+	var txs []*gethtypes.Transaction
+	calls := make([]any, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		calls = append(calls, s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, tx *gethtypes.Transaction) (string, error) {
+				txs = append(txs, tx)
+				return txHash, nil
+			}))
+	}
+	gomock.InOrder(calls...)
+
+	return &txs
+}
+
+func (s *EIP1559ProviderTestSuite) TestRelayPacketResolvesEarlierVariant() {
+	s.chainProvider.Config.MaxRetry = 2
+	s.chainProvider.Config.WaitingTxDuration = time.Second
+	database := s.setupDatabase()
+
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(200_000), nil).Times(2)
+
+	stuckTxHash := "0xabc123"
+	replacementTxHash := "0xdef456"
+	txs := s.mockBroadcastTxs(stuckTxHash, replacementTxHash)
+
+	// the first variant is mined only after it is replaced by the next attempt.
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), stuckTxHash).
+		DoAndReturn(func(context.Context, string) (*evm.TxReceipt, error) {
+			if len(*txs) < 2 {
+				return nil, fmt.Errorf("not found")
+			}
+			return &evm.TxReceipt{
+				Status:            gethtypes.ReceiptStatusSuccessful,
+				GasUsed:           21000,
+				EffectiveGasPrice: big.NewInt(20000),
+				BlockNumber:       big.NewInt(100),
+			}, nil
+		}).
+		AnyTimes()
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), replacementTxHash).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil).AnyTimes()
+	s.MockDefaultResponses()
+
+	txHash, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	s.Require().NoError(err)
+	s.Require().Equal(stuckTxHash, txHash)
+	s.Require().Equal((*txs)[0].Nonce(), (*txs)[1].Nonce())
+
+	tx, err := database.GetTransactionByHash(stuckTxHash)
+	s.Require().NoError(err)
+	s.Require().Equal(chaintypes.TX_STATUS_SUCCESS, tx.Status)
+
+	tx, err = database.GetTransactionByHash(replacementTxHash)
+	s.Require().NoError(err)
+	s.Require().Equal(chaintypes.TX_STATUS_TIMEOUT, tx.Status)
+}
+
+func (s *EIP1559ProviderTestSuite) TestRelayPacketResolvesStuckTxOfEarlierRelay() {
+	s.chainProvider.Config.MaxRetry = 1
+	s.chainProvider.Config.WaitingTxDuration = time.Second
+	database := s.setupDatabase()
+
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(200_000), nil).Times(3)
+
+	stuckTxHash := "0xabc123"
+	replacementTxHash := "0xdef456"
+	nextTxHash := "0x789abc"
+	txs := s.mockBroadcastTxs(stuckTxHash, replacementTxHash, nextTxHash)
+
+	receipt := &evm.TxReceipt{
+		Status:            gethtypes.ReceiptStatusSuccessful,
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(20000),
+		BlockNumber:       big.NewInt(100),
+	}
+
+	// the stuck transaction of the first packet is mined after the next packet replaces it.
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), stuckTxHash).
+		DoAndReturn(func(context.Context, string) (*evm.TxReceipt, error) {
+			if len(*txs) < 2 {
+				return nil, fmt.Errorf("not found")
+			}
+			return receipt, nil
+		}).
+		AnyTimes()
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), replacementTxHash).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), nextTxHash).Return(receipt, nil)
+	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil).AnyTimes()
+	s.MockDefaultResponses()
+
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	s.Require().ErrorContains(err, "failed to relay packet after")
+
+	// the next packet is relayed with a new nonce once the stuck transaction is mined.
+	s.chainProvider.Config.MaxRetry = 2
+	nextPacket := s.relayingPacket
+	nextPacket.Sequence++
+	txHash, err := s.chainProvider.RelayPacket(context.Background(), &nextPacket)
+	s.Require().NoError(err)
+	s.Require().Equal(nextTxHash, txHash)
+
+	s.Require().Equal(uint64(100), (*txs)[1].Nonce())
+	s.Require().Equal(uint64(101), (*txs)[2].Nonce())
+
+	tx, err := database.GetTransactionByHash(stuckTxHash)
+	s.Require().NoError(err)
+	s.Require().Equal(s.relayingPacket.Sequence, tx.Sequence)
+	s.Require().Equal(chaintypes.TX_STATUS_SUCCESS, tx.Status)
+	s.Require().NotNil(tx.BlockTimestamp)
+
+	tx, err = database.GetTransactionByHash(replacementTxHash)
+	s.Require().NoError(err)
+	s.Require().Equal(nextPacket.Sequence, tx.Sequence)
+	s.Require().Equal(chaintypes.TX_STATUS_TIMEOUT, tx.Status)
+
+	tx, err = database.GetTransactionByHash(nextTxHash)
+	s.Require().NoError(err)
+	s.Require().Equal(chaintypes.TX_STATUS_SUCCESS, tx.Status)
+}
+
+func (s *EIP1559ProviderTestSuite) TestRelayPacketSkipIfUnprofitable() {
+	s.chainProvider.Config.ProfitabilityPolicy = evm.ProfitabilityPolicySkipIfUnprofitable
+
+	// the gas fee cap (18 gwei) exceeds the relayer gas fee of the tunnel router (12 gwei).
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(200_000), nil)
+	s.MockDefaultResponses()
+
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	s.Require().ErrorIs(err, evm.ErrUnprofitableRelay)
+	s.Require().Equal(penalty.ClassUnprofitable, penalty.Classify(err))
+}
+
+func (s *EIP1559ProviderTestSuite) TestRelayPacketAlertIfUnprofitable() {
+	s.chainProvider.Config.ProfitabilityPolicy = evm.ProfitabilityPolicyAlertIfUnprofitable
+
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(200_000), nil)
+
+	txHash := "0xabc123"
+	s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).Return(txHash, nil)
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), txHash).Return(&evm.TxReceipt{
+		Status:            gethtypes.ReceiptStatusSuccessful,
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(20000),
+		BlockNumber:       big.NewInt(100),
+	}, nil)
+
+	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
+	s.MockDefaultResponses()
+
+	actual, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	s.Require().NoError(err)
+	s.Require().Equal(txHash, actual)
+}
+
+func (s *EIP1559ProviderTestSuite) TestEstimateRelayProfitability() {
+	s.MockDefaultResponses()
+
+	// the transaction pays the base fee (8 gwei) plus its tip (5 gwei), below its fee cap.
+	tx := gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+		Gas:       100_000,
+		GasFeeCap: big.NewInt(15_000_000_000),
+		GasTipCap: big.NewInt(5_000_000_000),
+	})
+
+	actual, err := s.chainProvider.EstimateRelayProfitability(context.Background(), tx)
+	s.Require().NoError(err)
+	s.Require().Equal(big.NewInt(1_300_000_000_000_000), actual.Cost)
+	s.Require().Equal(big.NewInt(1_200_000_000_000_000), actual.Reimbursement)
+	s.Require().Equal(big.NewInt(-100_000_000_000_000), actual.Profit())
+	s.Require().False(actual.IsProfitable())
+
+	// the transaction is profitable at its effective gas price (11 gwei), despite its fee cap.
+	tx = gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+		Gas:       100_000,
+		GasFeeCap: big.NewInt(15_000_000_000),
+		GasTipCap: big.NewInt(3_000_000_000),
+	})
+
+	actual, err = s.chainProvider.EstimateRelayProfitability(context.Background(), tx)
+	s.Require().NoError(err)
+	s.Require().Equal(big.NewInt(1_100_000_000_000_000), actual.Cost)
+	s.Require().True(actual.IsProfitable())
+
+	// the transaction pays at most its fee cap.
+	tx = gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+		Gas:       100_000,
+		GasFeeCap: big.NewInt(10_000_000_000),
+		GasTipCap: big.NewInt(5_000_000_000),
+	})
+
+	actual, err = s.chainProvider.EstimateRelayProfitability(context.Background(), tx)
+	s.Require().NoError(err)
+	s.Require().Equal(big.NewInt(1_000_000_000_000_000), actual.Cost)
+}
+
 func (s *EIP1559ProviderTestSuite) TestBumpAndBoundGas() {
 	s.MockDefaultResponses()
 
@@ -316,6 +710,68 @@ func (s *EIP1559ProviderTestSuite) TestEstimateGas() {
 	s.Require().Equal(expected, actual)
 }
 
+func (s *EIP1559ProviderTestSuite) TestEstimateGasFromFeeHistory() {
+	s.chainProvider.Config.GasOracle = evm.GasOracleFeeHistory
+	s.chainProvider.Config.FeeHistoryBlockCount = 4
+	s.chainProvider.Config.FeeHistoryRewardPercentile = 60
+	s.chainProvider.Config.BaseFeeMultiplier = 1.5
+
+	s.client.EXPECT().FeeHistory(gomock.Any(), uint64(4), []float64{60}).Return(&ethereum.FeeHistory{
+		Reward: [][]*big.Int{
+			{big.NewInt(1_000_000_000)},
+			{big.NewInt(9_000_000_000)},
+			{big.NewInt(3_000_000_000)},
+			{big.NewInt(5_000_000_000)},
+		},
+		BaseFee: []*big.Int{
+			big.NewInt(1_000_000_000),
+			big.NewInt(2_000_000_000),
+			big.NewInt(3_000_000_000),
+			big.NewInt(3_000_000_000),
+			big.NewInt(4_000_000_000),
+		},
+	}, nil)
+	s.MockDefaultResponses()
+
+	actual, err := s.chainProvider.EstimateGasFee(context.Background())
+	s.Require().NoError(err)
+
+	expected := evm.NewGasEIP1559Info(big.NewInt(4_000_000_000), big.NewInt(6_000_000_000))
+	s.Require().Equal(expected, actual)
+}
+
+func (s *EIP1559ProviderTestSuite) TestEstimateGasFromFeeHistoryNoReward() {
+	s.chainProvider.Config.GasOracle = evm.GasOracleFeeHistory
+
+	s.client.EXPECT().FeeHistory(gomock.Any(), uint64(evm.DefaultFeeHistoryBlockCount), gomock.Any()).
+		Return(&ethereum.FeeHistory{BaseFee: []*big.Int{big.NewInt(1_000_000_000)}}, nil)
+	s.MockDefaultResponses()
+
+	_, err := s.chainProvider.EstimateGasFee(context.Background())
+	s.Require().ErrorContains(err, "fee history has no reward")
+}
+
+func (s *EIP1559ProviderTestSuite) TestEstimateGasFromStatic() {
+	s.chainProvider.Config.GasOracle = evm.GasOracleStatic
+	s.chainProvider.Config.StaticPriorityFee = 2_000_000_000
+	s.chainProvider.Config.StaticBaseFee = 1_000_000_000
+	s.MockDefaultResponses()
+
+	actual, err := s.chainProvider.EstimateGasFee(context.Background())
+	s.Require().NoError(err)
+
+	expected := evm.NewGasEIP1559Info(big.NewInt(2_000_000_000), big.NewInt(1_000_000_000))
+	s.Require().Equal(expected, actual)
+}
+
+func (s *EIP1559ProviderTestSuite) TestEstimateGasUnsupportedOracle() {
+	s.chainProvider.Config.GasOracle = "unknown"
+	s.MockDefaultResponses()
+
+	_, err := s.chainProvider.EstimateGasFee(context.Background())
+	s.Require().ErrorContains(err, "unsupported gas oracle: unknown")
+}
+
 func (s *EIP1559ProviderTestSuite) TestNewRelayTx() {
 	data := []byte("mock calldata")
 
@@ -327,9 +783,8 @@ func (s *EIP1559ProviderTestSuite) TestNewRelayTx() {
 		GasTipCap: s.gasInfo.GasPriorityFee,
 	}
 	s.client.EXPECT().EstimateGas(gomock.Any(), callMsg).Return(uint64(100_000), nil)
-	s.client.EXPECT().NonceAt(gomock.Any(), s.mockSignerAddress).Return(uint64(1), nil)
 
-	actual, err := s.chainProvider.NewRelayTx(context.Background(), data, s.mockSigner, s.gasInfo)
+	actual, err := s.chainProvider.NewRelayTx(context.Background(), data, s.mockSigner, 1, s.gasInfo)
 	s.Require().NoError(err)
 
 	expected := gethtypes.NewTx(&gethtypes.DynamicFeeTx{
@@ -353,3 +808,89 @@ func (s *EIP1559ProviderTestSuite) TestNewRelayTx() {
 	s.Require().Equal(expected.GasFeeCap(), actual.GasFeeCap(), "GasFeeCap mismatch")
 	s.Require().Equal(expected.ChainId(), actual.ChainId(), "ChainID mismatch")
 }
+
+func (s *EIP1559ProviderTestSuite) TestSweepSigner() {
+	destination := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	balance := big.NewInt(1_000_000_000_000_000)
+	fee := new(big.Int).Mul(big.NewInt(21_000), s.gasInfo.GasFeeCap)
+	amount := new(big.Int).Sub(balance, fee)
+
+	s.client.EXPECT().GetBalance(gomock.Any(), s.mockSignerAddress, nil).Return(balance, nil)
+	s.client.EXPECT().EstimateGas(gomock.Any(), ethereum.CallMsg{
+		From:      s.mockSignerAddress,
+		To:        &destination,
+		GasFeeCap: s.gasInfo.GasFeeCap,
+		GasTipCap: s.gasInfo.GasPriorityFee,
+	}).Return(uint64(21_000), nil)
+
+	txHash := "0xabc123"
+	s.client.EXPECT().
+		BroadcastTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, tx *gethtypes.Transaction) (string, error) {
+			s.Require().Equal(destination, *tx.To())
+			s.Require().Equal(amount, tx.Value())
+			s.Require().Equal(uint64(21_000), tx.Gas())
+			return txHash, nil
+		})
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), txHash).Return(&evm.TxReceipt{
+		Status:            gethtypes.ReceiptStatusSuccessful,
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(20000),
+		BlockNumber:       big.NewInt(100),
+	}, nil)
+	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
+	s.MockDefaultResponses()
+
+	result, err := s.chainProvider.SweepSigner(context.Background(), s.mockSigner, destination.Hex(), false)
+	s.Require().NoError(err)
+	s.Require().Equal(balance, result.Balance)
+	s.Require().Equal(fee, result.Fee)
+	s.Require().Equal(amount, result.Amount)
+	s.Require().Equal(txHash, result.TxHash)
+	s.Require().Equal(chaintypes.TX_STATUS_SUCCESS, result.Status)
+}
+
+func (s *EIP1559ProviderTestSuite) TestSweepSignerTimeout() {
+	s.chainProvider.Config.WaitingTxDuration = time.Second
+	destination := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+
+	s.client.EXPECT().GetBalance(gomock.Any(), s.mockSignerAddress, nil).Return(big.NewInt(1_000_000_000_000_000), nil)
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(21_000), nil)
+
+	txHash := "0xabc123"
+	s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).Return(txHash, nil)
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), txHash).Return(nil, fmt.Errorf("not found")).AnyTimes()
+	s.MockDefaultResponses()
+
+	result, err := s.chainProvider.SweepSigner(context.Background(), s.mockSigner, destination.Hex(), false)
+	s.Require().NoError(err)
+	s.Require().Equal(chaintypes.TX_STATUS_TIMEOUT, result.Status)
+
+	// the nonce of the timed-out transfer is handed out again to replace it.
+	nonce, stuckTx, err := s.chainProvider.NonceManager.Next(context.Background(), s.mockSignerAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(100), nonce)
+	s.Require().NotNil(stuckTx)
+	s.Require().Equal([]string{txHash}, stuckTx.TxHashes)
+}
+
+func (s *EIP1559ProviderTestSuite) TestSweepSignerDryRun() {
+	destination := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	fee := new(big.Int).Mul(big.NewInt(21_000), s.gasInfo.GasFeeCap)
+
+	s.client.EXPECT().GetBalance(gomock.Any(), s.mockSignerAddress, nil).Return(big.NewInt(1000), nil)
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(21_000), nil)
+	s.MockDefaultResponses()
+
+	// the balance does not cover the fee, so nothing is transferred.
+	result, err := s.chainProvider.SweepSigner(context.Background(), s.mockSigner, destination.Hex(), true)
+	s.Require().NoError(err)
+	s.Require().Equal(fee, result.Fee)
+	s.Require().Equal(int64(0), result.Amount.Int64())
+	s.Require().Empty(result.TxHash)
+}
+
+func (s *EIP1559ProviderTestSuite) TestSweepSignerInvalidDestination() {
+	_, err := s.chainProvider.SweepSigner(context.Background(), s.mockSigner, "invalid", true)
+	s.Require().ErrorContains(err, "invalid destination address")
+}
@@ -59,7 +59,7 @@ func (s *LegacyProviderTestSuite) SetupTest() {
 	s.Require().NoError(err)
 
 	log := logger.NewZapLogWrapper(zap.NewNop().Sugar())
-	chainProvider, err := evm.NewEVMChainProvider(s.chainName, s.client, &evmConfig, log, evmWallet, nil)
+	chainProvider, err := evm.NewEVMChainProvider(s.chainName, s.client, &evmConfig, log, evmWallet, nil, nil)
 	s.Require().NoError(err)
 	s.chainProvider = chainProvider
 
@@ -77,6 +77,7 @@ func (s *LegacyProviderTestSuite) SetupTest() {
 
 	s.relayingPacket = mockPacket()
 	s.relayingCalldata, err = s.chainProvider.CreateCalldata(
+		context.Background(),
 		&s.relayingPacket,
 	)
 	s.Require().NoError(err)
@@ -93,7 +94,7 @@ func (s *LegacyProviderTestSuite) MockDefaultResponses() {
 	mockCtx := gomock.Any()
 	s.client.EXPECT().CheckAndConnect(mockCtx).Return(nil).AnyTimes()
 	s.client.EXPECT().EstimateGasPrice(mockCtx).Return(s.gasInfo.GasPrice, nil).AnyTimes()
-	s.client.EXPECT().NonceAt(mockCtx, s.mockSignerAddress).Return(uint64(100), nil).AnyTimes()
+	s.client.EXPECT().PendingNonceAt(mockCtx, s.mockSignerAddress).Return(uint64(100), nil).AnyTimes()
 	s.client.EXPECT().
 		Query(mockCtx, s.chainProvider.TunnelRouterAddress, gasInfoCalldata).
 		Return(gasInfoResponse, nil).
@@ -121,7 +122,29 @@ func (s *LegacyProviderTestSuite) TestRelayPacketSuccess() {
 	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
 	s.MockDefaultResponses()
 
-	err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	s.Require().NoError(err)
+}
+
+func (s *LegacyProviderTestSuite) TestRelayPacketSkipIfUnprofitableWhenProfitable() {
+	s.chainProvider.Config.ProfitabilityPolicy = evm.ProfitabilityPolicySkipIfUnprofitable
+
+	// the gas price (10 gwei) is covered by the relayer gas fee of the tunnel router (12 gwei).
+	s.client.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(200_000), nil)
+
+	txHash := "0xabc123"
+	s.client.EXPECT().BroadcastTx(gomock.Any(), gomock.Any()).Return(txHash, nil)
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), txHash).Return(&evm.TxReceipt{
+		Status:            gethtypes.ReceiptStatusSuccessful,
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(20000),
+		BlockNumber:       big.NewInt(100),
+	}, nil)
+
+	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
+	s.MockDefaultResponses()
+
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
 	s.Require().NoError(err)
 }
 
@@ -148,7 +171,7 @@ func (s *LegacyProviderTestSuite) TestRelayPacketSuccessWithoutQueryMaxGasFee()
 	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(105), nil)
 	s.MockDefaultResponses()
 
-	err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
 	s.Require().NoError(err)
 }
 
@@ -157,7 +180,7 @@ func (s *LegacyProviderTestSuite) TestRelayPacketFailedGasEstimation() {
 	s.client.EXPECT().EstimateGasPrice(gomock.Any()).Return(nil, fmt.Errorf("failed to estimate gas price"))
 	s.MockDefaultResponses()
 
-	err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
+	_, err := s.chainProvider.RelayPacket(context.Background(), &s.relayingPacket)
 	s.Require().ErrorContains(err, "failed to estimate gas price")
 }
 
@@ -229,6 +252,38 @@ func (s *LegacyProviderTestSuite) TestEstimateGas() {
 	s.Require().Equal(expected, actual)
 }
 
+func (s *LegacyProviderTestSuite) TestEstimateGasFromFeeHistory() {
+	s.chainProvider.Config.GasOracle = evm.GasOracleFeeHistory
+	s.chainProvider.Config.BaseFeeMultiplier = 1
+
+	s.client.EXPECT().
+		FeeHistory(gomock.Any(), uint64(evm.DefaultFeeHistoryBlockCount), []float64{evm.DefaultFeeHistoryRewardPercentile}).
+		Return(&ethereum.FeeHistory{
+			Reward: [][]*big.Int{
+				{big.NewInt(1_000_000_000)},
+				{big.NewInt(2_000_000_000)},
+				{big.NewInt(3_000_000_000)},
+			},
+			BaseFee: []*big.Int{big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0)},
+		}, nil)
+	s.MockDefaultResponses()
+
+	actual, err := s.chainProvider.EstimateGasFee(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(evm.NewGasLegacyInfo(big.NewInt(2_000_000_000)), actual)
+}
+
+func (s *LegacyProviderTestSuite) TestEstimateGasFromStatic() {
+	s.chainProvider.Config.GasOracle = evm.GasOracleStatic
+	s.chainProvider.Config.StaticGasPrice = 20_000_000_000
+	s.MockDefaultResponses()
+
+	// the static gas price is still bounded by the relayer gas fee of the tunnel router.
+	actual, err := s.chainProvider.EstimateGasFee(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(evm.NewGasLegacyInfo(big.NewInt(12_000_000_000)), actual)
+}
+
 func (s *LegacyProviderTestSuite) TestNewRelayTx() {
 	data := []byte("mock calldata")
 	callMsg := ethereum.CallMsg{
@@ -239,9 +294,8 @@ func (s *LegacyProviderTestSuite) TestNewRelayTx() {
 	}
 
 	s.client.EXPECT().EstimateGas(gomock.Any(), callMsg).Return(uint64(100), nil)
-	s.client.EXPECT().NonceAt(gomock.Any(), s.mockSignerAddress).Return(uint64(1), nil)
 
-	actual, err := s.chainProvider.NewRelayTx(context.Background(), data, s.mockSigner, s.gasInfo)
+	actual, err := s.chainProvider.NewRelayTx(context.Background(), data, s.mockSigner, 1, s.gasInfo)
 	s.Require().NoError(err)
 
 	expected := gethtypes.NewTx(&gethtypes.LegacyTx{
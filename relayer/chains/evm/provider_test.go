@@ -9,6 +9,7 @@ import (
 	"time"
 
 	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/ethereum/go-ethereum"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/shopspring/decimal"
@@ -22,6 +23,7 @@ import (
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/chains/evm"
 	chaintypes "github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/logger"
 	walletevm "github.com/bandprotocol/falcon/relayer/wallet/evm"
 )
@@ -106,7 +108,7 @@ func (s *ProviderTestSuite) SetupTest() {
 	s.Require().NoError(err)
 
 	log := logger.NewZapLogWrapper(zap.NewNop().Sugar())
-	s.chainProvider, err = evm.NewEVMChainProvider(s.chainName, s.client, baseEVMCfg, log, wallet, nil)
+	s.chainProvider, err = evm.NewEVMChainProvider(s.chainName, s.client, baseEVMCfg, log, wallet, nil, nil)
 	s.Require().NoError(err)
 
 	s.chainProvider.Client = s.client
@@ -209,6 +211,69 @@ func (s *ProviderTestSuite) TestEstimateGasUnsupportedGas() {
 	s.Require().ErrorContains(err, "unsupported gas type:")
 }
 
+func (s *ProviderTestSuite) TestNewEVMChainProviderUnsupportedProfitabilityPolicy() {
+	cfg := *baseEVMCfg
+	cfg.ProfitabilityPolicy = "never"
+
+	log := logger.NewZapLogWrapper(zap.NewNop().Sugar())
+	_, err := evm.NewEVMChainProvider(s.chainName, s.client, &cfg, log, s.chainProvider.Wallet, nil, nil)
+	s.Require().ErrorContains(err, "unsupported profitability policy: never")
+}
+
+func (s *ProviderTestSuite) TestNewEVMChainProviderInvalidGasOracle() {
+	log := logger.NewZapLogWrapper(zap.NewNop().Sugar())
+
+	testcases := []struct {
+		name string
+		cfg  func(cfg *evm.EVMChainProviderConfig)
+		err  string
+	}{
+		{
+			name: "unsupported gas oracle",
+			cfg: func(cfg *evm.EVMChainProviderConfig) {
+				cfg.GasOracle = "unknown"
+			},
+			err: "unsupported gas oracle: unknown",
+		},
+		{
+			name: "static legacy without gas price",
+			cfg: func(cfg *evm.EVMChainProviderConfig) {
+				cfg.GasOracle = evm.GasOracleStatic
+				cfg.GasType = evm.GasTypeLegacy
+			},
+			err: "static gas oracle requires static_gas_price",
+		},
+		{
+			name: "static eip1559 without priority fee",
+			cfg: func(cfg *evm.EVMChainProviderConfig) {
+				cfg.GasOracle = evm.GasOracleStatic
+				cfg.GasType = evm.GasTypeEIP1559
+				cfg.StaticBaseFee = 1_000_000_000
+			},
+			err: "static gas oracle requires static_base_fee and static_priority_fee",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			cfg := *baseEVMCfg
+			tc.cfg(&cfg)
+
+			_, err := evm.NewEVMChainProvider(s.chainName, s.client, &cfg, log, s.chainProvider.Wallet, nil, nil)
+			s.Require().ErrorContains(err, tc.err)
+		})
+	}
+
+	// the static gas oracle is valid with the fees of the gas type.
+	cfg := *baseEVMCfg
+	cfg.GasOracle = evm.GasOracleStatic
+	cfg.GasType = evm.GasTypeLegacy
+	cfg.StaticGasPrice = 20_000_000_000
+
+	_, err := evm.NewEVMChainProvider(s.chainName, s.client, &cfg, log, s.chainProvider.Wallet, nil, nil)
+	s.Require().NoError(err)
+}
+
 func (s *ProviderTestSuite) TestCheckConfirmedTx() {
 	txHash := "0xabc123"
 	txBlock := int64(100)
@@ -297,3 +362,52 @@ func (s *ProviderTestSuite) TestCheckConfirmedTx() {
 		})
 	}
 }
+
+func (s *ProviderTestSuite) TestReconcileTransaction() {
+	txHash := "0xabc123"
+	sender := gethcommon.HexToAddress("0xfad9c8855b740a0b7ed4c221dbad0f33a83a49ca")
+	pendingTx := db.Transaction{TxHash: txHash, Sender: sender.Hex(), Status: chaintypes.TX_STATUS_PENDING}
+
+	s.client.EXPECT().CheckAndConnect(gomock.Any()).Return(nil).AnyTimes()
+
+	// the transaction is mined and confirmed.
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), txHash).Return(&evm.TxReceipt{
+		Status:            gethtypes.ReceiptStatusSuccessful,
+		GasUsed:           21000,
+		EffectiveGasPrice: big.NewInt(20000),
+		BlockNumber:       big.NewInt(100),
+	}, nil)
+	s.client.EXPECT().GetBlockHeight(gomock.Any()).Return(uint64(200), nil)
+	s.client.EXPECT().GetHeaderBlock(gomock.Any(), big.NewInt(100)).Return(&gethtypes.Header{Time: 1700000000}, nil)
+
+	tx, err := s.chainProvider.ReconcileTransaction(context.Background(), pendingTx)
+	s.Require().NoError(err)
+	s.Require().Equal(chaintypes.TX_STATUS_SUCCESS, tx.Status)
+	s.Require().Equal(decimal.NewNullDecimal(decimal.New(21000, 0)), tx.GasUsed)
+	s.Require().Equal(time.Unix(1700000000, 0).UTC(), *tx.BlockTimestamp)
+
+	// the transaction is neither mined nor in the mempool.
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), txHash).Return(nil, ethereum.NotFound)
+	s.client.EXPECT().GetTxByHash(gomock.Any(), txHash).Return(nil, false, ethereum.NotFound)
+
+	tx, err = s.chainProvider.ReconcileTransaction(context.Background(), pendingTx)
+	s.Require().NoError(err)
+	s.Require().Equal(chaintypes.TX_STATUS_TIMEOUT, tx.Status)
+
+	// the transaction is still in the mempool; its nonce is handed out again to replace it.
+	s.client.EXPECT().GetTxReceipt(gomock.Any(), txHash).Return(nil, ethereum.NotFound)
+	s.client.EXPECT().GetTxByHash(gomock.Any(), txHash).
+		Return(gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 7, GasPrice: big.NewInt(20000)}), true, nil)
+
+	tx, err = s.chainProvider.ReconcileTransaction(context.Background(), pendingTx)
+	s.Require().NoError(err)
+	s.Require().Equal(chaintypes.TX_STATUS_PENDING, tx.Status)
+
+	s.client.EXPECT().PendingNonceAt(gomock.Any(), sender).Return(uint64(8), nil)
+	nonce, stuckTx, err := s.chainProvider.NonceManager.Next(context.Background(), sender)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(7), nonce)
+	s.Require().NotNil(stuckTx)
+	s.Require().Equal([]string{txHash}, stuckTx.TxHashes)
+	s.Require().Equal(evm.NewGasLegacyInfo(big.NewInt(20000)), stuckTx.GasInfo)
+}
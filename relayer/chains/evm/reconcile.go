@@ -0,0 +1,65 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/bandprotocol/falcon/relayer/chains"
+	"github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
+)
+
+var _ chains.TxReconciler = (*EVMChainProvider)(nil)
+
+// ReconcileTransaction looks up the receipt of the given pending transaction. If it is not
+// mined and still in the mempool, its nonce is marked as stuck so that the next relay of the
+// signer replaces it instead of queuing behind it, and the transaction stays pending. If it
+// is neither mined nor in the mempool anymore, e.g. replaced or dropped, it is marked as
+// timed out.
+func (cp *EVMChainProvider) ReconcileTransaction(ctx context.Context, tx db.Transaction) (db.Transaction, error) {
+	if err := cp.Client.CheckAndConnect(ctx); err != nil {
+		return tx, fmt.Errorf("[EVMProvider] failed to connect client: %w", err)
+	}
+
+	result, err := cp.CheckConfirmedTx(ctx, tx.TxHash)
+	if err == nil {
+		if result.Status == types.TX_STATUS_SUCCESS || result.Status == types.TX_STATUS_FAILED {
+			tx.Status = result.Status
+			tx.GasUsed = result.GasUsed
+			tx.EffectiveGasPrice = result.EffectiveGasPrice
+
+			header, err := cp.Client.GetHeaderBlock(ctx, result.BlockNumber)
+			if err != nil {
+				cp.Log.Error("Failed to get header block", "tx_hash", tx.TxHash, err)
+			} else {
+				timestamp := time.Unix(int64(header.Time), 0).UTC()
+				tx.BlockTimestamp = &timestamp
+			}
+		}
+
+		return tx, nil
+	}
+
+	pendingTx, isPending, err := cp.Client.GetTxByHash(ctx, tx.TxHash)
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+		tx.Status = types.TX_STATUS_TIMEOUT
+		return tx, nil
+	case err != nil:
+		return tx, fmt.Errorf("[EVMProvider] failed to get tx by hash: %w", err)
+	}
+
+	if isPending {
+		// records the fees of the transaction, so that the replacement pays more than it.
+		sender := gethcommon.HexToAddress(tx.Sender)
+		cp.NonceManager.MarkPending(sender, pendingTx.Nonce(), tx.TxHash, gasInfoFromTx(pendingTx))
+		cp.NonceManager.MarkStuck(sender, pendingTx.Nonce())
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,62 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/bandprotocol/falcon/relayer/chains"
+	"github.com/bandprotocol/falcon/relayer/wallet"
+)
+
+var _ chains.SignerSweeper = (*EVMChainProvider)(nil)
+
+// SweepSigner transfers the balance of the signer, less the maximum transfer fee, to the
+// destination. As the fee is computed from the fee cap, a small amount may be left in
+// the account on EIP-1559 chains.
+func (cp *EVMChainProvider) SweepSigner(
+	ctx context.Context,
+	signer wallet.Signer,
+	destination string,
+	dryRun bool,
+) (chains.SweepResult, error) {
+	to, err := HexToAddress(destination)
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[EVMProvider] invalid destination address: %w", err)
+	}
+
+	if err := cp.Client.CheckAndConnect(ctx); err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[EVMProvider] failed to connect client: %w", err)
+	}
+
+	from := gethcommon.HexToAddress(signer.GetAddress())
+	balance, err := cp.Client.GetBalance(ctx, from, nil)
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[EVMProvider] failed to query balance: %w", err)
+	}
+
+	gasInfo, err := cp.EstimateGasFee(ctx)
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[EVMProvider] failed to estimate gas fee: %w", err)
+	}
+
+	gasLimit, err := cp.estimateGasLimit(ctx, from, to, nil, nil, gasInfo)
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[EVMProvider] failed to estimate gas limit: %w", err)
+	}
+
+	result := chains.NewSweepResult(balance, cp.maxTxFee(gasLimit, gasInfo), new(big.Int))
+	if dryRun || result.Amount.Sign() == 0 {
+		return result, nil
+	}
+
+	log := cp.Log.With("signer_address", signer.GetAddress(), "destination", destination)
+	result.TxHash, result.Status, err = cp.transfer(ctx, signer, to, result.Amount, gasLimit, gasInfo, log)
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[EVMProvider] %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,58 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/bandprotocol/falcon/relayer/chains"
+)
+
+var _ chains.SignerFunder = (*EVMChainProvider)(nil)
+
+// FundSigner transfers the given amount of the native token from the treasury key to the
+// given address and waits for the transfer to be confirmed.
+func (cp *EVMChainProvider) FundSigner(
+	ctx context.Context,
+	address string,
+	amount *big.Int,
+) (chains.FundingResult, error) {
+	treasury, ok := cp.Wallet.GetSigner(cp.Config.TreasuryKey)
+	if !ok {
+		return chains.FundingResult{}, fmt.Errorf("[EVMProvider] treasury key does not exist: %s", cp.Config.TreasuryKey)
+	}
+
+	to, err := HexToAddress(address)
+	if err != nil {
+		return chains.FundingResult{}, fmt.Errorf("[EVMProvider] invalid signer address: %w", err)
+	}
+
+	if err := cp.Client.CheckAndConnect(ctx); err != nil {
+		return chains.FundingResult{}, fmt.Errorf("[EVMProvider] failed to connect client: %w", err)
+	}
+
+	gasInfo, err := cp.EstimateGasFee(ctx)
+	if err != nil {
+		return chains.FundingResult{}, fmt.Errorf("[EVMProvider] failed to estimate gas fee: %w", err)
+	}
+
+	treasuryAddr := gethcommon.HexToAddress(treasury.GetAddress())
+	gasLimit, err := cp.estimateGasLimit(ctx, treasuryAddr, to, amount, nil, gasInfo)
+	if err != nil {
+		return chains.FundingResult{}, fmt.Errorf("[EVMProvider] failed to estimate gas limit: %w", err)
+	}
+
+	log := cp.Log.With("treasury_address", treasury.GetAddress(), "signer_address", address)
+	txHash, status, err := cp.transfer(ctx, treasury, to, amount, gasLimit, gasInfo, log)
+	if err != nil {
+		return chains.FundingResult{}, fmt.Errorf("[EVMProvider] %w", err)
+	}
+
+	return chains.FundingResult{
+		TxHash: txHash,
+		Sender: treasury.GetAddress(),
+		Status: status,
+	}, nil
+}
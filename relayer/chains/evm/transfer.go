@@ -0,0 +1,66 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/wallet"
+)
+
+// transfer sends the given value of the native token from the signer to the given address
+// with the given gas limit and waits for the transfer to be confirmed. It returns the hash
+// and the status of the transaction.
+func (cp *EVMChainProvider) transfer(
+	ctx context.Context,
+	signer wallet.Signer,
+	to gethcommon.Address,
+	value *big.Int,
+	gasLimit uint64,
+	gasInfo GasInfo,
+	log logger.Logger,
+) (string, types.TxStatus, error) {
+	from := gethcommon.HexToAddress(signer.GetAddress())
+
+	// the gas of the transfer is not raised to replace a stuck transaction, as the transferred
+	// value may depend on the fee.
+	nonce, gasInfo, _, err := cp.nextNonce(ctx, from, gasInfo, false, log)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	tx, err := cp.buildTx(to, value, nil, nonce, gasLimit, gasInfo)
+	if err != nil {
+		cp.NonceManager.Release(from, nonce)
+		return "", 0, fmt.Errorf("failed to create an evm transaction: %w", err)
+	}
+
+	signedTx, err := cp.signTx(tx, signer)
+	if err != nil {
+		cp.NonceManager.Release(from, nonce)
+		return "", 0, fmt.Errorf("failed to sign an evm transaction: %w", err)
+	}
+
+	txHash, err := cp.Client.BroadcastTx(ctx, signedTx)
+	if err != nil {
+		cp.handleBroadcastNonce(ctx, from, nonce, false, err, log)
+		return "", 0, fmt.Errorf("failed to broadcast tx: %w", err)
+	}
+
+	cp.NonceManager.MarkPending(from, nonce, txHash, gasInfo)
+	log.Info("Submitted a transfer; checking transaction status", "tx_hash", txHash, "nonce", nonce)
+
+	// the nonce of a timed-out transfer is handed out again to replace it
+	txResult := cp.WaitForConfirmedTx(ctx, txHash, log)
+	if txResult.Status == types.TX_STATUS_TIMEOUT {
+		cp.NonceManager.MarkStuck(from, nonce)
+	} else {
+		cp.NonceManager.MarkDone(from, nonce)
+	}
+
+	return txHash, txResult.Status, nil
+}
@@ -0,0 +1,16 @@
+package chains
+
+import "time"
+
+// VerifyTSSSignature verifies the TSS signature of the message for testing.
+func VerifyTSSSignature(groupPubKey []byte, message []byte, rAddress []byte, signature []byte) error {
+	return verifyTSSSignature(groupPubKey, message, rAddress, signature)
+}
+
+// ExpireGroupPubKeys ages the cached public keys of the groups of the verifier for testing.
+func ExpireGroupPubKeys(v *TSSSigningVerifier, age time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.refreshedAt = v.refreshedAt.Add(-age)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/onflow/flow-go-sdk"
 	flowhttp "github.com/onflow/flow-go-sdk/access/http"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/logger"
@@ -34,6 +35,7 @@ type ClientConnectionResult struct {
 type Client interface {
 	Connect(ctx context.Context) error
 	CheckAndConnect(ctx context.Context) error
+	GetHealth() relayerhealth.ComponentHealth
 	StartLivelinessCheck(ctx context.Context, interval time.Duration)
 	GetAccount(ctx context.Context, address string) (*flow.Account, error)
 	GetLatestBlockID(ctx context.Context) (string, error)
@@ -108,6 +110,7 @@ func (c *client) Connect(_ context.Context) error {
 
 	wg.Wait()
 	res, err := c.getClientWithMaxHeight()
+	c.clients.SetCheckResult(err)
 	if err != nil {
 		c.Log.Error("Failed to connect to Flow chain", err)
 		return err
@@ -197,6 +200,11 @@ func (c *client) CheckAndConnect(ctx context.Context) error {
 	return nil
 }
 
+// GetHealth returns the health of the connection to the Flow chain.
+func (c *client) GetHealth() relayerhealth.ComponentHealth {
+	return c.clients.Health()
+}
+
 // StartLivelinessCheck periodically reconnects to verify endpoint health.
 func (c *client) StartLivelinessCheck(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
@@ -27,9 +27,10 @@ func (cpc *FlowChainProviderConfig) NewChainProvider(
 	log logger.Logger,
 	w wallet.Wallet,
 	a alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) (chains.ChainProvider, error) {
 	c := NewClient(chainName, cpc, log, a)
-	cp, err := NewFlowChainProvider(chainName, c, cpc, log, w, a)
+	cp, err := NewFlowChainProvider(chainName, c, cpc, log, w, a, signingVerifier)
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/onflow/flow-go-sdk"
 	"github.com/shopspring/decimal"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/internal/relayermetrics"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
@@ -39,7 +40,8 @@ type FlowChainProvider struct {
 
 	DB db.Database
 
-	Alert alert.Alert
+	Alert           alert.Alert
+	SigningVerifier chains.SigningVerifier
 
 	FreeSigners chan wallet.Signer
 	Wallet      wallet.Wallet
@@ -53,15 +55,17 @@ func NewFlowChainProvider(
 	log logger.Logger,
 	w wallet.Wallet,
 	a alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) (*FlowChainProvider, error) {
 	return &FlowChainProvider{
-		Config:      cfg,
-		ChainName:   chainName,
-		Client:      client,
-		Log:         log.With("chain_name", chainName),
-		Alert:       a,
-		FreeSigners: chains.LoadSigners(w),
-		Wallet:      w,
+		Config:          cfg,
+		ChainName:       chainName,
+		Client:          client,
+		Log:             log.With("chain_name", chainName),
+		Alert:           a,
+		SigningVerifier: signingVerifier,
+		FreeSigners:     chains.LoadSigners(w),
+		Wallet:          w,
 	}, nil
 }
 
@@ -93,14 +97,19 @@ func (cp *FlowChainProvider) QueryTunnelInfo(
 }
 
 // RelayPacket relays the packet to the Flow chain.
-func (cp *FlowChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) error {
+func (cp *FlowChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) (string, error) {
 	if err := cp.Client.CheckAndConnect(ctx); err != nil {
 		cp.Log.Error("Connect client error", err)
-		return fmt.Errorf("[FlowProvider] failed to connect client: %w", err)
+		return "", fmt.Errorf("[FlowProvider] failed to connect client: %w", err)
 	}
 
 	// Get a free signer matching the target address.
-	freeSigner := <-cp.FreeSigners
+	var freeSigner wallet.Signer
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("[FlowProvider] context canceled while waiting for signer: %w", ctx.Err())
+	case freeSigner = <-cp.FreeSigners:
+	}
 	defer func() { cp.FreeSigners <- freeSigner }()
 
 	log := cp.Log.With(
@@ -152,7 +161,7 @@ func (cp *FlowChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.
 			continue
 		}
 
-		signing, err := chains.SelectSigning(packet)
+		signing, err := chains.SelectSigning(ctx, packet, cp.SigningVerifier)
 		if err != nil {
 			log.Error("Select signing error", "retry_count", retryCount, err)
 			lastErr = err
@@ -234,7 +243,7 @@ func (cp *FlowChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.
 				alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 			)
 
-			return nil
+			return txHash, nil
 		}
 
 		lastErr = fmt.Errorf("transaction %s ended with status %s", txHash, txStatus)
@@ -252,7 +261,7 @@ func (cp *FlowChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.
 		lastErr.Error(),
 	)
 
-	return fmt.Errorf("[FlowProvider] failed to relay packet after %d attempts", cp.Config.MaxRetry)
+	return "", fmt.Errorf("[FlowProvider] failed to relay packet after %d attempts: %w", cp.Config.MaxRetry, lastErr)
 }
 
 // QueryBalance queries the FLOW balance for the given address.
@@ -263,6 +272,9 @@ func (cp *FlowChainProvider) QueryBalance(ctx context.Context, address string) (
 // GetChainName retrieves the chain name from the chain provider.
 func (cp *FlowChainProvider) GetChainName() string { return cp.ChainName }
 
+// GetHealth retrieves the health of the connection to the chain from the chain provider.
+func (cp *FlowChainProvider) GetHealth() relayerhealth.ComponentHealth { return cp.Client.GetHealth() }
+
 // ChainType retrieves the chain type from the chain provider.
 func (cp *FlowChainProvider) ChainType() types.ChainType {
 	return types.ChainTypeFlow
@@ -273,6 +285,11 @@ func (cp *FlowChainProvider) GetWallet() wallet.Wallet {
 	return cp.Wallet
 }
 
+// GetFreeSigners retrieves the pool of the signers that are free to relay packets.
+func (cp *FlowChainProvider) GetFreeSigners() chan wallet.Signer {
+	return cp.FreeSigners
+}
+
 // waitForTx polls until the transaction is sealed, failed, or times out.
 // Returns the final TxStatus and the fee (if extractable).
 func (cp *FlowChainProvider) waitForTx(
@@ -59,7 +59,7 @@ func (s *FlowProviderTestSuite) SetupTest() {
 
 	s.wallet.EXPECT().GetSigners().Return(nil) // consumed by LoadSigners in NewFlowChainProvider
 
-	cp, err := flow.NewFlowChainProvider("flow-test", s.client, cfg, s.log, s.wallet, s.alert)
+	cp, err := flow.NewFlowChainProvider("flow-test", s.client, cfg, s.log, s.wallet, s.alert, nil)
 	s.Require().NoError(err)
 	s.chainProvider = cp
 }
@@ -151,7 +151,7 @@ func (s *FlowProviderTestSuite) TestRelayPacket() {
 		Error:  nil,
 	}, nil)
 
-	err := s.chainProvider.RelayPacket(context.Background(), packet)
+	_, err := s.chainProvider.RelayPacket(context.Background(), packet)
 	s.Require().NoError(err)
 }
 
@@ -162,7 +162,7 @@ func (s *FlowProviderTestSuite) TestRelayPacket_ConnectionError() {
 
 	s.client.EXPECT().CheckAndConnect(gomock.Any()).Return(fmt.Errorf("connection error"))
 
-	err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
+	_, err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
 	s.Require().Error(err)
 	s.Contains(err.Error(), "connection error")
 }
@@ -175,7 +175,7 @@ func (s *FlowProviderTestSuite) TestRelayPacket_GetLatestBlockIDError() {
 	s.client.EXPECT().CheckAndConnect(gomock.Any()).Return(nil)
 	s.client.EXPECT().GetLatestBlockID(gomock.Any()).Return("", fmt.Errorf("rpc error")).Times(3)
 
-	err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
+	_, err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
 	s.Require().Error(err)
 	s.Contains(err.Error(), "failed to relay packet after 3 attempts")
 }
@@ -189,7 +189,7 @@ func (s *FlowProviderTestSuite) TestRelayPacket_GetAccountError() {
 	s.client.EXPECT().GetLatestBlockID(gomock.Any()).Return("abc123", nil).Times(3)
 	s.client.EXPECT().GetAccount(gomock.Any(), testSignerAddress).Return(nil, fmt.Errorf("account not found")).Times(3)
 
-	err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
+	_, err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
 	s.Require().Error(err)
 	s.Contains(err.Error(), "failed to relay packet after 3 attempts")
 }
@@ -205,7 +205,7 @@ func (s *FlowProviderTestSuite) TestRelayPacket_AccountHasNoKeys() {
 	s.client.EXPECT().GetLatestBlockID(gomock.Any()).Return("abc123", nil).Times(3)
 	s.client.EXPECT().GetAccount(gomock.Any(), testSignerAddress).Return(emptyAccount, nil).Times(3)
 
-	err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
+	_, err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
 	s.Require().Error(err)
 	s.Contains(err.Error(), "failed to relay packet after 3 attempts")
 }
@@ -220,7 +220,7 @@ func (s *FlowProviderTestSuite) TestRelayPacket_SignError() {
 	s.client.EXPECT().GetAccount(gomock.Any(), testSignerAddress).Return(newTestAccount(), nil).Times(3)
 	signer.EXPECT().Sign(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("sign failed")).Times(3)
 
-	err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
+	_, err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
 	s.Require().Error(err)
 	s.Contains(err.Error(), "failed to relay packet after 3 attempts")
 }
@@ -238,7 +238,7 @@ func (s *FlowProviderTestSuite) TestRelayPacket_BroadcastError() {
 	signer.EXPECT().Sign(gomock.Any(), gomock.Any()).Return(txBlob, nil).Times(3)
 	s.client.EXPECT().BroadcastTx(gomock.Any(), txBlob).Return("", fmt.Errorf("broadcast failed")).Times(3)
 
-	err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
+	_, err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
 	s.Require().Error(err)
 	s.Contains(err.Error(), "failed to relay packet after 3 attempts")
 }
@@ -260,7 +260,7 @@ func (s *FlowProviderTestSuite) TestRelayPacket_TxFailed() {
 		Error:  fmt.Errorf("execution reverted"),
 	}, nil).Times(3)
 
-	err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
+	_, err := s.chainProvider.RelayPacket(context.Background(), newTestPacket())
 	s.Require().Error(err)
 	s.Contains(err.Error(), "failed to relay packet after 3 attempts")
 }
@@ -284,7 +284,7 @@ func (s *FlowProviderTestSuite) TestRelayPacket_MissingSigning() {
 	s.client.EXPECT().GetLatestBlockID(gomock.Any()).Return("abc123", nil).Times(3)
 	s.client.EXPECT().GetAccount(gomock.Any(), testSignerAddress).Return(newTestAccount(), nil).Times(3)
 
-	err := s.chainProvider.RelayPacket(context.Background(), packet)
+	_, err := s.chainProvider.RelayPacket(context.Background(), packet)
 	s.Require().Error(err)
 	s.Contains(err.Error(), "failed to relay packet after 3 attempts")
 }
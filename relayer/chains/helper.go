@@ -1,26 +1,40 @@
 package chains
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cometbft/cometbft/libs/bytes"
 
+	tsstypes "github.com/bandprotocol/falcon/internal/bandchain/tss"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
 )
 
-// SelectSigning selects the signing from the packet.
-func SelectSigning(packet *bandtypes.Packet) (*bandtypes.Signing, error) {
+// SelectSigning selects the signing from the packet, and verifies its TSS signature with the
+// given signing verifier. A nil verifier skips the verification.
+func SelectSigning(
+	ctx context.Context,
+	packet *bandtypes.Packet,
+	verifier SigningVerifier,
+) (*bandtypes.Signing, error) {
 	// get signing from packet; prefer to use signing from
-	// current group than incoming group
-	if packet.CurrentGroupSigning != nil {
-		return packet.CurrentGroupSigning, nil
+	// current group than incoming group, unless it has fallen
+	signing := packet.CurrentGroupSigning
+	if signing == nil || signing.SigningStatus == tsstypes.SIGNING_STATUS_FALLEN {
+		signing = packet.IncomingGroupSigning
 	}
 
-	if packet.IncomingGroupSigning != nil {
-		return packet.IncomingGroupSigning, nil
+	if signing == nil {
+		return nil, fmt.Errorf("missing signing")
 	}
 
-	return nil, fmt.Errorf("missing signing")
+	if verifier != nil {
+		if err := verifier.Verify(ctx, signing); err != nil {
+			return nil, err
+		}
+	}
+
+	return signing, nil
 }
 
 // ExtractEVMSignature extracts the EVM signature from the signing.
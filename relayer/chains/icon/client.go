@@ -11,6 +11,7 @@ import (
 	"github.com/icon-project/goloop/server/jsonrpc"
 	v3 "github.com/icon-project/goloop/server/v3"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/logger"
@@ -29,6 +30,7 @@ var _ Client = (*client)(nil)
 type Client interface {
 	Connect(ctx context.Context) error
 	CheckAndConnect(ctx context.Context) error
+	GetHealth() relayerhealth.ComponentHealth
 	StartLivelinessCheck(ctx context.Context, interval time.Duration)
 	BroadcastTx(txParams v3.TransactionParam) (string, error)
 	GetBalance(account string) (*big.Int, error)
@@ -77,6 +79,7 @@ func (c *client) Connect(_ context.Context) error {
 
 	wg.Wait()
 	res, err := c.getClientWithMaxHeight()
+	c.clients.SetCheckResult(err)
 	if err != nil {
 		c.Log.Error("Failed to connect to ICON chain", err)
 		return err
@@ -192,6 +195,11 @@ func (c *client) CheckAndConnect(ctx context.Context) error {
 	return nil
 }
 
+// GetHealth returns the health of the connection to the Icon chain.
+func (c *client) GetHealth() relayerhealth.ComponentHealth {
+	return c.clients.Health()
+}
+
 func (c *client) BroadcastTx(txParams v3.TransactionParam) (string, error) {
 	c.Log.Debug(
 		"Broadcasting tx",
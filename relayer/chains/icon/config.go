@@ -28,10 +28,11 @@ func (cpc *IconChainProviderConfig) NewChainProvider(
 	log logger.Logger,
 	wallet wallet.Wallet,
 	alert alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) (chains.ChainProvider, error) {
 	client := NewClient(chainName, cpc, log, alert)
 
-	return NewIconChainProvider(chainName, client, cpc, log, wallet, alert), nil
+	return NewIconChainProvider(chainName, client, cpc, log, wallet, alert, signingVerifier), nil
 }
 
 // Validate validates the Icon chain provider configuration.
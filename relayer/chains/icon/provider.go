@@ -10,6 +10,7 @@ import (
 	v3 "github.com/icon-project/goloop/server/v3"
 	"github.com/shopspring/decimal"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/internal/relayermetrics"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
@@ -35,7 +36,8 @@ type IconChainProvider struct {
 	Wallet wallet.Wallet
 	DB     db.Database
 
-	Alert alert.Alert
+	Alert           alert.Alert
+	SigningVerifier chains.SigningVerifier
 
 	FreeSigners chan wallet.Signer
 }
@@ -48,15 +50,17 @@ func NewIconChainProvider(
 	log logger.Logger,
 	wallet wallet.Wallet,
 	alert alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) *IconChainProvider {
 	return &IconChainProvider{
-		Config:      cfg,
-		ChainName:   chainName,
-		Client:      client,
-		Log:         log.With("chain_name", chainName),
-		Wallet:      wallet,
-		Alert:       alert,
-		FreeSigners: chains.LoadSigners(wallet),
+		Config:          cfg,
+		ChainName:       chainName,
+		Client:          client,
+		Log:             log.With("chain_name", chainName),
+		Wallet:          wallet,
+		Alert:           alert,
+		SigningVerifier: signingVerifier,
+		FreeSigners:     chains.LoadSigners(wallet),
 	}
 }
 
@@ -87,14 +91,19 @@ func (cp *IconChainProvider) QueryTunnelInfo(
 }
 
 // RelayPacket relays the packet to Icon.
-func (cp *IconChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) error {
+func (cp *IconChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) (string, error) {
 	if err := cp.Client.CheckAndConnect(ctx); err != nil {
-		return err
+		return "", err
 	}
 
 	// get a free signer
 	cp.Log.Debug("Waiting for a free signer...")
-	freeSigner := <-cp.FreeSigners
+	var freeSigner wallet.Signer
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("context canceled while waiting for signer: %w", ctx.Err())
+	case freeSigner = <-cp.FreeSigners:
+	}
 	defer func() { cp.FreeSigners <- freeSigner }()
 
 	log := cp.Log.With(
@@ -107,7 +116,7 @@ func (cp *IconChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.
 	for retryCount := 1; retryCount <= cp.Config.MaxRetry; retryCount++ {
 		log.Info("Relaying a message", "retry_count", retryCount)
 
-		signing, err := chains.SelectSigning(packet)
+		signing, err := chains.SelectSigning(ctx, packet, cp.SigningVerifier)
 		if err != nil {
 			log.Error("Select signing error", "retry_count", retryCount, err)
 			lastErr = err
@@ -223,7 +232,7 @@ func (cp *IconChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.
 				alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 			)
 
-			return nil
+			return txHash, nil
 		} else {
 			log.Error("Transaction failed", "tx_hash", txHash, "failure_reason", txResult.FailureReason)
 			lastErr = fmt.Errorf("transaction failed: %s", txResult.FailureReason)
@@ -236,23 +245,20 @@ func (cp *IconChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.
 		alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 		lastErr.Error(),
 	)
-	return fmt.Errorf("failed to relay packet after %d attempts", cp.Config.MaxRetry)
+	return "", fmt.Errorf("failed to relay packet after %d attempts: %w", cp.Config.MaxRetry, lastErr)
 }
 
-// QueryBalance queries balance by given key name from the destination chain.
-func (cp *IconChainProvider) QueryBalance(ctx context.Context, keyName string) (*big.Int, error) {
-	signer, ok := cp.Wallet.GetSigner(keyName)
-	if !ok {
-		cp.Log.Error("Key name does not exist", "key_name", keyName)
-		return nil, fmt.Errorf("key name does not exist: %s", keyName)
-	}
-
-	return cp.Client.GetBalance(signer.GetAddress())
+// QueryBalance queries balance by given address from the destination chain.
+func (cp *IconChainProvider) QueryBalance(ctx context.Context, address string) (*big.Int, error) {
+	return cp.Client.GetBalance(address)
 }
 
 // GetChainName retrieves the chain name from the chain provider.
 func (cp *IconChainProvider) GetChainName() string { return cp.ChainName }
 
+// GetHealth retrieves the health of the connection to the chain from the chain provider.
+func (cp *IconChainProvider) GetHealth() relayerhealth.ComponentHealth { return cp.Client.GetHealth() }
+
 // ChainType retrieves the chain type from the chain provider.
 func (cp *IconChainProvider) ChainType() types.ChainType {
 	return types.ChainTypeIcon
@@ -263,6 +269,11 @@ func (cp *IconChainProvider) GetWallet() wallet.Wallet {
 	return cp.Wallet
 }
 
+// GetFreeSigners retrieves the pool of the signers that are free to relay packets.
+func (cp *IconChainProvider) GetFreeSigners() chan wallet.Signer {
+	return cp.FreeSigners
+}
+
 // prepareTransaction prepares the transaction to be stored in the database.
 func (cp *IconChainProvider) prepareTransaction(
 	_ context.Context,
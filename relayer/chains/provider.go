@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
 	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
 	"github.com/bandprotocol/falcon/relayer/db"
@@ -28,8 +29,9 @@ type ChainProvider interface {
 		tunnelDestinationAddr string,
 	) (*chainstypes.Tunnel, error)
 
-	// RelayPacket relays the packet from the source chain to the destination chain.
-	RelayPacket(ctx context.Context, packet *bandtypes.Packet) error
+	// RelayPacket relays the packet from the source chain to the destination chain and
+	// returns the hash of the successful transaction.
+	RelayPacket(ctx context.Context, packet *bandtypes.Packet) (string, error)
 
 	// QueryBalance queries balance by given address from the destination chain.
 	QueryBalance(ctx context.Context, address string) (*big.Int, error)
@@ -42,4 +44,43 @@ type ChainProvider interface {
 
 	// GetWallet retrieves the wallet from the chain provider.
 	GetWallet() wallet.Wallet
+
+	// GetFreeSigners retrieves the pool of the signers that are free to relay packets.
+	GetFreeSigners() chan wallet.Signer
+
+	// GetHealth retrieves the health of the connection to the destination chain.
+	GetHealth() relayerhealth.ComponentHealth
+}
+
+// SignerFunder is implemented by the chain providers that can top up their signers
+// from the treasury key of the chain.
+type SignerFunder interface {
+	ChainProvider
+
+	// FundSigner transfers the given amount of the native token from the treasury key to
+	// the given address and waits for the transfer to be confirmed. An error is returned
+	// only if the transfer is not broadcasted.
+	FundSigner(ctx context.Context, address string, amount *big.Int) (FundingResult, error)
+}
+
+// SignerSweeper is implemented by the chain providers that can transfer the balance of
+// their signers out to another address.
+type SignerSweeper interface {
+	ChainProvider
+
+	// SweepSigner transfers the balance of the signer, less the transfer fee and the amount
+	// that must stay in the account, to the destination and waits for the transfer to be
+	// confirmed. If dryRun is true, it only computes the amount without sending it.
+	SweepSigner(ctx context.Context, signer wallet.Signer, destination string, dryRun bool) (SweepResult, error)
+}
+
+// TxReconciler is implemented by the chain providers that can look up the transactions
+// they broadcasted, to reconcile the ones left pending by a previous run.
+type TxReconciler interface {
+	ChainProvider
+
+	// ReconcileTransaction looks up the given pending transaction on the chain and returns it
+	// with its current status. The transaction stays pending if it is broadcasted but not
+	// confirmed yet.
+	ReconcileTransaction(ctx context.Context, tx db.Transaction) (db.Transaction, error)
 }
@@ -21,6 +21,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/logger"
@@ -32,6 +33,7 @@ type SecretClients = chains.ClientPool[sdkclient.Context]
 type Client interface {
 	Connect(ctx context.Context) error
 	CheckAndConnect(ctx context.Context) error
+	GetHealth() relayerhealth.ComponentHealth
 	StartLivelinessCheck(ctx context.Context, interval time.Duration)
 
 	BroadcastTx(txBlob []byte) (string, error)
@@ -154,6 +156,7 @@ func (c *client) Connect(ctx context.Context) error {
 	wg.Wait()
 
 	res, err := c.getClientWithMaxHeight(ctx)
+	c.clients.SetCheckResult(err)
 	if err != nil {
 		c.log.Error("Failed to connect to secret chain", err)
 		return err
@@ -194,6 +197,11 @@ func (c *client) CheckAndConnect(ctx context.Context) error {
 	return nil
 }
 
+// GetHealth returns the health of the connection to the Secret chain.
+func (c *client) GetHealth() relayerhealth.ComponentHealth {
+	return c.clients.Health()
+}
+
 func (c *client) getSelectedClient() (*sdkclient.Context, error) {
 	cli, err := c.clients.GetSelectedClient()
 	if err != nil {
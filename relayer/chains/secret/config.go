@@ -42,10 +42,11 @@ func (cpc *SecretChainProviderConfig) NewChainProvider(
 	log logger.Logger,
 	wallet wallet.Wallet,
 	alert alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) (chains.ChainProvider, error) {
 	client := NewClient(chainName, cpc, log, alert)
 
-	return NewSecretChainProvider(chainName, client, cpc, log, wallet, alert), nil
+	return NewSecretChainProvider(chainName, client, cpc, log, wallet, alert, signingVerifier), nil
 }
 
 func (cpc *SecretChainProviderConfig) GetChainType() types.ChainType {
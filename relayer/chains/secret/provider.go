@@ -10,6 +10,7 @@ import (
 
 	"github.com/shopspring/decimal"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/internal/relayermetrics"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
@@ -33,10 +34,11 @@ type SecretChainProvider struct {
 	Client    Client
 	Log       logger.Logger
 
-	Wallet      wallet.Wallet
-	DB          db.Database
-	Alert       alert.Alert
-	FreeSigners chan wallet.Signer
+	Wallet          wallet.Wallet
+	DB              db.Database
+	Alert           alert.Alert
+	SigningVerifier chains.SigningVerifier
+	FreeSigners     chan wallet.Signer
 }
 
 func NewSecretChainProvider(
@@ -46,15 +48,17 @@ func NewSecretChainProvider(
 	log logger.Logger,
 	wallet wallet.Wallet,
 	alert alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) *SecretChainProvider {
 	return &SecretChainProvider{
-		Config:      cfg,
-		ChainName:   chainName,
-		Client:      client,
-		Log:         log.With("chain_name", chainName),
-		Wallet:      wallet,
-		Alert:       alert,
-		FreeSigners: chains.LoadSigners(wallet),
+		Config:          cfg,
+		ChainName:       chainName,
+		Client:          client,
+		Log:             log.With("chain_name", chainName),
+		Wallet:          wallet,
+		Alert:           alert,
+		SigningVerifier: signingVerifier,
+		FreeSigners:     chains.LoadSigners(wallet),
 	}
 }
 
@@ -107,14 +111,19 @@ func countValidSecretSymbolRates(signalPrices []bandtypes.SignalPrice) uint64 {
 // - building a SignSecretRequest payload for fkms (including per-tunnel contract address)
 // - delegating tx signing & encryption to fkms via remote signer
 // - broadcasting the returned signed tx blob
-func (cp *SecretChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) error {
+func (cp *SecretChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) (string, error) {
 	if err := cp.Client.CheckAndConnect(ctx); err != nil {
-		return err
+		return "", err
 	}
 
 	// get a free signer
 	cp.Log.Debug("Waiting for a free signer...")
-	freeSigner := <-cp.FreeSigners
+	var freeSigner wallet.Signer
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("context canceled while waiting for signer: %w", ctx.Err())
+	case freeSigner = <-cp.FreeSigners:
+	}
 	defer func() { cp.FreeSigners <- freeSigner }()
 
 	log := cp.Log.With(
@@ -127,7 +136,7 @@ func (cp *SecretChainProvider) RelayPacket(ctx context.Context, packet *bandtype
 	for retryCount := 1; retryCount <= cp.Config.MaxRetry; retryCount++ {
 		log.Info("Relaying a message", "retry_count", retryCount)
 
-		signing, err := chains.SelectSigning(packet)
+		signing, err := chains.SelectSigning(ctx, packet, cp.SigningVerifier)
 		if err != nil {
 			log.Error("Select signing error", "retry_count", retryCount, err)
 			lastErr = err
@@ -218,7 +227,7 @@ func (cp *SecretChainProvider) RelayPacket(ctx context.Context, packet *bandtype
 			alert.HandleReset(cp.Alert, alert.NewTopic(alert.RelayTxErrorMsg).
 				WithTunnelID(packet.TunnelID).
 				WithChainName(cp.ChainName))
-			return nil
+			return txHash, nil
 		}
 
 		lastErr = fmt.Errorf("transaction failed: %s", txResult.FailureReason)
@@ -236,21 +245,21 @@ func (cp *SecretChainProvider) RelayPacket(ctx context.Context, packet *bandtype
 		alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 		lastErr.Error(),
 	)
-	return fmt.Errorf("failed to relay packet after %d attempts", cp.Config.MaxRetry)
+	return "", fmt.Errorf("failed to relay packet after %d attempts: %w", cp.Config.MaxRetry, lastErr)
 }
 
-func (cp *SecretChainProvider) QueryBalance(ctx context.Context, keyName string) (*big.Int, error) {
-	signer, ok := cp.Wallet.GetSigner(keyName)
-	if !ok {
-		cp.Log.Error("Key name does not exist", "key_name", keyName)
-		return nil, fmt.Errorf("key name does not exist: %s", keyName)
-	}
-	return cp.Client.GetBalance(ctx, signer.GetAddress())
+// QueryBalance queries balance by given address from the destination chain.
+func (cp *SecretChainProvider) QueryBalance(ctx context.Context, address string) (*big.Int, error) {
+	return cp.Client.GetBalance(ctx, address)
 }
 
-func (cp *SecretChainProvider) GetChainName() string       { return cp.ChainName }
-func (cp *SecretChainProvider) ChainType() types.ChainType { return types.ChainTypeSecret }
-func (cp *SecretChainProvider) GetWallet() wallet.Wallet   { return cp.Wallet }
+func (cp *SecretChainProvider) GetChainName() string               { return cp.ChainName }
+func (cp *SecretChainProvider) ChainType() types.ChainType         { return types.ChainTypeSecret }
+func (cp *SecretChainProvider) GetWallet() wallet.Wallet           { return cp.Wallet }
+func (cp *SecretChainProvider) GetFreeSigners() chan wallet.Signer { return cp.FreeSigners }
+func (cp *SecretChainProvider) GetHealth() relayerhealth.ComponentHealth {
+	return cp.Client.GetHealth()
+}
 
 func (cp *SecretChainProvider) prepareTransaction(
 	ctx context.Context,
@@ -1,12 +1,15 @@
 package chains
 
 import (
+	"slices"
+
 	"github.com/bandprotocol/falcon/relayer/wallet"
 )
 
-// LoadSigners returns the Signer channel with all configured wallet signers.
-func LoadSigners(w wallet.Wallet) chan wallet.Signer {
-	signers := w.GetSigners()
+// LoadSigners returns the Signer channel with all configured wallet signers, except the
+// signers of the given excluded key names (e.g. the treasury key).
+func LoadSigners(w wallet.Wallet, excludedKeyNames ...string) chan wallet.Signer {
+	signers := GetRelaySigners(w, excludedKeyNames...)
 	signerChannel := make(chan wallet.Signer, len(signers))
 
 	for _, signer := range signers {
@@ -15,3 +18,16 @@ func LoadSigners(w wallet.Wallet) chan wallet.Signer {
 
 	return signerChannel
 }
+
+// GetRelaySigners returns the wallet signers used for relaying, i.e. all signers except
+// the signers of the given excluded key names.
+func GetRelaySigners(w wallet.Wallet, excludedKeyNames ...string) []wallet.Signer {
+	signers := w.GetSigners()
+	if len(excludedKeyNames) == 0 {
+		return signers
+	}
+
+	return slices.DeleteFunc(slices.Clone(signers), func(signer wallet.Signer) bool {
+		return slices.Contains(excludedKeyNames, signer.GetName())
+	})
+}
@@ -69,7 +69,7 @@ func (s *SenderTestSuite) SetupTest() {
 	wallet, err := walletevm.NewWallet("", s.homePath, s.chainName)
 	s.Require().NoError(err)
 
-	_, err = evm.NewEVMChainProvider(s.chainName, client, evmCfg, log, wallet, nil)
+	_, err = evm.NewEVMChainProvider(s.chainName, client, evmCfg, log, wallet, nil, nil)
 	s.Require().NoError(err)
 
 	// Add two mock keys to the chain provider
@@ -89,7 +89,7 @@ func (s *SenderTestSuite) TestLoadFreeSenders() {
 	wallet, err := walletevm.NewWallet("", s.homePath, s.chainName)
 	s.Require().NoError(err)
 
-	chainProvider, err := evm.NewEVMChainProvider(s.chainName, client, evmCfg, log, wallet, nil)
+	chainProvider, err := evm.NewEVMChainProvider(s.chainName, client, evmCfg, log, wallet, nil, nil)
 	s.Require().NoError(err)
 
 	count := len(wallet.GetSigners())
@@ -0,0 +1,208 @@
+package chains
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/bandprotocol/falcon/relayer/band"
+	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
+	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/penalty"
+)
+
+const (
+	// tssContext is the context of the challenge of the TSS signatures on BandChain.
+	tssContext = "BAND-TSS-secp256k1-v0"
+	// tssChallengeLabel is the label of the challenge of the TSS signatures on BandChain.
+	tssChallengeLabel = "challenge"
+
+	// DefaultGroupsRefreshInterval is the interval of refreshing the public keys of the groups.
+	DefaultGroupsRefreshInterval = time.Minute
+	// mismatchRefreshDivisor limits the refreshes of the public keys of the groups on signatures
+	// that do not match them to once per this fraction of the refresh interval.
+	mismatchRefreshDivisor = 10
+)
+
+// ErrInvalidSignature is returned when the TSS signature of a signing is not signed by the
+// current or incoming group on BandChain.
+var ErrInvalidSignature = errors.New("invalid tss signature")
+
+// SigningVerifier verifies the signing of a packet before it is relayed to the target chain.
+type SigningVerifier interface {
+	Verify(ctx context.Context, signing *bandtypes.Signing) error
+}
+
+var _ SigningVerifier = &TSSSigningVerifier{}
+
+// TSSSigningVerifier verifies the TSS signature of a signing locally against the public keys
+// of the current and incoming groups on BandChain, the same way as the target contracts do.
+// The public keys are cached, and refreshed once they are older than the refresh interval or
+// a signature does not match them, e.g. after a group transition. The latter refreshes happen
+// at most once per tenth of the refresh interval, so invalid signatures cannot flood BandChain.
+type TSSSigningVerifier struct {
+	Log             logger.Logger
+	BandClient      band.Client
+	RefreshInterval time.Duration
+
+	// mu guards the public keys of the groups, and serializes their refreshes.
+	mu           sync.Mutex
+	groupPubKeys [][]byte
+	refreshedAt  time.Time
+}
+
+// NewTSSSigningVerifier creates a new TSSSigningVerifier.
+func NewTSSSigningVerifier(log logger.Logger, bandClient band.Client) *TSSSigningVerifier {
+	return &TSSSigningVerifier{
+		Log:             log.With("component", "signing_verifier"),
+		BandClient:      bandClient,
+		RefreshInterval: DefaultGroupsRefreshInterval,
+	}
+}
+
+// Verify verifies the TSS signature of the signing against the public keys of the current and
+// incoming groups. It returns ErrInvalidSignature if the signature matches neither of them.
+func (v *TSSSigningVerifier) Verify(ctx context.Context, signing *bandtypes.Signing) error {
+	if signing.EVMSignature == nil {
+		return penalty.NewError(
+			penalty.ClassSigningNotReady,
+			fmt.Errorf("%w: missing signature of signing %d", ErrInvalidSignature, signing.ID),
+		)
+	}
+
+	verifiedAt := time.Now()
+	groupPubKeys, refreshedAt, err := v.getGroupPubKeys(ctx, verifiedAt.Add(-v.RefreshInterval))
+	if err != nil {
+		return penalty.NewError(penalty.ClassTransient, err)
+	}
+
+	isValid := v.verifyGroupPubKeys(groupPubKeys, signing)
+
+	// the groups may have changed since the public keys were cached
+	if !isValid {
+		var newRefreshedAt time.Time
+		groupPubKeys, newRefreshedAt, err = v.getGroupPubKeys(
+			ctx,
+			verifiedAt.Add(-v.RefreshInterval/mismatchRefreshDivisor),
+		)
+		if err != nil {
+			return penalty.NewError(penalty.ClassTransient, err)
+		}
+
+		if newRefreshedAt.After(refreshedAt) {
+			isValid = v.verifyGroupPubKeys(groupPubKeys, signing)
+		}
+	}
+
+	if !isValid {
+		return penalty.NewError(
+			penalty.ClassSigningNotReady,
+			fmt.Errorf("%w: signing %d is not signed by the current or incoming group", ErrInvalidSignature, signing.ID),
+		)
+	}
+
+	return nil
+}
+
+// verifyGroupPubKeys checks if the TSS signature of the signing is signed by any of the groups.
+func (v *TSSSigningVerifier) verifyGroupPubKeys(groupPubKeys [][]byte, signing *bandtypes.Signing) bool {
+	rAddress, signature := ExtractEVMSignature(signing.EVMSignature)
+	for _, groupPubKey := range groupPubKeys {
+		err := verifyTSSSignature(groupPubKey, signing.Message, rAddress, signature)
+		if err == nil {
+			return true
+		}
+
+		v.Log.Debug("Signature does not match the group", "signing_id", signing.ID, "error", err.Error())
+	}
+
+	return false
+}
+
+// getGroupPubKeys returns the public keys of the current and incoming groups, refreshing them
+// from BandChain if they were refreshed before the given time. It also returns when they were
+// refreshed.
+func (v *TSSSigningVerifier) getGroupPubKeys(ctx context.Context, refreshedAfter time.Time) ([][]byte, time.Time, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.groupPubKeys != nil && !v.refreshedAt.Before(refreshedAfter) {
+		return v.groupPubKeys, v.refreshedAt, nil
+	}
+
+	currentGroup, err := v.BandClient.GetCurrentGroup(ctx)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get current group: %w", err)
+	}
+	groupPubKeys := [][]byte{currentGroup.PubKey}
+
+	// BandChain fails the query if there is no incoming group, which is the usual case
+	incomingGroup, err := v.BandClient.GetIncomingGroup(ctx)
+	if err != nil {
+		v.Log.Debug("No incoming group to verify signatures", "error", err.Error())
+	} else if len(incomingGroup.PubKey) > 0 {
+		groupPubKeys = append(groupPubKeys, incomingGroup.PubKey)
+	}
+
+	v.groupPubKeys = groupPubKeys
+	v.refreshedAt = time.Now()
+
+	return v.groupPubKeys, v.refreshedAt, nil
+}
+
+// verifyTSSSignature verifies the TSS signature of the message, given as the address of its
+// nonce and its signature scalar, against the public key of the group. The signature is valid
+// if the nonce equals s*G - c*P, where c is the challenge of the signature.
+func verifyTSSSignature(groupPubKey []byte, message []byte, rAddress []byte, signature []byte) error {
+	if len(rAddress) != 20 {
+		return fmt.Errorf("invalid nonce address length: %d", len(rAddress))
+	}
+	if len(signature) != 32 {
+		return fmt.Errorf("invalid signature length: %d", len(signature))
+	}
+
+	pubKey, err := secp256k1.ParsePubKey(groupPubKey)
+	if err != nil {
+		return fmt.Errorf("invalid group public key: %w", err)
+	}
+
+	var s secp256k1.ModNScalar
+	if overflow := s.SetByteSlice(signature); overflow || s.IsZero() {
+		return fmt.Errorf("invalid signature scalar")
+	}
+
+	// c = H(context, label, P, R address, H(message)) mod N
+	var c secp256k1.ModNScalar
+	c.SetByteSlice(crypto.Keccak256(
+		[]byte(tssContext),
+		[]byte{0x00},
+		[]byte(tssChallengeLabel),
+		[]byte{0x00},
+		pubKey.SerializeCompressed(),
+		rAddress,
+		crypto.Keccak256(message),
+	))
+
+	var p, sG, negCP, r secp256k1.JacobianPoint
+	pubKey.AsJacobian(&p)
+	secp256k1.ScalarBaseMultNonConst(&s, &sG)
+	secp256k1.ScalarMultNonConst(c.Negate(), &p, &negCP)
+	secp256k1.AddNonConst(&sG, &negCP, &r)
+	if (r.X.IsZero() && r.Y.IsZero()) || r.Z.IsZero() {
+		return fmt.Errorf("signature nonce is the point at infinity")
+	}
+	r.ToAffine()
+
+	nonce := secp256k1.NewPublicKey(&r.X, &r.Y).SerializeUncompressed()
+	if !bytes.Equal(crypto.Keccak256(nonce[1:])[12:], rAddress) {
+		return fmt.Errorf("signature does not match the group public key")
+	}
+
+	return nil
+}
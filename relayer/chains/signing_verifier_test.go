@@ -0,0 +1,284 @@
+package chains_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	cmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	tsstypes "github.com/bandprotocol/falcon/internal/bandchain/tss"
+	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
+	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
+	"github.com/bandprotocol/falcon/relayer/chains"
+	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/penalty"
+)
+
+// signTSS signs the message as a TSS group of the given private key on BandChain, returning
+// the address of the nonce and the signature scalar.
+func signTSS(t *testing.T, groupPrivKey *secp256k1.PrivateKey, message []byte) ([]byte, []byte) {
+	nonce, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	rPubKey := nonce.PubKey().SerializeUncompressed()
+	rAddress := crypto.Keccak256(rPubKey[1:])[12:]
+
+	var c secp256k1.ModNScalar
+	c.SetByteSlice(crypto.Keccak256(
+		[]byte("BAND-TSS-secp256k1-v0"),
+		[]byte{0x00},
+		[]byte("challenge"),
+		[]byte{0x00},
+		groupPrivKey.PubKey().SerializeCompressed(),
+		rAddress,
+		crypto.Keccak256(message),
+	))
+
+	// s = k + c*x
+	s := new(secp256k1.ModNScalar).Mul2(&c, &groupPrivKey.Key).Add(&nonce.Key)
+	signature := s.Bytes()
+
+	return rAddress, signature[:]
+}
+
+func newPrivKey(t *testing.T) *secp256k1.PrivateKey {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	return privKey
+}
+
+func TestVerifyTSSSignature(t *testing.T) {
+	groupPrivKey := newPrivKey(t)
+	groupPubKey := groupPrivKey.PubKey().SerializeCompressed()
+	message := []byte("message")
+	rAddress, signature := signTSS(t, groupPrivKey, message)
+
+	testcases := []struct {
+		name        string
+		groupPubKey []byte
+		message     []byte
+		rAddress    []byte
+		signature   []byte
+		err         string
+	}{
+		{
+			name:        "valid signature",
+			groupPubKey: groupPubKey,
+			message:     message,
+			rAddress:    rAddress,
+			signature:   signature,
+		},
+		{
+			name:        "uncompressed group public key",
+			groupPubKey: groupPrivKey.PubKey().SerializeUncompressed(),
+			message:     message,
+			rAddress:    rAddress,
+			signature:   signature,
+		},
+		{
+			name:        "other message",
+			groupPubKey: groupPubKey,
+			message:     []byte("other message"),
+			rAddress:    rAddress,
+			signature:   signature,
+			err:         "signature does not match the group public key",
+		},
+		{
+			name:        "other group",
+			groupPubKey: newPrivKey(t).PubKey().SerializeCompressed(),
+			message:     message,
+			rAddress:    rAddress,
+			signature:   signature,
+			err:         "signature does not match the group public key",
+		},
+		{
+			name:        "invalid group public key",
+			groupPubKey: []byte{0x02, 0x01},
+			message:     message,
+			rAddress:    rAddress,
+			signature:   signature,
+			err:         "invalid group public key",
+		},
+		{
+			name:        "invalid nonce address",
+			groupPubKey: groupPubKey,
+			message:     message,
+			rAddress:    rAddress[1:],
+			signature:   signature,
+			err:         "invalid nonce address length",
+		},
+		{
+			name:        "invalid signature",
+			groupPubKey: groupPubKey,
+			message:     message,
+			rAddress:    rAddress,
+			signature:   make([]byte, 32),
+			err:         "invalid signature scalar",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := chains.VerifyTSSSignature(tc.groupPubKey, tc.message, tc.rAddress, tc.signature)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type SigningVerifierTestSuite struct {
+	suite.Suite
+
+	ctx        context.Context
+	client     *mocks.MockClient
+	verifier   *chains.TSSSigningVerifier
+	currentKey *secp256k1.PrivateKey
+	nextKey    *secp256k1.PrivateKey
+}
+
+func TestSigningVerifierTestSuite(t *testing.T) {
+	suite.Run(t, new(SigningVerifierTestSuite))
+}
+
+func (s *SigningVerifierTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+
+	s.ctx = context.Background()
+	s.client = mocks.NewMockClient(ctrl)
+	s.verifier = chains.NewTSSSigningVerifier(logger.NewZapLogWrapper(zap.NewNop().Sugar()), s.client)
+	s.currentKey = newPrivKey(s.T())
+	s.nextKey = newPrivKey(s.T())
+}
+
+// mockGroups mocks the current group and, if given, the incoming group on BandChain.
+func (s *SigningVerifierTestSuite) mockGroups(current *secp256k1.PrivateKey, incoming *secp256k1.PrivateKey) {
+	s.client.EXPECT().
+		GetCurrentGroup(s.ctx).
+		Return(bandtypes.NewGroup(1, current.PubKey().SerializeCompressed()), nil)
+
+	if incoming == nil {
+		s.client.EXPECT().GetIncomingGroup(s.ctx).Return(nil, fmt.Errorf("no incoming group"))
+	} else {
+		s.client.EXPECT().
+			GetIncomingGroup(s.ctx).
+			Return(bandtypes.NewGroup(2, incoming.PubKey().SerializeCompressed()), nil)
+	}
+}
+
+// newSigning creates a signing of the message signed by the group of the given private key.
+func (s *SigningVerifierTestSuite) newSigning(groupPrivKey *secp256k1.PrivateKey) *bandtypes.Signing {
+	message := []byte("message")
+	rAddress, signature := signTSS(s.T(), groupPrivKey, message)
+
+	return bandtypes.NewSigning(
+		1,
+		message,
+		bandtypes.NewEVMSignature(rAddress, signature),
+		tsstypes.SIGNING_STATUS_SUCCESS,
+	)
+}
+
+func (s *SigningVerifierTestSuite) TestVerifyCurrentGroup() {
+	s.mockGroups(s.currentKey, nil)
+
+	// the public keys of the groups are cached
+	s.Require().NoError(s.verifier.Verify(s.ctx, s.newSigning(s.currentKey)))
+	s.Require().NoError(s.verifier.Verify(s.ctx, s.newSigning(s.currentKey)))
+}
+
+func (s *SigningVerifierTestSuite) TestVerifyIncomingGroup() {
+	s.mockGroups(s.currentKey, s.nextKey)
+
+	s.Require().NoError(s.verifier.Verify(s.ctx, s.newSigning(s.nextKey)))
+}
+
+func (s *SigningVerifierTestSuite) TestVerifyAfterGroupTransition() {
+	s.mockGroups(s.currentKey, nil)
+	s.Require().NoError(s.verifier.Verify(s.ctx, s.newSigning(s.currentKey)))
+
+	// the cached public keys are not refreshed again right after they were refreshed
+	s.Require().ErrorIs(s.verifier.Verify(s.ctx, s.newSigning(s.nextKey)), chains.ErrInvalidSignature)
+
+	// the cached public keys are refreshed once the signature does not match them
+	chains.ExpireGroupPubKeys(s.verifier, s.verifier.RefreshInterval/10)
+	s.mockGroups(s.nextKey, nil)
+	s.Require().NoError(s.verifier.Verify(s.ctx, s.newSigning(s.nextKey)))
+}
+
+func (s *SigningVerifierTestSuite) TestVerifyRateLimitsRefreshes() {
+	s.mockGroups(s.currentKey, nil)
+	s.Require().NoError(s.verifier.Verify(s.ctx, s.newSigning(s.currentKey)))
+
+	// the mismatched signatures refresh the public keys at most once per tenth of the refresh
+	// interval, so they are not refreshed while younger than that
+	chains.ExpireGroupPubKeys(s.verifier, s.verifier.RefreshInterval/20)
+	s.Require().ErrorIs(s.verifier.Verify(s.ctx, s.newSigning(s.nextKey)), chains.ErrInvalidSignature)
+
+	chains.ExpireGroupPubKeys(s.verifier, s.verifier.RefreshInterval/20)
+	s.mockGroups(s.currentKey, nil)
+	s.Require().ErrorIs(s.verifier.Verify(s.ctx, s.newSigning(s.nextKey)), chains.ErrInvalidSignature)
+
+	s.Require().ErrorIs(s.verifier.Verify(s.ctx, s.newSigning(s.nextKey)), chains.ErrInvalidSignature)
+}
+
+func (s *SigningVerifierTestSuite) TestVerifyInvalidSignature() {
+	s.mockGroups(s.currentKey, s.nextKey)
+
+	err := s.verifier.Verify(s.ctx, s.newSigning(newPrivKey(s.T())))
+	s.Require().ErrorIs(err, chains.ErrInvalidSignature)
+	s.Require().Equal(penalty.ClassSigningNotReady, penalty.Classify(err))
+
+	// the malformed signature does not match any group, even after refreshing the groups
+	chains.ExpireGroupPubKeys(s.verifier, s.verifier.RefreshInterval/10)
+	s.mockGroups(s.currentKey, s.nextKey)
+	signing := s.newSigning(s.currentKey)
+	signing.EVMSignature.Signature = cmbytes.HexBytes{0x01}
+	s.Require().ErrorIs(s.verifier.Verify(s.ctx, signing), chains.ErrInvalidSignature)
+
+	signing.EVMSignature = nil
+	s.Require().ErrorIs(s.verifier.Verify(s.ctx, signing), chains.ErrInvalidSignature)
+}
+
+func (s *SigningVerifierTestSuite) TestVerifyFailedToGetCurrentGroup() {
+	s.client.EXPECT().GetCurrentGroup(s.ctx).Return(nil, fmt.Errorf("connection refused"))
+
+	err := s.verifier.Verify(s.ctx, s.newSigning(s.currentKey))
+	s.Require().ErrorContains(err, "failed to get current group")
+	s.Require().NotErrorIs(err, chains.ErrInvalidSignature)
+	s.Require().Equal(penalty.ClassTransient, penalty.Classify(err))
+}
+
+func (s *SigningVerifierTestSuite) TestSelectSigning() {
+	fallenSigning := s.newSigning(newPrivKey(s.T()))
+	fallenSigning.SigningStatus = tsstypes.SIGNING_STATUS_FALLEN
+	incomingSigning := s.newSigning(s.nextKey)
+	packet := bandtypes.NewPacket(1, 1, nil, fallenSigning, incomingSigning, 0)
+
+	s.mockGroups(s.currentKey, s.nextKey)
+
+	// the signing of the incoming group is selected as the current group has fallen
+	signing, err := chains.SelectSigning(s.ctx, packet, s.verifier)
+	s.Require().NoError(err)
+	s.Require().Equal(incomingSigning, signing)
+
+	// the signing not signed by any group is refused
+	packet.CurrentGroupSigning = s.newSigning(newPrivKey(s.T()))
+	_, err = chains.SelectSigning(s.ctx, packet, s.verifier)
+	s.Require().ErrorIs(err, chains.ErrInvalidSignature)
+
+	// the signing is not verified without a verifier
+	signing, err = chains.SelectSigning(s.ctx, packet, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(packet.CurrentGroupSigning, signing)
+}
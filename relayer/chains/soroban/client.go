@@ -13,6 +13,7 @@ import (
 	"github.com/stellar/go-stellar-sdk/clients/horizonclient"
 	hProtocol "github.com/stellar/go-stellar-sdk/protocols/horizon"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/logger"
@@ -36,6 +37,7 @@ type ClientConnectionResult struct {
 type Client interface {
 	Connect(ctx context.Context) error
 	CheckAndConnect(ctx context.Context) error
+	GetHealth() relayerhealth.ComponentHealth
 	StartLivelinessCheck(ctx context.Context, interval time.Duration)
 	GetAccountSequenceNumber(account string) (int64, error)
 	GetBalance(account string) (*big.Int, error)
@@ -110,6 +112,7 @@ func (c *client) Connect(ctx context.Context) error {
 
 	wg.Wait()
 	res, err := c.getClientWithMaxLedger(ctx)
+	c.clients.SetCheckResult(err)
 	if err != nil {
 		c.Log.Error("Failed to connect to Soroban chain", err)
 		return err
@@ -132,6 +135,11 @@ func (c *client) CheckAndConnect(ctx context.Context) error {
 	return nil
 }
 
+// GetHealth returns the health of the connection to the Soroban chain.
+func (c *client) GetHealth() relayerhealth.ComponentHealth {
+	return c.clients.Health()
+}
+
 // StartLivelinessCheck starts the liveliness check for the Soroban chain.
 func (c *client) StartLivelinessCheck(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
@@ -28,10 +28,11 @@ func (cpc *SorobanChainProviderConfig) NewChainProvider(
 	log logger.Logger,
 	wallet wallet.Wallet,
 	alert alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) (chains.ChainProvider, error) {
 	client := NewClient(chainName, cpc, log, alert)
 
-	return NewSorobanChainProvider(chainName, client, cpc, log, wallet, alert), nil
+	return NewSorobanChainProvider(chainName, client, cpc, log, wallet, alert, signingVerifier), nil
 }
 
 func (cpc *SorobanChainProviderConfig) GetChainType() types.ChainType {
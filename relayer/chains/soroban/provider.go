@@ -9,6 +9,7 @@ import (
 
 	"github.com/shopspring/decimal"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/internal/relayermetrics"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
@@ -58,10 +59,11 @@ type SorobanChainProvider struct {
 	Config    *SorobanChainProviderConfig
 	ChainName string
 
-	Client Client
-	Log    logger.Logger
-	DB     db.Database
-	Alert  alert.Alert
+	Client          Client
+	Log             logger.Logger
+	DB              db.Database
+	Alert           alert.Alert
+	SigningVerifier chains.SigningVerifier
 
 	FreeSigners chan wallet.Signer
 	Wallet      wallet.Wallet
@@ -75,15 +77,17 @@ func NewSorobanChainProvider(
 	log logger.Logger,
 	w wallet.Wallet,
 	a alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) *SorobanChainProvider {
 	return &SorobanChainProvider{
-		Config:      cfg,
-		ChainName:   chainName,
-		Client:      client,
-		Log:         log.With("chain_name", chainName),
-		Alert:       a,
-		FreeSigners: chains.LoadSigners(w),
-		Wallet:      w,
+		Config:          cfg,
+		ChainName:       chainName,
+		Client:          client,
+		Log:             log.With("chain_name", chainName),
+		Alert:           a,
+		SigningVerifier: signingVerifier,
+		FreeSigners:     chains.LoadSigners(w),
+		Wallet:          w,
 	}
 }
 
@@ -109,14 +113,19 @@ func (cp *SorobanChainProvider) QueryTunnelInfo(
 	return tunnel, nil
 }
 
-func (cp *SorobanChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) error {
+func (cp *SorobanChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) (string, error) {
 	if err := cp.Client.CheckAndConnect(ctx); err != nil {
-		return fmt.Errorf("[SorobanProvider] failed to connect client: %w", err)
+		return "", fmt.Errorf("[SorobanProvider] failed to connect client: %w", err)
 	}
 
 	// get a free signer
 	cp.Log.Debug("Waiting for a free signer...")
-	freeSigner := <-cp.FreeSigners
+	var freeSigner wallet.Signer
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("[SorobanProvider] context canceled while waiting for signer: %w", ctx.Err())
+	case freeSigner = <-cp.FreeSigners:
+	}
 	defer func() { cp.FreeSigners <- freeSigner }()
 
 	log := cp.Log.With(
@@ -138,7 +147,7 @@ func (cp *SorobanChainProvider) RelayPacket(ctx context.Context, packet *bandtyp
 		}
 		sequence++
 
-		signing, err := chains.SelectSigning(packet)
+		signing, err := chains.SelectSigning(ctx, packet, cp.SigningVerifier)
 		if err != nil {
 			log.Error("Select signing error", "retry_count", retryCount, err)
 			lastErr = err
@@ -245,7 +254,7 @@ func (cp *SorobanChainProvider) RelayPacket(ctx context.Context, packet *bandtyp
 				cp.Alert,
 				alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 			)
-			return nil
+			return txResult.TxHash, nil
 		}
 
 		lastErr = fmt.Errorf("%s", txResult.FailureReason)
@@ -265,7 +274,7 @@ func (cp *SorobanChainProvider) RelayPacket(ctx context.Context, packet *bandtyp
 		alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 		lastErr.Error(),
 	)
-	return fmt.Errorf("[SorobanProvider] failed to relay packet after %d attempts", cp.Config.MaxRetry)
+	return "", fmt.Errorf("[SorobanProvider] failed to relay packet after %d attempts: %w", cp.Config.MaxRetry, lastErr)
 }
 
 // CheckConfirmedTx checks whether the submitted tx is confirmed on-chain.
@@ -333,9 +342,13 @@ func (cp *SorobanChainProvider) QueryBalance(ctx context.Context, address string
 	return cp.Client.GetBalance(address)
 }
 
-func (cp *SorobanChainProvider) GetChainName() string       { return cp.ChainName }
-func (cp *SorobanChainProvider) ChainType() types.ChainType { return types.ChainTypeSoroban }
-func (cp *SorobanChainProvider) GetWallet() wallet.Wallet   { return cp.Wallet }
+func (cp *SorobanChainProvider) GetChainName() string               { return cp.ChainName }
+func (cp *SorobanChainProvider) ChainType() types.ChainType         { return types.ChainTypeSoroban }
+func (cp *SorobanChainProvider) GetWallet() wallet.Wallet           { return cp.Wallet }
+func (cp *SorobanChainProvider) GetFreeSigners() chan wallet.Signer { return cp.FreeSigners }
+func (cp *SorobanChainProvider) GetHealth() relayerhealth.ComponentHealth {
+	return cp.Client.GetHealth()
+}
 
 func (cp *SorobanChainProvider) handleSaveTransaction(
 	txResult TxResult,
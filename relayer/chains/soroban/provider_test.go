@@ -94,7 +94,7 @@ func (s *ProviderTestSuite) SetupTest() {
 	s.Require().NoError(err)
 
 	log := logger.NewZapLogWrapper(zap.NewNop().Sugar())
-	s.chainProvider = soroban.NewSorobanChainProvider(s.chainName, s.client, baseSorobanCfg, log, wallet, nil)
+	s.chainProvider = soroban.NewSorobanChainProvider(s.chainName, s.client, baseSorobanCfg, log, wallet, nil, nil)
 
 	s.chainProvider.Client = s.client
 }
@@ -0,0 +1,35 @@
+package chains
+
+import (
+	"math/big"
+
+	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
+)
+
+// SweepResult is the result of a transfer of the balance of a signer. Amount is zero and
+// no transaction is sent if the balance does not cover the fee and the reserve.
+type SweepResult struct {
+	Balance *big.Int
+	Fee     *big.Int
+	Reserve *big.Int
+	Amount  *big.Int
+	TxHash  string
+	Status  chainstypes.TxStatus
+}
+
+// NewSweepResult creates a new SweepResult of the given balance, fee and reserve, whose
+// amount is the remaining balance.
+func NewSweepResult(balance, fee, reserve *big.Int) SweepResult {
+	amount := new(big.Int).Sub(balance, fee)
+	amount.Sub(amount, reserve)
+	if amount.Sign() < 0 {
+		amount.SetInt64(0)
+	}
+
+	return SweepResult{
+		Balance: balance,
+		Fee:     fee,
+		Reserve: reserve,
+		Amount:  amount,
+	}
+}
@@ -0,0 +1,291 @@
+package chains
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/bandprotocol/falcon/relayer/alert"
+	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+const (
+	DefaultTopUpCheckInterval = 5 * time.Minute
+	topUpCapWindow            = 24 * time.Hour
+)
+
+// FundingResult is the result of a transfer from the treasury key to a signer.
+type FundingResult struct {
+	TxHash string
+	Sender string
+	Status chainstypes.TxStatus
+}
+
+// fundingSpending is the amount sent by a funding transaction that counts toward the daily cap.
+type fundingSpending struct {
+	at     time.Time
+	amount *big.Int
+}
+
+// SignerTopUp periodically tops up the signers whose balance is below the low-water mark
+// back to the high-water mark from the treasury key, as long as the total amount sent in
+// the last 24 hours does not exceed the daily cap. The funding transactions are recorded in
+// the database, from which the spending toward the cap is loaded before the first top-up.
+type SignerTopUp struct {
+	Log    logger.Logger
+	Funder SignerFunder
+	DB     db.Database
+	Alert  alert.Alert
+
+	TreasuryKey   string
+	LowWaterMark  *big.Int
+	HighWaterMark *big.Int
+	DailyCap      *big.Int
+	Interval      time.Duration
+
+	mu             sync.Mutex
+	spending       []fundingSpending
+	spendingLoaded bool
+}
+
+// NewSignerTopUp creates a new SignerTopUp of the given chain provider. It returns an error
+// if the provider does not support top-up, the top-up config is invalid or the database is
+// not configured, as the daily cap cannot be enforced across restarts without it.
+func NewSignerTopUp(
+	log logger.Logger,
+	chainProvider ChainProvider,
+	database db.Database,
+	alert alert.Alert,
+	cfg BaseChainProviderConfig,
+) (*SignerTopUp, error) {
+	funder, ok := chainProvider.(SignerFunder)
+	if !ok {
+		return nil, fmt.Errorf("signer top-up is not supported for chain type: %s", chainProvider.ChainType())
+	}
+
+	if database == nil {
+		return nil, fmt.Errorf("signer top-up requires a database; set DB_PATH to record funding transactions")
+	}
+
+	if _, ok := chainProvider.GetWallet().GetSigner(cfg.TreasuryKey); !ok {
+		return nil, fmt.Errorf("treasury key does not exist: %s", cfg.TreasuryKey)
+	}
+
+	lowWaterMark := amountOrZero(cfg.TopUpLowWaterMark)
+	highWaterMark := amountOrZero(cfg.TopUpHighWaterMark)
+	if highWaterMark.Cmp(lowWaterMark) <= 0 {
+		return nil, fmt.Errorf("top_up_high_water_mark must be greater than top_up_low_water_mark")
+	}
+
+	dailyCap := amountOrZero(cfg.TopUpDailyCap)
+	if dailyCap.Sign() == 0 {
+		return nil, fmt.Errorf("top_up_daily_cap is required")
+	}
+
+	interval := cfg.TopUpCheckInterval
+	if interval == 0 {
+		interval = DefaultTopUpCheckInterval
+	}
+
+	return &SignerTopUp{
+		Log:           log.With("chain_name", chainProvider.GetChainName()),
+		Funder:        funder,
+		DB:            database,
+		Alert:         alert,
+		TreasuryKey:   cfg.TreasuryKey,
+		LowWaterMark:  lowWaterMark,
+		HighWaterMark: highWaterMark,
+		DailyCap:      dailyCap,
+		Interval:      interval,
+	}, nil
+}
+
+// Start tops up the signers every interval until the context is done.
+func (t *SignerTopUp) Start(ctx context.Context) {
+	ticker := time.NewTicker(t.Interval)
+	defer ticker.Stop()
+
+	for {
+		t.TopUpSigners(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// TopUpSigners funds every signer below the low-water mark up to the high-water mark.
+// Signers are skipped once the daily cap is reached. No signer is funded until the spending
+// of the last 24 hours is loaded from the database.
+func (t *SignerTopUp) TopUpSigners(ctx context.Context) {
+	chainName := t.Funder.GetChainName()
+	capTopic := alert.NewTopic(alert.TopUpDailyCapReachedMsg).WithChainName(chainName)
+	errTopic := alert.NewTopic(alert.TopUpSignerErrorMsg).WithChainName(chainName)
+
+	if err := t.loadSpending(time.Now()); err != nil {
+		t.Log.Error("Skip signer top-up; failed to load funding transactions", err)
+		alert.HandleAlert(t.Alert, errTopic, fmt.Sprintf("failed to load funding transactions: %s", err))
+		return
+	}
+
+	isCapReached := false
+	hasError := false
+	for _, signer := range GetRelaySigners(t.Funder.GetWallet(), t.TreasuryKey) {
+		address := signer.GetAddress()
+		log := t.Log.With("signer", address)
+
+		balance, err := t.Funder.QueryBalance(ctx, address)
+		if err != nil {
+			log.Warn("Failed to query signer balance", err)
+			continue
+		}
+
+		if balance.Cmp(t.LowWaterMark) >= 0 {
+			continue
+		}
+
+		amount := new(big.Int).Sub(t.HighWaterMark, balance)
+		remaining := t.remainingCap(time.Now())
+		if amount.Cmp(remaining) > 0 {
+			log.Warn(
+				"Skip signer top-up; daily cap is reached",
+				"amount", amount.String(),
+				"remaining_cap", remaining.String(),
+			)
+			isCapReached = true
+			continue
+		}
+
+		log.Info("Topping up signer", "balance", balance.String(), "amount", amount.String())
+		result, err := t.Funder.FundSigner(ctx, address, amount)
+		if err != nil {
+			log.Error("Failed to top up signer", err)
+			alert.HandleAlert(t.Alert, errTopic, fmt.Sprintf("failed to top up signer %s: %s", address, err))
+			hasError = true
+			continue
+		}
+
+		// a failed transfer does not send the amount; a timed-out one may still be mined.
+		if result.Status != chainstypes.TX_STATUS_FAILED {
+			t.addSpending(time.Now(), amount)
+		}
+
+		t.saveFundingTransaction(result, address, amount, log)
+
+		if result.Status != chainstypes.TX_STATUS_SUCCESS {
+			log.Error("Signer top-up is not successful", "tx_hash", result.TxHash, "status", result.Status.String())
+			alert.HandleAlert(
+				t.Alert,
+				errTopic,
+				fmt.Sprintf("top-up tx %s of signer %s is %s", result.TxHash, address, result.Status),
+			)
+			hasError = true
+			continue
+		}
+
+		log.Info("Signer is topped up", "tx_hash", result.TxHash, "amount", amount.String())
+	}
+
+	if isCapReached {
+		alert.HandleAlert(t.Alert, capTopic, fmt.Sprintf("daily top-up cap %s is reached", t.DailyCap))
+	} else {
+		alert.HandleReset(t.Alert, capTopic)
+	}
+
+	if !hasError {
+		alert.HandleReset(t.Alert, errTopic)
+	}
+}
+
+// loadSpending loads the amounts sent by the funding transactions of the last 24 hours
+// from the database, if they are not loaded yet.
+func (t *SignerTopUp) loadSpending(now time.Time) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.spendingLoaded {
+		return nil
+	}
+
+	txs, err := t.DB.GetFundingTransactions(t.Funder.GetChainName(), now.Add(-topUpCapWindow))
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range txs {
+		if tx.Status == chainstypes.TX_STATUS_FAILED {
+			continue
+		}
+		t.spending = append(t.spending, fundingSpending{at: tx.CreatedAt, amount: tx.Amount.BigInt()})
+	}
+	t.spendingLoaded = true
+
+	return nil
+}
+
+// saveFundingTransaction saves the funding transaction to the database.
+func (t *SignerTopUp) saveFundingTransaction(
+	result FundingResult,
+	recipient string,
+	amount *big.Int,
+	log logger.Logger,
+) {
+	tx := db.NewFundingTransaction(
+		result.TxHash,
+		t.Funder.GetChainName(),
+		t.Funder.ChainType(),
+		result.Sender,
+		recipient,
+		decimal.NewFromBigInt(amount, 0),
+		result.Status,
+	)
+
+	topic := alert.NewTopic(alert.SaveDatabaseErrorMsg).WithChainName(t.Funder.GetChainName())
+	if err := t.DB.AddOrUpdateFundingTransaction(tx); err != nil {
+		log.Error("Save funding transaction error", err)
+		alert.HandleAlert(t.Alert, topic, err.Error())
+	} else {
+		alert.HandleReset(t.Alert, topic)
+	}
+}
+
+// addSpending records the amount sent at the given time.
+func (t *SignerTopUp) addSpending(at time.Time, amount *big.Int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.spending = append(t.spending, fundingSpending{at: at, amount: amount})
+}
+
+// remainingCap returns the amount that can still be sent within the daily cap, pruning
+// the spending older than 24 hours.
+func (t *SignerTopUp) remainingCap(now time.Time) *big.Int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	spent := new(big.Int)
+	recent := t.spending[:0]
+	for _, s := range t.spending {
+		if now.Sub(s.at) >= topUpCapWindow {
+			continue
+		}
+		recent = append(recent, s)
+		spent.Add(spent, s.amount)
+	}
+	t.spending = recent
+
+	remaining := new(big.Int).Sub(t.DailyCap, spent)
+	if remaining.Sign() < 0 {
+		return new(big.Int)
+	}
+
+	return remaining
+}
@@ -0,0 +1,232 @@
+package chains_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
+	"github.com/bandprotocol/falcon/relayer/alert"
+	"github.com/bandprotocol/falcon/relayer/chains"
+	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
+	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/wallet"
+)
+
+const (
+	treasuryKeyName = "treasury"
+	treasuryAddress = "0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC"
+)
+
+type SignerTopUpTestSuite struct {
+	suite.Suite
+
+	ctrl     *gomock.Controller
+	log      logger.Logger
+	funder   *mocks.MockSignerFunder
+	database db.Database
+	alert    *recordedAlert
+	balances map[string]*big.Int
+	cfg      chains.BaseChainProviderConfig
+}
+
+func TestSignerTopUpTestSuite(t *testing.T) {
+	suite.Run(t, new(SignerTopUpTestSuite))
+}
+
+func (s *SignerTopUpTestSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.log = logger.NewZapLogWrapper(zap.NewNop().Sugar())
+
+	names := []string{keyName1, keyName2, treasuryKeyName}
+	addresses := []string{address1, address2, treasuryAddress}
+
+	signers := make([]wallet.Signer, 0, len(names))
+	for i, name := range names {
+		signer := mocks.NewMockSigner(s.ctrl)
+		signer.EXPECT().GetName().Return(name).AnyTimes()
+		signer.EXPECT().GetAddress().Return(addresses[i]).AnyTimes()
+		signers = append(signers, signer)
+	}
+
+	w := mocks.NewMockWallet(s.ctrl)
+	w.EXPECT().GetSigners().Return(signers).AnyTimes()
+	w.EXPECT().GetSigner(treasuryKeyName).Return(signers[2], true).AnyTimes()
+	w.EXPECT().GetSigner(gomock.Not(treasuryKeyName)).Return(nil, false).AnyTimes()
+
+	s.balances = map[string]*big.Int{
+		address1:        big.NewInt(1000),
+		address2:        big.NewInt(1000),
+		treasuryAddress: big.NewInt(0),
+	}
+
+	s.funder = mocks.NewMockSignerFunder(s.ctrl)
+	s.funder.EXPECT().GetChainName().Return("testnet").AnyTimes()
+	s.funder.EXPECT().ChainType().Return(chainstypes.ChainTypeEVM).AnyTimes()
+	s.funder.EXPECT().GetWallet().Return(w).AnyTimes()
+	s.funder.EXPECT().
+		QueryBalance(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, address string) (*big.Int, error) {
+			return s.balances[address], nil
+		}).
+		AnyTimes()
+
+	dbPath := "sqlite:///" + filepath.Join(s.T().TempDir(), "falcon.db")
+	migrator, err := db.NewMigrator(dbPath)
+	s.Require().NoError(err)
+	defer migrator.Close()
+
+	_, err = migrator.Migrate(context.Background())
+	s.Require().NoError(err)
+
+	s.database, err = db.NewSQL(dbPath)
+	s.Require().NoError(err)
+
+	s.alert = &recordedAlert{triggered: make(map[string]string)}
+	s.cfg = chains.BaseChainProviderConfig{
+		TreasuryKey:        treasuryKeyName,
+		TopUpLowWaterMark:  big.NewInt(500),
+		TopUpHighWaterMark: big.NewInt(1000),
+		TopUpDailyCap:      big.NewInt(1000),
+	}
+}
+
+func (s *SignerTopUpTestSuite) newSignerTopUp() *chains.SignerTopUp {
+	topUp, err := chains.NewSignerTopUp(s.log, s.funder, s.database, s.alert, s.cfg)
+	s.Require().NoError(err)
+
+	return topUp
+}
+
+// expectFundSigner expects the signer of the given address to be funded with the given amount.
+func (s *SignerTopUpTestSuite) expectFundSigner(address string, amount int64, txHash string) {
+	s.funder.EXPECT().
+		FundSigner(gomock.Any(), address, big.NewInt(amount)).
+		DoAndReturn(func(_ context.Context, address string, amount *big.Int) (chains.FundingResult, error) {
+			s.balances[address] = new(big.Int).Add(s.balances[address], amount)
+			return chains.FundingResult{
+				TxHash: txHash,
+				Sender: treasuryAddress,
+				Status: chainstypes.TX_STATUS_SUCCESS,
+			}, nil
+		})
+}
+
+func (s *SignerTopUpTestSuite) TestNewSignerTopUpInvalidConfig() {
+	cfg := s.cfg
+	cfg.TopUpHighWaterMark = cfg.TopUpLowWaterMark
+	_, err := chains.NewSignerTopUp(s.log, s.funder, s.database, s.alert, cfg)
+	s.Require().ErrorContains(err, "top_up_high_water_mark must be greater than top_up_low_water_mark")
+
+	cfg = s.cfg
+	cfg.TopUpDailyCap = nil
+	_, err = chains.NewSignerTopUp(s.log, s.funder, s.database, s.alert, cfg)
+	s.Require().ErrorContains(err, "top_up_daily_cap is required")
+
+	cfg = s.cfg
+	cfg.TreasuryKey = "unknown"
+	_, err = chains.NewSignerTopUp(s.log, s.funder, s.database, s.alert, cfg)
+	s.Require().ErrorContains(err, "treasury key does not exist")
+
+	_, err = chains.NewSignerTopUp(s.log, s.funder, nil, s.alert, s.cfg)
+	s.Require().ErrorContains(err, "signer top-up requires a database")
+
+	chainProvider := mocks.NewMockChainProvider(s.ctrl)
+	chainProvider.EXPECT().ChainType().Return(chainstypes.ChainTypeXRPL)
+	_, err = chains.NewSignerTopUp(s.log, chainProvider, s.database, s.alert, s.cfg)
+	s.Require().ErrorContains(err, "signer top-up is not supported for chain type: xrpl")
+}
+
+func (s *SignerTopUpTestSuite) TestTopUpSigners() {
+	s.balances[address1] = big.NewInt(300)
+	s.expectFundSigner(address1, 700, "0x01")
+
+	s.newSignerTopUp().TopUpSigners(context.Background())
+
+	s.Require().Equal(big.NewInt(1000), s.balances[address1])
+	s.Require().Empty(s.alert.triggered)
+
+	txs, err := s.database.GetFundingTransactions("testnet", time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().Equal("0x01", txs[0].TxHash)
+	s.Require().Equal(treasuryAddress, txs[0].Sender)
+	s.Require().Equal(address1, txs[0].Recipient)
+	s.Require().Equal("700", txs[0].Amount.String())
+	s.Require().Equal(chainstypes.TX_STATUS_SUCCESS, txs[0].Status)
+}
+
+func (s *SignerTopUpTestSuite) TestTopUpSignersDailyCap() {
+	s.balances[address1] = big.NewInt(300)
+	s.balances[address2] = big.NewInt(200)
+	s.expectFundSigner(address1, 700, "0x01")
+
+	// the second signer needs 800 but only 300 is left of the daily cap.
+	topUp := s.newSignerTopUp()
+	topUp.TopUpSigners(context.Background())
+
+	s.Require().Equal(big.NewInt(200), s.balances[address2])
+	topic := alert.NewTopic(alert.TopUpDailyCapReachedMsg).WithChainName("testnet").GetFullTopic()
+	s.Require().Contains(s.alert.triggered, topic)
+
+	// the spending recorded in the database counts toward the cap after restarting;
+	// a canceled context makes Start run a single round.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.newSignerTopUp().Start(ctx)
+	s.Require().Equal(big.NewInt(200), s.balances[address2])
+}
+
+func (s *SignerTopUpTestSuite) TestTopUpSignersFailedTransfer() {
+	s.balances[address1] = big.NewInt(300)
+	s.funder.EXPECT().
+		FundSigner(gomock.Any(), address1, big.NewInt(700)).
+		Return(chains.FundingResult{
+			TxHash: "0x01",
+			Sender: treasuryAddress,
+			Status: chainstypes.TX_STATUS_FAILED,
+		}, nil)
+	s.expectFundSigner(address1, 700, "0x02")
+
+	topUp := s.newSignerTopUp()
+	topUp.TopUpSigners(context.Background())
+
+	topic := alert.NewTopic(alert.TopUpSignerErrorMsg).WithChainName("testnet").GetFullTopic()
+	s.Require().Contains(s.alert.triggered, topic)
+
+	// a failed transfer does not count toward the daily cap.
+	topUp.TopUpSigners(context.Background())
+	s.Require().Equal(big.NewInt(1000), s.balances[address1])
+	s.Require().NotContains(s.alert.triggered, topic)
+}
+
+// failingDatabase is a database that fails to load the funding transactions.
+type failingDatabase struct {
+	db.Database
+}
+
+func (failingDatabase) GetFundingTransactions(string, time.Time) ([]db.FundingTransaction, error) {
+	return nil, fmt.Errorf("database is locked")
+}
+
+func (s *SignerTopUpTestSuite) TestTopUpSignersFailedLoadSpending() {
+	s.balances[address1] = big.NewInt(300)
+
+	topUp, err := chains.NewSignerTopUp(s.log, s.funder, failingDatabase{s.database}, s.alert, s.cfg)
+	s.Require().NoError(err)
+
+	// no signer is funded as the spending toward the daily cap is unknown.
+	topUp.TopUpSigners(context.Background())
+
+	s.Require().Equal(big.NewInt(300), s.balances[address1])
+	topic := alert.NewTopic(alert.TopUpSignerErrorMsg).WithChainName("testnet").GetFullTopic()
+	s.Require().Contains(s.alert.triggered[topic], "failed to load funding transactions")
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+// DecodeAmountHook decode hook to convert a decimal string or an integer to an amount in the
+// smallest unit of a token, e.g. wei, which may exceed uint64.
+func DecodeAmountHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if to != reflect.TypeOf(&big.Int{}) {
+		return data, nil
+	}
+
+	var amount *big.Int
+	switch v := data.(type) {
+	case string:
+		var ok bool
+		amount, ok = new(big.Int).SetString(v, 10)
+		if !ok {
+			return data, fmt.Errorf("invalid amount: %q", v)
+		}
+	case int64:
+		amount = big.NewInt(v)
+	case uint64:
+		amount = new(big.Int).SetUint64(v)
+	case int:
+		amount = big.NewInt(int64(v))
+	default:
+		return data, nil
+	}
+
+	if amount.Sign() < 0 {
+		return data, fmt.Errorf("amount must not be negative: %s", amount)
+	}
+
+	return amount, nil
+}
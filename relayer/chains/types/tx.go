@@ -3,6 +3,7 @@ package types
 import (
 	"database/sql/driver"
 	"fmt"
+	"strings"
 )
 
 // TxStatus is the status of the transaction
@@ -33,6 +34,18 @@ var txStatusFromString = map[string]TxStatus{
 	"Timeout": TX_STATUS_TIMEOUT,
 }
 
+// ToTxStatus converts a string to a TxStatus (case-insensitive). It returns zero if the
+// string is not a valid status.
+func ToTxStatus(s string) TxStatus {
+	for status, name := range txStatusNameMap {
+		if strings.EqualFold(name, s) {
+			return status
+		}
+	}
+
+	return 0
+}
+
 // Scan scans string value into TxStatus, implements sql.Scanner interface.
 // (need to manually creates `tx_status` type in a database first
 // by "CREATE TYPE tx_status AS ENUM ('Pending', 'Success', 'Failed', 'Timeout')")
@@ -52,3 +65,19 @@ func (t *TxStatus) Scan(value interface{}) error {
 
 // Value converts TxStatus to a driver.Value (string form).
 func (t TxStatus) Value() (driver.Value, error) { return t.String(), nil }
+
+// MarshalText is used for json encoding.
+func (t TxStatus) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText is used for json decoding.
+func (t *TxStatus) UnmarshalText(text []byte) error {
+	status := ToTxStatus(string(text))
+	if status == 0 {
+		return fmt.Errorf("invalid tx status")
+	}
+
+	*t = status
+	return nil
+}
@@ -10,11 +10,13 @@ import (
 	xrplaccount "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/rpc"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/logger"
@@ -34,9 +36,11 @@ func NewXRPLClients() XRPLClients {
 type Client interface {
 	Connect(ctx context.Context) error
 	CheckAndConnect(ctx context.Context) error
+	GetHealth() relayerhealth.ComponentHealth
 	StartLivelinessCheck(ctx context.Context, interval time.Duration)
 	GetAccountSequenceNumber(account string) (uint32, error)
 	GetBalance(account string) (*big.Int, error)
+	GetAccountReserve(account string) (*big.Int, error)
 	Autofill(tx *transaction.FlatTransaction) error
 	BroadcastTx(txBlob string) (TxResult, error)
 	GetLedgerCloseTime(ledgerIndex common.LedgerIndex) (*time.Time, error)
@@ -118,6 +122,7 @@ func (c *client) Connect(_ context.Context) error {
 
 	wg.Wait()
 	res, err := c.getClientWithMaxHeight()
+	c.clients.SetCheckResult(err)
 	if err != nil {
 		c.Log.Error("Failed to connect to XRPL chain", err)
 		return fmt.Errorf("failed to connect to XRPL chain: %w", err)
@@ -204,6 +209,11 @@ func (c *client) CheckAndConnect(ctx context.Context) error {
 	return nil
 }
 
+// GetHealth returns the health of the connection to the XRPL chain.
+func (c *client) GetHealth() relayerhealth.ComponentHealth {
+	return c.clients.Health()
+}
+
 // StartLivelinessCheck starts the liveliness check for the XRPL chain.
 func (c *client) StartLivelinessCheck(ctx context.Context, interval time.Duration) {
 	ticker := time.NewTicker(interval)
@@ -263,6 +273,34 @@ func (c *client) GetBalance(account string) (*big.Int, error) {
 	return b, nil
 }
 
+// GetAccountReserve fetches the XRP that must stay in the given account (drops), which is the
+// base reserve plus the owner reserve of every object owned by the account.
+func (c *client) GetAccountReserve(account string) (*big.Int, error) {
+	client, err := c.clients.GetSelectedClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client: %w", err)
+	}
+
+	state, err := client.GetServerState(&server.StateRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server state: %w", err)
+	}
+
+	result, err := client.GetAccountInfo(&xrplaccount.InfoRequest{
+		Account: types.Address(account),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+
+	validatedLedger := state.State.ValidatedLedger
+	reserve := new(big.Int).SetUint64(uint64(validatedLedger.ReserveInc))
+	reserve.Mul(reserve, new(big.Int).SetUint64(uint64(result.AccountData.OwnerCount)))
+	reserve.Add(reserve, new(big.Int).SetUint64(uint64(validatedLedger.ReserveBase)))
+
+	return reserve, nil
+}
+
 // Autofill completes a transaction with missing Sequence, Fee, and LastLedgerSequence fields.
 func (c *client) Autofill(tx *transaction.FlatTransaction) error {
 	client, err := c.clients.GetSelectedClient()
@@ -26,10 +26,11 @@ func (cpc *XRPLChainProviderConfig) NewChainProvider(
 	log logger.Logger,
 	wallet wallet.Wallet,
 	alert alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) (chains.ChainProvider, error) {
 	client := NewClient(chainName, cpc, log, alert)
 
-	return NewXRPLChainProvider(chainName, client, cpc, log, wallet, alert), nil
+	return NewXRPLChainProvider(chainName, client, cpc, log, wallet, alert, signingVerifier), nil
 }
 
 func (cpc *XRPLChainProviderConfig) GetChainType() types.ChainType {
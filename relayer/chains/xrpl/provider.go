@@ -11,6 +11,7 @@ import (
 
 	"github.com/shopspring/decimal"
 
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
 	"github.com/bandprotocol/falcon/internal/relayermetrics"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
@@ -37,7 +38,8 @@ type XRPLChainProvider struct {
 
 	DB db.Database
 
-	Alert alert.Alert
+	Alert           alert.Alert
+	SigningVerifier chains.SigningVerifier
 
 	FreeSigners chan wallet.Signer
 	Wallet      wallet.Wallet
@@ -51,15 +53,17 @@ func NewXRPLChainProvider(
 	log logger.Logger,
 	w wallet.Wallet,
 	a alert.Alert,
+	signingVerifier chains.SigningVerifier,
 ) *XRPLChainProvider {
 	return &XRPLChainProvider{
-		Config:      cfg,
-		ChainName:   chainName,
-		Client:      client,
-		Log:         log.With("chain_name", chainName),
-		Alert:       a,
-		FreeSigners: chains.LoadSigners(w),
-		Wallet:      w,
+		Config:          cfg,
+		ChainName:       chainName,
+		Client:          client,
+		Log:             log.With("chain_name", chainName),
+		Alert:           a,
+		SigningVerifier: signingVerifier,
+		FreeSigners:     chains.LoadSigners(w),
+		Wallet:          w,
 	}
 }
 
@@ -93,15 +97,15 @@ func (cp *XRPLChainProvider) QueryTunnelInfo(
 }
 
 // RelayPacket relays the packet to XRPL OracleSet transaction.
-func (cp *XRPLChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) error {
+func (cp *XRPLChainProvider) RelayPacket(ctx context.Context, packet *bandtypes.Packet) (string, error) {
 	validSender, oracleID, err := cp.validateTargetAddress(packet)
 	if err != nil {
-		return fmt.Errorf("[XRPLProvider] invalid target address: %w", err)
+		return "", fmt.Errorf("[XRPLProvider] invalid target address: %w", err)
 	}
 
 	if err := cp.Client.CheckAndConnect(ctx); err != nil {
 		cp.Log.Error("Connect client error", err)
-		return fmt.Errorf("[XRPLProvider] failed to connect client: %w", err)
+		return "", fmt.Errorf("[XRPLProvider] failed to connect client: %w", err)
 	}
 
 	// get a free signer
@@ -117,7 +121,7 @@ SignerLoop:
 	for {
 		select {
 		case <-ctx.Done():
-			return fmt.Errorf("[XRPLProvider] context canceled while waiting for signer: %w", ctx.Err())
+			return "", fmt.Errorf("[XRPLProvider] context canceled while waiting for signer: %w", ctx.Err())
 		case s := <-cp.FreeSigners:
 			if s.GetAddress() == validSender {
 				freeSigner = s
@@ -149,7 +153,7 @@ SignerLoop:
 			continue
 		}
 
-		signing, err := chains.SelectSigning(packet)
+		signing, err := chains.SelectSigning(ctx, packet, cp.SigningVerifier)
 		if err != nil {
 			log.Error("Select signing error", "retry_count", retryCount, err)
 			lastErr = err
@@ -246,7 +250,7 @@ SignerLoop:
 			alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 		)
 
-		return nil
+		return txResult.TxHash, nil
 	}
 
 	alert.HandleAlert(
@@ -254,7 +258,7 @@ SignerLoop:
 		alert.NewTopic(alert.RelayTxErrorMsg).WithTunnelID(packet.TunnelID).WithChainName(cp.ChainName),
 		lastErr.Error(),
 	)
-	return fmt.Errorf("[XRPLProvider] failed to relay packet after %d attempts", cp.Config.MaxRetry)
+	return "", fmt.Errorf("[XRPLProvider] failed to relay packet after %d attempts: %w", cp.Config.MaxRetry, lastErr)
 }
 
 // QueryBalance queries balance by given address from the destination chain.
@@ -265,6 +269,9 @@ func (cp *XRPLChainProvider) QueryBalance(ctx context.Context, address string) (
 // GetChainName retrieves the chain name from the chain provider.
 func (cp *XRPLChainProvider) GetChainName() string { return cp.ChainName }
 
+// GetHealth retrieves the health of the connection to the chain from the chain provider.
+func (cp *XRPLChainProvider) GetHealth() relayerhealth.ComponentHealth { return cp.Client.GetHealth() }
+
 // ChainType retrieves the chain type from the chain provider.
 func (cp *XRPLChainProvider) ChainType() types.ChainType {
 	return types.ChainTypeXRPL
@@ -274,6 +281,11 @@ func (cp *XRPLChainProvider) GetWallet() wallet.Wallet {
 	return cp.Wallet
 }
 
+// GetFreeSigners retrieves the pool of the signers that are free to relay packets.
+func (cp *XRPLChainProvider) GetFreeSigners() chan wallet.Signer {
+	return cp.FreeSigners
+}
+
 // validateTargetAddress parses the BandChain target address in "sender:tunnelID"
 // format and verifies that the sender is a known wallet signer and the tunnelID
 // matches the packet.
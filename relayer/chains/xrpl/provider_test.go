@@ -58,7 +58,7 @@ func (s *XRPLProviderTestSuite) SetupTest() {
 
 	s.wallet.EXPECT().GetSigners().Return(nil) // consumed by LoadSigners in NewXRPLChainProvider
 
-	cp := xrpl.NewXRPLChainProvider("xrpl-test", s.client, cfg, s.log, s.wallet, s.alert)
+	cp := xrpl.NewXRPLChainProvider("xrpl-test", s.client, cfg, s.log, s.wallet, s.alert, nil)
 	s.chainProvider = cp
 }
 
@@ -134,7 +134,7 @@ func (s *XRPLProviderTestSuite) TestRelayPacket() {
 	)
 
 	// Execute
-	err = s.chainProvider.RelayPacket(context.Background(), packet)
+	_, err = s.chainProvider.RelayPacket(context.Background(), packet)
 	s.Require().NoError(err)
 }
 
@@ -151,7 +151,7 @@ func (s *XRPLProviderTestSuite) TestRelayPacket_ConnectionError() {
 	s.wallet.EXPECT().GetSigners().Return([]wallet.Signer{mockSigner})
 	s.client.EXPECT().CheckAndConnect(gomock.Any()).Return(fmt.Errorf("connection error"))
 
-	err := s.chainProvider.RelayPacket(context.Background(), packet)
+	_, err := s.chainProvider.RelayPacket(context.Background(), packet)
 	s.Require().Error(err)
 	s.Contains(err.Error(), "connection error")
 }
@@ -165,3 +165,52 @@ func (s *XRPLProviderTestSuite) TestQueryBalance() {
 	s.Require().NoError(err)
 	s.Equal(expectedBalance, bal)
 }
+
+func (s *XRPLProviderTestSuite) TestSweepSigner() {
+	seed := "sEdVeuhfwHB6dMxgSBccJ7ZYGyLfySa"
+	w, _ := xrplwallet.FromSecret(seed)
+	mockSigner := walletxrpl.NewLocalSigner("test-local-signer", &w)
+	destination := "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+
+	s.client.EXPECT().CheckAndConnect(gomock.Any()).Return(nil)
+	s.client.EXPECT().GetBalance(mockSigner.GetAddress()).Return(big.NewInt(5_000_000), nil)
+	s.client.EXPECT().GetAccountReserve(mockSigner.GetAddress()).Return(big.NewInt(1_200_000), nil)
+	s.client.EXPECT().GetAccountSequenceNumber(mockSigner.GetAddress()).Return(uint32(10), nil)
+	s.client.EXPECT().BroadcastTx(gomock.Any()).Return(xrpl.TxResult{TxHash: "HASH", Fee: "100"}, nil)
+
+	result, err := s.chainProvider.SweepSigner(context.Background(), mockSigner, destination, false)
+	s.Require().NoError(err)
+	s.Equal(big.NewInt(100), result.Fee)
+	s.Equal(big.NewInt(1_200_000), result.Reserve)
+	s.Equal(big.NewInt(3_799_900), result.Amount)
+	s.Equal("HASH", result.TxHash)
+}
+
+func (s *XRPLProviderTestSuite) TestSweepSigner_DryRun() {
+	seed := "sEdVeuhfwHB6dMxgSBccJ7ZYGyLfySa"
+	w, _ := xrplwallet.FromSecret(seed)
+	mockSigner := walletxrpl.NewLocalSigner("test-local-signer", &w)
+
+	s.client.EXPECT().CheckAndConnect(gomock.Any()).Return(nil)
+	s.client.EXPECT().GetBalance(mockSigner.GetAddress()).Return(big.NewInt(5_000_000), nil)
+	s.client.EXPECT().GetAccountReserve(mockSigner.GetAddress()).Return(big.NewInt(1_200_000), nil)
+
+	result, err := s.chainProvider.SweepSigner(
+		context.Background(),
+		mockSigner,
+		"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		true,
+	)
+	s.Require().NoError(err)
+	s.Equal(big.NewInt(3_799_900), result.Amount)
+	s.Empty(result.TxHash)
+}
+
+func (s *XRPLProviderTestSuite) TestSweepSigner_InvalidDestination() {
+	seed := "sEdVeuhfwHB6dMxgSBccJ7ZYGyLfySa"
+	w, _ := xrplwallet.FromSecret(seed)
+	mockSigner := walletxrpl.NewLocalSigner("test-local-signer", &w)
+
+	_, err := s.chainProvider.SweepSigner(context.Background(), mockSigner, "0xinvalid", true)
+	s.Require().ErrorContains(err, "invalid destination address")
+}
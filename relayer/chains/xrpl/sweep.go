@@ -0,0 +1,88 @@
+package xrpl
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+
+	"github.com/bandprotocol/falcon/relayer/chains"
+	"github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/wallet"
+	"github.com/bandprotocol/falcon/relayer/wallet/xrpl"
+)
+
+var _ chains.SignerSweeper = (*XRPLChainProvider)(nil)
+
+// SweepSigner transfers the balance of the signer, less the fee and the account reserve,
+// to the destination with an XRP payment.
+func (cp *XRPLChainProvider) SweepSigner(
+	ctx context.Context,
+	signer wallet.Signer,
+	destination string,
+	dryRun bool,
+) (chains.SweepResult, error) {
+	if !addresscodec.IsValidClassicAddress(destination) {
+		return chains.SweepResult{}, fmt.Errorf("[XRPLProvider] invalid destination address: %s", destination)
+	}
+
+	paymentSigner, ok := signer.(xrpl.PaymentSigner)
+	if !ok {
+		return chains.SweepResult{}, fmt.Errorf("[XRPLProvider] signer does not support payment signing")
+	}
+
+	fee, ok := new(big.Int).SetString(cp.Config.Fee, 10)
+	if !ok {
+		return chains.SweepResult{}, fmt.Errorf("[XRPLProvider] invalid fee: %s", cp.Config.Fee)
+	}
+
+	if err := cp.Client.CheckAndConnect(ctx); err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[XRPLProvider] failed to connect client: %w", err)
+	}
+
+	address := signer.GetAddress()
+	balance, err := cp.Client.GetBalance(address)
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[XRPLProvider] failed to query balance: %w", err)
+	}
+
+	reserve, err := cp.Client.GetAccountReserve(address)
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[XRPLProvider] failed to query account reserve: %w", err)
+	}
+
+	result := chains.NewSweepResult(balance, fee, reserve)
+	if dryRun || result.Amount.Sign() == 0 {
+		return result, nil
+	}
+
+	sequence, err := cp.Client.GetAccountSequenceNumber(address)
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[XRPLProvider] failed to get account sequence number: %w", err)
+	}
+
+	payload := xrpl.NewPaymentPayload(address, destination, result.Amount.String(), cp.Config.Fee, sequence)
+	txBlob, err := paymentSigner.SignPayment(payload)
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[XRPLProvider] failed to sign payment: %w", err)
+	}
+
+	txResult, err := cp.Client.BroadcastTx(string(txBlob))
+	if err != nil {
+		return chains.SweepResult{}, fmt.Errorf("[XRPLProvider] failed to broadcast payment: %w", err)
+	}
+
+	cp.Log.Info(
+		"Signer balance is swept",
+		"signer_address", address,
+		"destination", destination,
+		"amount", result.Amount.String(),
+		"tx_hash", txResult.TxHash,
+	)
+
+	result.TxHash = txResult.TxHash
+	result.Status = types.TX_STATUS_SUCCESS
+
+	return result, nil
+}
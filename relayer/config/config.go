@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/pelletier/go-toml/v2"
 
+	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/band"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/chains/evm"
@@ -18,6 +20,7 @@ import (
 	"github.com/bandprotocol/falcon/relayer/chains/soroban"
 	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
 	"github.com/bandprotocol/falcon/relayer/chains/xrpl"
+	"github.com/bandprotocol/falcon/relayer/penalty"
 )
 
 // ChainProviderConfigs is a collection of ChainProviderConfig interfaces (mapped by chainName)
@@ -28,14 +31,28 @@ type GlobalConfig struct {
 	LogLevel               string        `mapstructure:"log_level"                toml:"log_level"`
 	CheckingPacketInterval time.Duration `mapstructure:"checking_packet_interval" toml:"checking_packet_interval"`
 	SyncTunnelsInterval    time.Duration `mapstructure:"sync_tunnels_interval"    toml:"sync_tunnels_interval"`
-	PenaltySkipRounds      uint          `mapstructure:"penalty_skip_rounds"      toml:"penalty_skip_rounds"`
+	CatchUpWindow          time.Duration `mapstructure:"catch_up_window"          toml:"catch_up_window"`
+	SigningMaxWait         time.Duration `mapstructure:"signing_max_wait"         toml:"signing_max_wait"`
+	ShutdownGracePeriod    time.Duration `mapstructure:"shutdown_grace_period"    toml:"shutdown_grace_period"`
+	MaxConcurrentRelays    int           `mapstructure:"max_concurrent_relays"    toml:"max_concurrent_relays"`
 	MetricsListenAddr      string        `mapstructure:"metrics_listen_addr"      toml:"metrics_listen_addr"`
+	AutoMigrate            bool          `mapstructure:"auto_migrate"             toml:"auto_migrate"`
+	AdminListenAddr        string        `mapstructure:"admin_listen_addr"        toml:"admin_listen_addr"`
+	AdminToken             string        `mapstructure:"admin_token"              toml:"admin_token,omitempty"`
+
+	// Penalties overrides the default penalty backoff of the failing tunnel relayers by error class.
+	Penalties map[penalty.Class]penalty.BackoffConfig `mapstructure:"penalties" toml:"penalties,omitempty"`
+
+	// Deprecated: PenaltySkipRounds is ignored; the penalty of a failing tunnel relayer is
+	// configured by Penalties.
+	PenaltySkipRounds uint `mapstructure:"penalty_skip_rounds" toml:"penalty_skip_rounds,omitempty"`
 }
 
 // Config defines the configuration for the falcon tunnel relayer.
 type Config struct {
 	Global       GlobalConfig         `mapstructure:"global"        toml:"global"`
 	BandChain    band.Config          `mapstructure:"bandchain"     toml:"bandchain"`
+	Alert        alert.Config         `mapstructure:"alert"         toml:"alert"`
 	TargetChains ChainProviderConfigs `mapstructure:"target_chains" toml:"target_chains"`
 }
 
@@ -46,6 +63,7 @@ type ChainProviderConfigWrapper map[string]interface{}
 type ConfigInputWrapper struct {
 	Global       GlobalConfig                          `mapstructure:"global"`
 	BandChain    band.Config                           `mapstructure:"bandchain"`
+	Alert        alert.Config                          `mapstructure:"alert"`
 	TargetChains map[string]ChainProviderConfigWrapper `mapstructure:"target_chains"`
 }
 
@@ -61,6 +79,7 @@ func ParseChainProviderConfig(w ChainProviderConfigWrapper) (chains.ChainProvide
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			decodeTimeHook,
 			chainstypes.DecodeChainTypeHook,
+			chainstypes.DecodeAmountHook,
 			evm.DecodeGasTypeHook,
 		),
 	}
@@ -199,6 +218,7 @@ func ParseConfigInputWrapper(wrappedCfg *ConfigInputWrapper) (*Config, error) {
 	return &Config{
 		Global:       wrappedCfg.Global,
 		BandChain:    wrappedCfg.BandChain,
+		Alert:        wrappedCfg.Alert,
 		TargetChains: targetChains,
 	}, nil
 }
@@ -210,19 +230,36 @@ func DefaultConfig() *Config {
 			RpcEndpoints:               []string{"http://localhost:26657"},
 			Timeout:                    3 * time.Second,
 			LivelinessCheckingInterval: 5 * time.Minute,
+			TunnelsRefreshInterval:     band.DefaultTunnelsRefreshInterval,
+			PacketCacheSize:            band.DefaultPacketCacheSize,
+			VerifySignatures:           true,
+		},
+		Alert: alert.Config{
+			Timeout: 5 * time.Second,
 		},
 		TargetChains: make(map[string]chains.ChainProviderConfig),
 		Global: GlobalConfig{
 			LogLevel:               "info",
 			CheckingPacketInterval: time.Minute,
-			PenaltySkipRounds:      3,
 			SyncTunnelsInterval:    5 * time.Minute,
+			CatchUpWindow:          time.Hour,
+			SigningMaxWait:         2 * time.Minute,
+			ShutdownGracePeriod:    30 * time.Second,
+			MaxConcurrentRelays:    100,
 		},
 	}
 }
 
+// ParseConfig parses the given TOML bytes into a Config object. The fields that are not set,
+// e.g. added after the config file was written, keep their default values.
 func ParseConfig(data []byte) (*Config, error) {
-	var cfgWrapper ConfigInputWrapper
+	defaultCfg := DefaultConfig()
+	cfgWrapper := ConfigInputWrapper{
+		Global:    defaultCfg.Global,
+		BandChain: defaultCfg.BandChain,
+		Alert:     defaultCfg.Alert,
+	}
+
 	if err := DecodeConfigInputWrapperTOML(data, &cfgWrapper); err != nil {
 		return nil, err
 	}
@@ -266,3 +303,42 @@ func LoadChainConfig(cfgPath string) (chains.ChainProviderConfig, error) {
 
 	return chainProviderConfig, nil
 }
+
+// TargetChainsDiff is the difference of the target chains between two configs.
+type TargetChainsDiff struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Updated []string `json:"updated"`
+}
+
+// IsEmpty checks if there is no difference of the target chains.
+func (d TargetChainsDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Updated) == 0
+}
+
+// DiffTargetChains returns the target chains added, removed and updated in the new configs,
+// compared to the old ones. The chain names are sorted.
+func DiffTargetChains(oldCfgs ChainProviderConfigs, newCfgs ChainProviderConfigs) TargetChainsDiff {
+	diff := TargetChainsDiff{Added: []string{}, Removed: []string{}, Updated: []string{}}
+	for chainName, newCfg := range newCfgs {
+		oldCfg, ok := oldCfgs[chainName]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, chainName)
+		case !reflect.DeepEqual(oldCfg, newCfg):
+			diff.Updated = append(diff.Updated, chainName)
+		}
+	}
+
+	for chainName := range oldCfgs {
+		if _, ok := newCfgs[chainName]; !ok {
+			diff.Removed = append(diff.Removed, chainName)
+		}
+	}
+
+	slices.Sort(diff.Added)
+	slices.Sort(diff.Removed)
+	slices.Sort(diff.Updated)
+
+	return diff
+}
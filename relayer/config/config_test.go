@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"fmt"
+	"math/big"
 	"os"
 	"path"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bandprotocol/falcon/internal/relayertest"
+	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/band"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/chains/evm"
@@ -18,8 +20,16 @@ import (
 	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
 	"github.com/bandprotocol/falcon/relayer/chains/xrpl"
 	"github.com/bandprotocol/falcon/relayer/config"
+	"github.com/bandprotocol/falcon/relayer/penalty"
 )
 
+// defaultConfigWith returns the default config modified by the given function.
+func defaultConfigWith(modify func(cfg *config.Config)) *config.Config {
+	cfg := config.DefaultConfig()
+	modify(cfg)
+	return cfg
+}
+
 func TestParseConfig(t *testing.T) {
 	testcases := []struct {
 		name        string
@@ -34,6 +44,78 @@ func TestParseConfig(t *testing.T) {
 			in:   []byte(relayertest.DefaultCfgText),
 			out:  config.DefaultConfig(),
 		},
+		{
+			name: "read legacy config; missing fields keep their defaults",
+			in:   []byte(relayertest.LegacyCfgText),
+			out: defaultConfigWith(func(cfg *config.Config) {
+				cfg.Global.PenaltySkipRounds = 3
+			}),
+		},
+		{
+			name: "explicit zero values are kept",
+			in: []byte(`[global]
+			signing_max_wait = 0
+			catch_up_window = 0
+
+			[bandchain]
+			verify_signatures = false
+			tunnels_refresh_interval = 0
+			`),
+			out: defaultConfigWith(func(cfg *config.Config) {
+				cfg.Global.SigningMaxWait = 0
+				cfg.Global.CatchUpWindow = 0
+				cfg.BandChain.VerifySignatures = false
+				cfg.BandChain.TunnelsRefreshInterval = 0
+			}),
+		},
+		{
+			name: "alert thresholds",
+			in: []byte(`[alert]
+			type = 'webhook'
+			url = 'http://localhost'
+			timeout = '5s'
+
+			[alert.default]
+			min_triggers = 3
+
+			[alert.thresholds.'Failed to relay transaction']
+			min_duration = '1m'
+			recovery_window = '5m'
+			max_notifications = 2
+			`),
+			out: defaultConfigWith(func(cfg *config.Config) {
+				cfg.Alert = alert.Config{
+					Type:    alert.BackendTypeWebhook,
+					URL:     "http://localhost",
+					Timeout: 5 * time.Second,
+					Default: alert.ThresholdConfig{MinTriggers: 3},
+					Thresholds: map[string]alert.ThresholdConfig{
+						alert.RelayTxErrorMsg: {
+							MinDuration:      time.Minute,
+							RecoveryWindow:   5 * time.Minute,
+							MaxNotifications: 2,
+						},
+					},
+				}
+			}),
+		},
+		{
+			name: "penalties",
+			in: []byte(`[global.penalties.insufficient_funds]
+			initial_backoff = '10m'
+			max_backoff = '2h'
+			multiplier = 3.0
+			`),
+			out: defaultConfigWith(func(cfg *config.Config) {
+				cfg.Global.Penalties = map[penalty.Class]penalty.BackoffConfig{
+					penalty.ClassInsufficientFunds: {
+						InitialBackoff: 10 * time.Minute,
+						MaxBackoff:     2 * time.Hour,
+						Multiplier:     3,
+					},
+				}
+			}),
+		},
 		{
 			name: "invalid config file; invalid chain type",
 			in: []byte(`[target_chains.testnet]
@@ -106,6 +188,39 @@ func TestParseChainProviderConfig(t *testing.T) {
 				ComputeLimit: 2000,
 			},
 		},
+		{
+			name: "evm chain with amounts",
+			in: config.ChainProviderConfigWrapper{
+				"chain_type":             "evm",
+				"endpoints":              []string{"http://localhost:8545"},
+				"min_signer_balance":     "100000000000000000000",
+				"top_up_high_water_mark": int64(5_000_000_000),
+			},
+			out: &evm.EVMChainProviderConfig{
+				BaseChainProviderConfig: chains.BaseChainProviderConfig{
+					Endpoints:          []string{"http://localhost:8545"},
+					ChainType:          chainstypes.ChainTypeEVM,
+					MinSignerBalance:   new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil),
+					TopUpHighWaterMark: big.NewInt(5_000_000_000),
+				},
+			},
+		},
+		{
+			name: "invalid amount",
+			in: config.ChainProviderConfigWrapper{
+				"chain_type":       "evm",
+				"top_up_daily_cap": "1e18",
+			},
+			err: fmt.Errorf("invalid amount"),
+		},
+		{
+			name: "negative amount",
+			in: config.ChainProviderConfigWrapper{
+				"chain_type":           "evm",
+				"signer_balance_floor": int64(-1),
+			},
+			err: fmt.Errorf("amount must not be negative"),
+		},
 		{
 			name: "chain type not found",
 			in: config.ChainProviderConfigWrapper{
@@ -257,3 +372,36 @@ func TestLoadFlowChainConfig(t *testing.T) {
 
 	require.Equal(t, expect, actual)
 }
+
+func TestDiffTargetChains(t *testing.T) {
+	evmCfg := &evm.EVMChainProviderConfig{
+		BaseChainProviderConfig: chains.BaseChainProviderConfig{
+			Endpoints: []string{"http://localhost:8545"},
+			ChainType: chainstypes.ChainTypeEVM,
+		},
+		GasLimit: 1_000_000,
+	}
+	updatedEVMCfg := *evmCfg
+	updatedEVMCfg.GasLimit = 2_000_000
+
+	oldCfgs := config.ChainProviderConfigs{
+		"testnet_a": evmCfg,
+		"testnet_b": evmCfg,
+		"testnet_c": evmCfg,
+	}
+	newCfgs := config.ChainProviderConfigs{
+		"testnet_a": evmCfg,
+		"testnet_c": &updatedEVMCfg,
+		"testnet_d": evmCfg,
+	}
+
+	diff := config.DiffTargetChains(oldCfgs, newCfgs)
+	require.Equal(t, config.TargetChainsDiff{
+		Added:   []string{"testnet_d"},
+		Removed: []string{"testnet_b"},
+		Updated: []string{"testnet_c"},
+	}, diff)
+	require.False(t, diff.IsEmpty())
+
+	require.True(t, config.DiffTargetChains(oldCfgs, oldCfgs).IsEmpty())
+}
@@ -1,6 +1,38 @@
 package db
 
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrTransactionNotFound is returned when the requested transaction does not exist.
+var ErrTransactionNotFound = errors.New("transaction not found")
+
 // Database defines the interface for the db interaction with the target chain.
 type Database interface {
 	AddOrUpdateTransaction(transaction *Transaction) error
+
+	GetTransactions(filter TransactionFilter) ([]Transaction, error)
+	GetTransactionByHash(txHash string) (*Transaction, error)
+	GetTransactionsByTunnelSequence(tunnelID uint64, sequence uint64) ([]Transaction, error)
+	GetTunnelStats(filter TransactionFilter) ([]TunnelStats, error)
+
+	AddOrUpdateFundingTransaction(transaction *FundingTransaction) error
+	GetFundingTransactions(chainName string, since time.Time) ([]FundingTransaction, error)
+
+	// Ping verifies that the database is still reachable.
+	Ping(ctx context.Context) error
+	// Close closes the database connection once all pending writes are done.
+	Close() error
+
+	CheckpointStore
+}
+
+// CheckpointStore defines the interface for persisting the relay checkpoint of tunnels.
+type CheckpointStore interface {
+	// GetRelayCheckpoint returns the checkpoint of the given tunnel, or nil if there is none.
+	GetRelayCheckpoint(tunnelID uint64) (*RelayCheckpoint, error)
+	// SaveRelayCheckpoint creates or replaces the checkpoint of the tunnel.
+	SaveRelayCheckpoint(checkpoint *RelayCheckpoint) error
 }
@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	gosql "database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"github.com/pressly/goose/v3"
+)
+
+//go:embed migrations/postgres/*.sql migrations/sqlite/*.sql
+var migrationsFS embed.FS
+
+// ErrNoMigrationToRollback is returned when rolling back a database without any applied migration.
+var ErrNoMigrationToRollback = errors.New("no migration to rollback")
+
+// MigrationResult is the result of applying or rolling back a single migration.
+type MigrationResult struct {
+	Version   int64         `json:"version"`
+	Name      string        `json:"name"`
+	Direction string        `json:"direction"`
+	Duration  time.Duration `json:"duration"`
+}
+
+// MigrationStatus is the status of a single migration in the database.
+type MigrationStatus struct {
+	Version   int64      `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at"`
+}
+
+// Migrator applies the migrations embedded in the binary to the database.
+type Migrator struct {
+	provider *goose.Provider
+	db       *gosql.DB
+}
+
+// NewMigrator opens a new connection to the database of the given dbPath and prepares the
+// embedded migrations of its driver.
+func NewMigrator(dbPath string) (*Migrator, error) {
+	driverName, _, err := splitDbPath(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialect goose.Dialect
+	switch driverName {
+	case "postgres":
+		dialect = goose.DialectPostgres
+	case "sqlite":
+		dialect = goose.DialectSQLite3
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", driverName)
+	}
+
+	fsys, err := fs.Sub(migrationsFS, "migrations/"+driverName)
+	if err != nil {
+		return nil, err
+	}
+
+	sql, err := NewSQL(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := sql.Db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := goose.NewProvider(dialect, sqlDB, fsys)
+	if err != nil {
+		_ = sqlDB.Close()
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	return &Migrator{provider: provider, db: sqlDB}, nil
+}
+
+// Migrate applies all pending migrations.
+func (m *Migrator) Migrate(ctx context.Context) ([]MigrationResult, error) {
+	results, err := m.provider.Up(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate database: %w", err)
+	}
+
+	migrationResults := make([]MigrationResult, 0, len(results))
+	for _, result := range results {
+		migrationResults = append(migrationResults, newMigrationResult(result))
+	}
+
+	return migrationResults, nil
+}
+
+// Rollback rolls back the latest applied migration.
+func (m *Migrator) Rollback(ctx context.Context) (MigrationResult, error) {
+	result, err := m.provider.Down(ctx)
+	if errors.Is(err, goose.ErrNoNextVersion) {
+		return MigrationResult{}, ErrNoMigrationToRollback
+	} else if err != nil {
+		return MigrationResult{}, fmt.Errorf("failed to rollback database: %w", err)
+	}
+
+	return newMigrationResult(result), nil
+}
+
+// Status returns the status of all embedded migrations.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	statuses, err := m.provider.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get migration status: %w", err)
+	}
+
+	migrationStatuses := make([]MigrationStatus, 0, len(statuses))
+	for _, status := range statuses {
+		migrationStatus := MigrationStatus{
+			Version: status.Source.Version,
+			Name:    filepath.Base(status.Source.Path),
+			Applied: status.State == goose.StateApplied,
+		}
+		if migrationStatus.Applied {
+			appliedAt := status.AppliedAt.UTC()
+			migrationStatus.AppliedAt = &appliedAt
+		}
+
+		migrationStatuses = append(migrationStatuses, migrationStatus)
+	}
+
+	return migrationStatuses, nil
+}
+
+// Versions returns the current schema version of the database and the latest version
+// of the embedded migrations.
+func (m *Migrator) Versions(ctx context.Context) (current int64, latest int64, err error) {
+	current, latest, err = m.provider.GetVersions(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+
+	return current, latest, nil
+}
+
+// Close closes the database connection of the migrator.
+func (m *Migrator) Close() error {
+	return m.db.Close()
+}
+
+// newMigrationResult converts the goose migration result into MigrationResult.
+func newMigrationResult(result *goose.MigrationResult) MigrationResult {
+	return MigrationResult{
+		Version:   result.Source.Version,
+		Name:      filepath.Base(result.Source.Path),
+		Direction: result.Direction,
+		Duration:  result.Duration,
+	}
+}
@@ -0,0 +1,89 @@
+package db_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/relayer/db"
+)
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	dbPath := "sqlite:///" + filepath.Join(t.TempDir(), "falcon.db")
+
+	migrator, err := db.NewMigrator(dbPath)
+	require.NoError(t, err)
+	defer migrator.Close()
+
+	// a new database has all migrations pending.
+	current, latest, err := migrator.Versions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), current)
+	require.Equal(t, int64(3), latest)
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	for _, status := range statuses {
+		require.False(t, status.Applied)
+		require.Nil(t, status.AppliedAt)
+	}
+
+	// migrate applies all pending migrations.
+	results, err := migrator.Migrate(ctx)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, int64(1), results[0].Version)
+	require.Equal(t, "00001_create_transactions.sql", results[0].Name)
+	require.Equal(t, "up", results[0].Direction)
+	require.Equal(t, "00002_create_relay_checkpoints.sql", results[1].Name)
+	require.Equal(t, "00003_create_funding_transactions.sql", results[2].Name)
+
+	statuses, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	for _, status := range statuses {
+		require.True(t, status.Applied)
+		require.NotNil(t, status.AppliedAt)
+	}
+
+	// migrate again is a no-op.
+	results, err = migrator.Migrate(ctx)
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	// the schema is usable after the migration.
+	sql, err := db.NewSQL(dbPath)
+	require.NoError(t, err)
+	require.NoError(t, sql.SaveRelayCheckpoint(db.NewRelayCheckpoint(1, 1, "0x01", time.Now())))
+
+	// rollback reverts the latest migration only.
+	result, err := migrator.Rollback(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), result.Version)
+	require.Equal(t, "down", result.Direction)
+
+	current, _, err = migrator.Versions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), current)
+
+	for _, version := range []int64{2, 1} {
+		result, err = migrator.Rollback(ctx)
+		require.NoError(t, err)
+		require.Equal(t, version, result.Version)
+	}
+
+	_, err = migrator.Rollback(ctx)
+	require.ErrorIs(t, err, db.ErrNoMigrationToRollback)
+}
+
+func TestNewMigratorInvalidPath(t *testing.T) {
+	_, err := db.NewMigrator("mysql://localhost")
+	require.ErrorContains(t, err, "unsupported database driver")
+
+	_, err = db.NewMigrator("falcon.db")
+	require.ErrorContains(t, err, "invalid db path")
+}
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE relay_checkpoints (
+  tunnel_id   BIGINT PRIMARY KEY,
+  sequence    BIGINT NOT NULL,
+  tx_hash     TEXT NOT NULL,
+  relayed_at  TIMESTAMPTZ NOT NULL,
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE relay_checkpoints;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE funding_transactions (
+  id          BIGSERIAL PRIMARY KEY,
+  tx_hash     TEXT UNIQUE NOT NULL,
+  chain_name  TEXT NOT NULL,
+  chain_type  chain_type NOT NULL,
+  sender      TEXT NOT NULL,
+  recipient   TEXT NOT NULL,
+  amount      NUMERIC NOT NULL,
+  status      tx_status NOT NULL,
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_funding_transactions_chain_name_created_at ON funding_transactions (chain_name, created_at);
+
+-- +goose Down
+DROP TABLE funding_transactions;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE relay_checkpoints (
+  tunnel_id   INTEGER PRIMARY KEY,
+  sequence    INTEGER NOT NULL,
+  tx_hash     TEXT NOT NULL,
+  relayed_at  DATETIME NOT NULL,
+  updated_at  DATETIME NOT NULL DEFAULT (datetime('now', 'utc'))
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS relay_checkpoints;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE funding_transactions (
+  id          INTEGER PRIMARY KEY AUTOINCREMENT,
+  tx_hash     TEXT UNIQUE NOT NULL,
+  chain_name  TEXT NOT NULL,
+  chain_type  TEXT NOT NULL CHECK (chain_type IN ('evm', 'xrpl', 'icon', 'flow', 'soroban', 'secret')),
+  sender      TEXT NOT NULL,
+  recipient   TEXT NOT NULL,
+  amount      DECIMAL NOT NULL,
+  status      TEXT NOT NULL CHECK (status IN ('Pending','Success','Failed','Timeout')),
+  created_at  DATETIME NOT NULL DEFAULT (datetime('now', 'utc')),
+  updated_at  DATETIME NOT NULL DEFAULT (datetime('now', 'utc'))
+);
+
+CREATE INDEX idx_funding_transactions_chain_name_created_at ON funding_transactions (chain_name, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS funding_transactions;
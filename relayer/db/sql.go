@@ -1,14 +1,21 @@
 package db
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
+
+	"github.com/bandprotocol/falcon/relayer/chains/types"
 )
 
 var _ Database = &SQL{}
@@ -85,3 +92,259 @@ func (sql SQL) AddOrUpdateTransaction(transaction *Transaction) error {
 		Create(transaction).
 		Error
 }
+
+// GetTransactions returns the transactions matching the given filter, ordered from the
+// most recent one.
+func (sql SQL) GetTransactions(filter TransactionFilter) ([]Transaction, error) {
+	query := applyTransactionFilter(sql.Db.Model(&Transaction{}), filter).
+		Preload("SignalPrices").
+		Order("created_at DESC").
+		Order("id DESC")
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	var txs []Transaction
+	if err := query.Find(&txs).Error; err != nil {
+		return nil, err
+	}
+
+	return txs, nil
+}
+
+// GetTransactionByHash returns the transaction of the given hash.
+func (sql SQL) GetTransactionByHash(txHash string) (*Transaction, error) {
+	var tx Transaction
+	err := sql.Db.
+		Preload("SignalPrices").
+		Where("tx_hash = ?", txHash).
+		First(&tx).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTransactionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &tx, nil
+}
+
+// GetTransactionsByTunnelSequence returns all transactions (i.e. every relay attempt)
+// of the given tunnel packet, ordered from the oldest one.
+func (sql SQL) GetTransactionsByTunnelSequence(tunnelID uint64, sequence uint64) ([]Transaction, error) {
+	var txs []Transaction
+	err := sql.Db.
+		Preload("SignalPrices").
+		Where("tunnel_id = ? AND sequence = ?", tunnelID, sequence).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&txs).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return txs, nil
+}
+
+// GetTunnelStats returns the aggregated transaction information per tunnel and target
+// chain of the transactions matching the given filter. Pagination is not applied.
+func (sql SQL) GetTunnelStats(filter TransactionFilter) ([]TunnelStats, error) {
+	filter.Limit, filter.Offset = 0, 0
+
+	// the transactions are aggregated by status in the database, and the statuses of each
+	// tunnel and target chain are merged below.
+	var rows []struct {
+		TunnelID     uint64
+		ChainName    string
+		Status       types.TxStatus
+		Count        int64
+		GasUsed      decimal.NullDecimal
+		BalanceDelta decimal.NullDecimal
+		LatencySum   *float64
+		LatencyCount int64
+	}
+
+	latency := sql.latencySecondsExpr()
+	err := applyTransactionFilter(sql.Db.Model(&Transaction{}), filter).
+		Select(
+			"tunnel_id, chain_name, status, COUNT(*) AS count, " +
+				"SUM(gas_used) AS gas_used, SUM(balance_delta) AS balance_delta, " +
+				"SUM(" + latency + ") AS latency_sum, COUNT(" + latency + ") AS latency_count",
+		).
+		Group("tunnel_id").
+		Group("chain_name").
+		Group("status").
+		Order("tunnel_id ASC").
+		Order("chain_name ASC").
+		Scan(&rows).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		stats        []TunnelStats
+		latencySums  []float64
+		latencyCount []int64
+	)
+
+	for _, row := range rows {
+		if len(stats) == 0 ||
+			stats[len(stats)-1].TunnelID != row.TunnelID ||
+			stats[len(stats)-1].ChainName != row.ChainName {
+			stats = append(stats, TunnelStats{TunnelID: row.TunnelID, ChainName: row.ChainName})
+			latencySums = append(latencySums, 0)
+			latencyCount = append(latencyCount, 0)
+		}
+
+		idx := len(stats) - 1
+		s := &stats[idx]
+		s.TotalCount += row.Count
+		switch row.Status {
+		case types.TX_STATUS_PENDING:
+			s.PendingCount += row.Count
+		case types.TX_STATUS_SUCCESS:
+			s.SuccessCount += row.Count
+		case types.TX_STATUS_FAILED:
+			s.FailedCount += row.Count
+		case types.TX_STATUS_TIMEOUT:
+			s.TimeoutCount += row.Count
+		}
+
+		if row.GasUsed.Valid {
+			s.TotalGasUsed = s.TotalGasUsed.Add(row.GasUsed.Decimal)
+		}
+		if row.BalanceDelta.Valid {
+			s.TotalFee = s.TotalFee.Sub(row.BalanceDelta.Decimal)
+		}
+		if row.LatencySum != nil {
+			latencySums[idx] += *row.LatencySum
+			latencyCount[idx] += row.LatencyCount
+		}
+	}
+
+	for idx := range stats {
+		s := &stats[idx]
+		if finalized := s.TotalCount - s.PendingCount; finalized > 0 {
+			s.SuccessRate = float64(s.SuccessCount) / float64(finalized)
+		}
+		if latencyCount[idx] > 0 {
+			averageMillis := math.Round(latencySums[idx] * 1000 / float64(latencyCount[idx]))
+			s.AverageLatency = time.Duration(averageMillis) * time.Millisecond
+		}
+	}
+
+	return stats, nil
+}
+
+// latencySecondsExpr returns the SQL expression of the seconds between the packet creation and
+// the block including the transaction, which is NULL if either timestamp is missing.
+func (sql SQL) latencySecondsExpr() string {
+	if sql.Db.Dialector.Name() == "postgres" {
+		return "EXTRACT(EPOCH FROM (block_timestamp - packet_timestamp))"
+	}
+
+	return "(julianday(block_timestamp) - julianday(packet_timestamp)) * 86400"
+}
+
+// applyTransactionFilter applies the conditions of the given filter to the query.
+func applyTransactionFilter(query *gorm.DB, filter TransactionFilter) *gorm.DB {
+	if filter.TunnelID != nil {
+		query = query.Where("tunnel_id = ?", *filter.TunnelID)
+	}
+	if filter.ChainName != "" {
+		query = query.Where("chain_name = ?", filter.ChainName)
+	}
+	if filter.Status != 0 {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Sender != "" {
+		query = query.Where("sender = ?", filter.Sender)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	return query
+}
+
+// AddOrUpdateFundingTransaction inserts a new FundingTransaction record, or updates the status
+// of the existing record with the same TxHash.
+func (sql SQL) AddOrUpdateFundingTransaction(transaction *FundingTransaction) error {
+	return sql.Db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tx_hash"}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "updated_at"}),
+		}).
+		Create(transaction).
+		Error
+}
+
+// GetFundingTransactions returns the funding transactions of the given chain created since
+// the given time, ordered from the oldest one.
+func (sql SQL) GetFundingTransactions(chainName string, since time.Time) ([]FundingTransaction, error) {
+	var txs []FundingTransaction
+	err := sql.Db.
+		Where("chain_name = ? AND created_at >= ?", chainName, since).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&txs).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return txs, nil
+}
+
+// GetRelayCheckpoint returns the relay checkpoint of the given tunnel, or nil if there is none.
+func (sql SQL) GetRelayCheckpoint(tunnelID uint64) (*RelayCheckpoint, error) {
+	var checkpoint RelayCheckpoint
+	err := sql.Db.Where("tunnel_id = ?", tunnelID).First(&checkpoint).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+// SaveRelayCheckpoint inserts the relay checkpoint of the tunnel, or replaces the existing one.
+func (sql SQL) SaveRelayCheckpoint(checkpoint *RelayCheckpoint) error {
+	return sql.Db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tunnel_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"sequence", "tx_hash", "relayed_at", "updated_at"}),
+		}).
+		Create(checkpoint).
+		Error
+}
+
+// Ping verifies that the database is still reachable.
+func (sql SQL) Ping(ctx context.Context) error {
+	sqlDB, err := sql.Db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.PingContext(ctx)
+}
+
+// Close closes the database connection once all pending writes are done.
+func (sql SQL) Close() error {
+	sqlDB, err := sql.Db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.Close()
+}
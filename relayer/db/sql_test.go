@@ -0,0 +1,250 @@
+package db_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
+)
+
+type SQLTestSuite struct {
+	suite.Suite
+
+	db db.SQL
+}
+
+func TestSQLTestSuite(t *testing.T) {
+	suite.Run(t, new(SQLTestSuite))
+}
+
+// SetupTest opens a new sqlite database and creates its schema from the embedded migrations.
+func (s *SQLTestSuite) SetupTest() {
+	dbPath := "sqlite:///" + filepath.Join(s.T().TempDir(), "falcon.db")
+
+	migrator, err := db.NewMigrator(dbPath)
+	s.Require().NoError(err)
+	defer migrator.Close()
+
+	_, err = migrator.Migrate(context.Background())
+	s.Require().NoError(err)
+
+	sql, err := db.NewSQL(dbPath)
+	s.Require().NoError(err)
+
+	s.db = sql
+}
+
+func (s *SQLTestSuite) addTransaction(
+	txHash string,
+	tunnelID uint64,
+	sequence uint64,
+	chainName string,
+	sender string,
+	status types.TxStatus,
+	balanceDelta int64,
+	latency time.Duration,
+) {
+	packetTimestamp := time.Unix(1_700_000_000+int64(sequence), 0).UTC()
+	blockTimestamp := packetTimestamp.Add(latency)
+
+	tx := db.NewTransaction(
+		txHash,
+		tunnelID,
+		sequence,
+		chainName,
+		types.ChainTypeEVM,
+		sender,
+		status,
+		decimal.NewNullDecimal(decimal.NewFromInt(21000)),
+		decimal.NewNullDecimal(decimal.NewFromInt(1)),
+		decimal.NewNullDecimal(decimal.NewFromInt(balanceDelta)),
+		[]db.SignalPrice{*db.NewSignalPrice("CS:BTC-USD", 100)},
+		&blockTimestamp,
+		&packetTimestamp,
+	)
+	s.Require().NoError(s.db.AddOrUpdateTransaction(tx))
+}
+
+func (s *SQLTestSuite) seed() {
+	s.addTransaction("0x01", 1, 1, "testnet", "0xa", types.TX_STATUS_SUCCESS, -100, 4*time.Second)
+	s.addTransaction("0x02", 1, 2, "testnet", "0xb", types.TX_STATUS_FAILED, -50, 6*time.Second)
+	s.addTransaction("0x03", 1, 2, "testnet", "0xa", types.TX_STATUS_SUCCESS, -100, 8*time.Second)
+	s.addTransaction("0x04", 2, 1, "devnet", "0xa", types.TX_STATUS_PENDING, 0, 0)
+}
+
+func (s *SQLTestSuite) txHashes(txs []db.Transaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, tx.TxHash)
+	}
+	return hashes
+}
+
+func (s *SQLTestSuite) TestGetTransactions() {
+	s.seed()
+
+	tunnelID := uint64(1)
+	testcases := []struct {
+		name   string
+		filter db.TransactionFilter
+		out    []string
+	}{
+		{
+			name:   "no filter",
+			filter: db.TransactionFilter{},
+			out:    []string{"0x04", "0x03", "0x02", "0x01"},
+		},
+		{
+			name:   "by tunnel",
+			filter: db.TransactionFilter{TunnelID: &tunnelID},
+			out:    []string{"0x03", "0x02", "0x01"},
+		},
+		{
+			name:   "by chain",
+			filter: db.TransactionFilter{ChainName: "devnet"},
+			out:    []string{"0x04"},
+		},
+		{
+			name:   "by status",
+			filter: db.TransactionFilter{Status: types.TX_STATUS_SUCCESS},
+			out:    []string{"0x03", "0x01"},
+		},
+		{
+			name:   "by sender",
+			filter: db.TransactionFilter{Sender: "0xb"},
+			out:    []string{"0x02"},
+		},
+		{
+			name:   "with pagination",
+			filter: db.TransactionFilter{Limit: 2, Offset: 1},
+			out:    []string{"0x03", "0x02"},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			txs, err := s.db.GetTransactions(tc.filter)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.out, s.txHashes(txs))
+		})
+	}
+}
+
+func (s *SQLTestSuite) TestGetTransactionsByTimeRange() {
+	s.seed()
+
+	future := time.Now().Add(time.Hour)
+	txs, err := s.db.GetTransactions(db.TransactionFilter{From: &future})
+	s.Require().NoError(err)
+	s.Require().Empty(txs)
+
+	txs, err = s.db.GetTransactions(db.TransactionFilter{To: &future})
+	s.Require().NoError(err)
+	s.Require().Len(txs, 4)
+}
+
+func (s *SQLTestSuite) TestGetTransactionByHash() {
+	s.seed()
+
+	tx, err := s.db.GetTransactionByHash("0x02")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), tx.TunnelID)
+	s.Require().Equal(uint64(2), tx.Sequence)
+	s.Require().Equal(types.TX_STATUS_FAILED, tx.Status)
+	s.Require().Equal("21000", tx.GasUsed.Decimal.String())
+	s.Require().Len(tx.SignalPrices, 1)
+	s.Require().Equal("CS:BTC-USD", tx.SignalPrices[0].SignalID)
+
+	_, err = s.db.GetTransactionByHash("0xff")
+	s.Require().ErrorIs(err, db.ErrTransactionNotFound)
+}
+
+func (s *SQLTestSuite) TestGetTransactionsByTunnelSequence() {
+	s.seed()
+
+	txs, err := s.db.GetTransactionsByTunnelSequence(1, 2)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"0x02", "0x03"}, s.txHashes(txs))
+
+	txs, err = s.db.GetTransactionsByTunnelSequence(1, 3)
+	s.Require().NoError(err)
+	s.Require().Empty(txs)
+}
+
+func (s *SQLTestSuite) TestGetTunnelStats() {
+	s.seed()
+
+	stats, err := s.db.GetTunnelStats(db.TransactionFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(stats, 2)
+
+	s.Require().Equal(uint64(1), stats[0].TunnelID)
+	s.Require().Equal("testnet", stats[0].ChainName)
+	s.Require().Equal(int64(3), stats[0].TotalCount)
+	s.Require().Equal(int64(2), stats[0].SuccessCount)
+	s.Require().Equal(int64(1), stats[0].FailedCount)
+	s.Require().InDelta(2.0/3.0, stats[0].SuccessRate, 1e-9)
+	s.Require().Equal(6*time.Second, stats[0].AverageLatency)
+	s.Require().Equal("63000", stats[0].TotalGasUsed.String())
+	s.Require().Equal("250", stats[0].TotalFee.String())
+
+	s.Require().Equal(uint64(2), stats[1].TunnelID)
+	s.Require().Equal(int64(1), stats[1].PendingCount)
+	s.Require().Zero(stats[1].SuccessRate)
+}
+
+func (s *SQLTestSuite) TestRelayCheckpoint() {
+	checkpoint, err := s.db.GetRelayCheckpoint(1)
+	s.Require().NoError(err)
+	s.Require().Nil(checkpoint)
+
+	relayedAt := time.Unix(1_700_000_000, 0).UTC()
+	s.Require().NoError(s.db.SaveRelayCheckpoint(db.NewRelayCheckpoint(1, 5, "0x05", relayedAt)))
+	s.Require().NoError(s.db.SaveRelayCheckpoint(db.NewRelayCheckpoint(1, 6, "0x06", relayedAt.Add(time.Minute))))
+
+	checkpoint, err = s.db.GetRelayCheckpoint(1)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6), checkpoint.Sequence)
+	s.Require().Equal("0x06", checkpoint.TxHash)
+	s.Require().True(relayedAt.Add(time.Minute).Equal(checkpoint.RelayedAt))
+}
+
+func (s *SQLTestSuite) TestFundingTransactions() {
+	since := time.Now().Add(-time.Hour)
+
+	s.Require().NoError(s.db.AddOrUpdateFundingTransaction(db.NewFundingTransaction(
+		"0x01", "testnet", types.ChainTypeEVM, "0xtreasury", "0xa", decimal.NewFromInt(100), types.TX_STATUS_TIMEOUT,
+	)))
+	s.Require().NoError(s.db.AddOrUpdateFundingTransaction(db.NewFundingTransaction(
+		"0x02", "othernet", types.ChainTypeEVM, "0xtreasury", "0xb", decimal.NewFromInt(200), types.TX_STATUS_SUCCESS,
+	)))
+
+	// the status of an existing funding transaction is updated.
+	s.Require().NoError(s.db.AddOrUpdateFundingTransaction(db.NewFundingTransaction(
+		"0x01", "testnet", types.ChainTypeEVM, "0xtreasury", "0xa", decimal.NewFromInt(100), types.TX_STATUS_SUCCESS,
+	)))
+
+	txs, err := s.db.GetFundingTransactions("testnet", since)
+	s.Require().NoError(err)
+	s.Require().Len(txs, 1)
+	s.Require().Equal("0x01", txs[0].TxHash)
+	s.Require().Equal("0xa", txs[0].Recipient)
+	s.Require().Equal("100", txs[0].Amount.String())
+	s.Require().Equal(types.TX_STATUS_SUCCESS, txs[0].Status)
+
+	txs, err = s.db.GetFundingTransactions("testnet", time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	s.Require().Empty(txs)
+}
+
+func (s *SQLTestSuite) TestClose() {
+	s.Require().NoError(s.db.Ping(context.Background()))
+	s.Require().NoError(s.db.Close())
+	s.Require().Error(s.db.Ping(context.Background()))
+}
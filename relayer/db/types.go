@@ -10,23 +10,23 @@ import (
 
 // Transaction represents transaction information sent to the target chain contract that will be stored in the database.
 type Transaction struct {
-	ID                uint            `gorm:"primarykey"`
-	TxHash            string          `gorm:"unique"`
-	TunnelID          uint64          `gorm:"not null"`
-	Sequence          uint64          `gorm:"not null"`
-	ChainName         string          `gorm:"not null"`
-	ChainType         types.ChainType `gorm:"type:chain_type;not null"`
-	Sender            string
-	Status            types.TxStatus      `gorm:"type:tx_status;not null"`
-	GasUsed           decimal.NullDecimal `gorm:"type:decimal"`
-	EffectiveGasPrice decimal.NullDecimal `gorm:"type:decimal"`
-	BalanceDelta      decimal.NullDecimal `gorm:"type:decimal"`
+	ID                uint                `gorm:"primarykey" json:"id"`
+	TxHash            string              `gorm:"unique" json:"tx_hash"`
+	TunnelID          uint64              `gorm:"not null" json:"tunnel_id"`
+	Sequence          uint64              `gorm:"not null" json:"sequence"`
+	ChainName         string              `gorm:"not null" json:"chain_name"`
+	ChainType         types.ChainType     `gorm:"type:chain_type;not null" json:"chain_type"`
+	Sender            string              `json:"sender"`
+	Status            types.TxStatus      `gorm:"type:tx_status;not null" json:"status"`
+	GasUsed           decimal.NullDecimal `gorm:"type:decimal" json:"gas_used"`
+	EffectiveGasPrice decimal.NullDecimal `gorm:"type:decimal" json:"effective_gas_price"`
+	BalanceDelta      decimal.NullDecimal `gorm:"type:decimal" json:"balance_delta"`
 
-	SignalPrices    []SignalPrice
-	BlockTimestamp  *time.Time `gorm:"default:NULL"`
-	PacketTimestamp *time.Time `gorm:"default:NULL"`
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	SignalPrices    []SignalPrice `json:"signal_prices"`
+	BlockTimestamp  *time.Time    `gorm:"default:NULL" json:"block_timestamp"`
+	PacketTimestamp *time.Time    `gorm:"default:NULL" json:"packet_timestamp"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
 }
 
 // NewTransaction creates a new Transaction instance.
@@ -64,9 +64,9 @@ func NewTransaction(
 
 // SignalPrice represents the price of a signal for a given transaction.
 type SignalPrice struct {
-	TransactionID uint   `gorm:"primarykey"`
-	SignalID      string `gorm:"primarykey"`
-	Price         uint64 `gorm:"not null"`
+	TransactionID uint   `gorm:"primarykey" json:"-"`
+	SignalID      string `gorm:"primarykey" json:"signal_id"`
+	Price         uint64 `gorm:"not null" json:"price"`
 }
 
 // NewSignalPrice creates a new SignalPrice instance.
@@ -79,3 +79,92 @@ func NewSignalPrice(
 		Price:    price,
 	}
 }
+
+// TransactionFilter defines the filter and pagination of the transaction query.
+// Zero-value fields are not applied.
+type TransactionFilter struct {
+	TunnelID  *uint64
+	ChainName string
+	Status    types.TxStatus
+	Sender    string
+	// From and To filter transactions by their creation time, [From, To).
+	From *time.Time
+	To   *time.Time
+
+	Limit  int
+	Offset int
+}
+
+// TunnelStats represents aggregated transaction information of a tunnel on a target chain.
+type TunnelStats struct {
+	TunnelID     uint64
+	ChainName    string
+	TotalCount   int64
+	PendingCount int64
+	SuccessCount int64
+	FailedCount  int64
+	TimeoutCount int64
+	// SuccessRate is the ratio of successful transactions to the finalized (non-pending) ones.
+	SuccessRate float64
+	// AverageLatency is the average duration between the packet creation and the block
+	// including the transaction, of the transactions that have both timestamps, rounded to
+	// milliseconds.
+	AverageLatency time.Duration
+	TotalGasUsed   decimal.Decimal
+	// TotalFee is the total amount spent by the senders, derived from the balance delta.
+	TotalFee decimal.Decimal
+}
+
+// RelayCheckpoint represents the last packet of a tunnel successfully relayed by the relayer.
+type RelayCheckpoint struct {
+	TunnelID  uint64    `gorm:"primarykey;autoIncrement:false" json:"tunnel_id" toml:"tunnel_id"`
+	Sequence  uint64    `gorm:"not null" json:"sequence" toml:"sequence"`
+	TxHash    string    `gorm:"not null" json:"tx_hash" toml:"tx_hash"`
+	RelayedAt time.Time `gorm:"not null" json:"relayed_at" toml:"relayed_at"`
+	UpdatedAt time.Time `json:"-" toml:"-"`
+}
+
+// NewRelayCheckpoint creates a new RelayCheckpoint instance.
+func NewRelayCheckpoint(tunnelID uint64, sequence uint64, txHash string, relayedAt time.Time) *RelayCheckpoint {
+	return &RelayCheckpoint{
+		TunnelID:  tunnelID,
+		Sequence:  sequence,
+		TxHash:    txHash,
+		RelayedAt: relayedAt,
+	}
+}
+
+// FundingTransaction represents a transfer of the native token from the treasury key to a signer.
+type FundingTransaction struct {
+	ID        uint            `gorm:"primarykey" json:"id"`
+	TxHash    string          `gorm:"unique" json:"tx_hash"`
+	ChainName string          `gorm:"not null" json:"chain_name"`
+	ChainType types.ChainType `gorm:"type:chain_type;not null" json:"chain_type"`
+	Sender    string          `gorm:"not null" json:"sender"`
+	Recipient string          `gorm:"not null" json:"recipient"`
+	Amount    decimal.Decimal `gorm:"type:decimal;not null" json:"amount"`
+	Status    types.TxStatus  `gorm:"type:tx_status;not null" json:"status"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// NewFundingTransaction creates a new FundingTransaction instance.
+func NewFundingTransaction(
+	txHash string,
+	chainName string,
+	chainType types.ChainType,
+	sender string,
+	recipient string,
+	amount decimal.Decimal,
+	status types.TxStatus,
+) *FundingTransaction {
+	return &FundingTransaction{
+		TxHash:    txHash,
+		ChainName: chainName,
+		ChainType: chainType,
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		Status:    status,
+	}
+}
@@ -0,0 +1,88 @@
+package relayer
+
+import (
+	"context"
+	"time"
+
+	"github.com/bandprotocol/falcon/relayer/db"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+// GetTunnelRelayer returns the tunnel relayer of the given tunnel ID for testing.
+func (s *Scheduler) GetTunnelRelayer(tunnelID uint64) *TunnelRelayer {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.tunnelRelayers[tunnelID]
+}
+
+// RelayPool is the relay pool of the scheduler exported for testing.
+type RelayPool = relayPool
+
+// NewRelayPool creates a new RelayPool for testing.
+func NewRelayPool(maxRelays int) *RelayPool {
+	return newRelayPool(maxRelays)
+}
+
+// Submit queues the relay of the tunnel for testing.
+func (p *relayPool) Submit(tunnelID uint64, chainName string, chainLimit int, run func()) bool {
+	return p.submit(tunnelID, chainName, chainLimit, run)
+}
+
+// Stop stops the relay pool for testing.
+func (p *relayPool) Stop() bool {
+	return p.stop()
+}
+
+// Wait waits for the running relays for testing.
+func (p *relayPool) Wait() {
+	p.wait()
+}
+
+// DiscoverTunnel adds the tunnel relayer of the unknown tunnel for testing.
+func (s *Scheduler) DiscoverTunnel(ctx context.Context, tunnelID uint64) *TunnelRelayer {
+	return s.discoverTunnel(ctx, tunnelID)
+}
+
+// WaitRelays waits for the running relays of the scheduler for testing.
+func (s *Scheduler) WaitRelays() {
+	s.relayPool.wait()
+}
+
+// SigningWaiter is the signing waiter of the scheduler exported for testing.
+type SigningWaiter = signingWaiter
+
+// NewSigningWaiter creates a new SigningWaiter for testing.
+func NewSigningWaiter(log logger.Logger) *SigningWaiter {
+	return newSigningWaiter(log, time.Minute, nil)
+}
+
+// Wait waits for the signing of the given ID for testing.
+func (w *signingWaiter) Wait(signingID uint64) (<-chan struct{}, func()) {
+	return w.wait(signingID)
+}
+
+// Notify notifies the signing of the given ID for testing.
+func (w *signingWaiter) Notify(signingID uint64) {
+	w.notify(signingID)
+}
+
+// LockRelay locks the tunnel relayer as if it is relaying, for testing.
+func (t *TunnelRelayer) LockRelay() {
+	t.mu.Lock()
+}
+
+// UnlockRelay unlocks the tunnel relayer locked by LockRelay, for testing.
+func (t *TunnelRelayer) UnlockRelay() {
+	t.mu.Unlock()
+}
+
+// ReconcilePendingTransactions reconciles the pending transactions created before the given time for testing.
+func (a *App) ReconcilePendingTransactions(ctx context.Context, database db.Database, before time.Time) {
+	a.reconcilePendingTransactions(ctx, database, before)
+}
+
+// RegisterHealthChecks registers the health checks of the BandChain client and the database for testing.
+func (a *App) RegisterHealthChecks(database db.Database) {
+	a.registerHealthChecks(database)
+}
@@ -0,0 +1,150 @@
+package relayer
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	feedstypes "github.com/bandprotocol/falcon/internal/bandchain/feeds"
+	tsstypes "github.com/bandprotocol/falcon/internal/bandchain/tss"
+	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
+	"github.com/bandprotocol/falcon/relayer/types"
+	"github.com/bandprotocol/falcon/relayer/wallet"
+)
+
+// fixedPointDecimals is the number of decimals of a fixed-point price.
+const fixedPointDecimals = 9
+
+// decodePacket decodes the TSS message of the packet by the given encoder of its tunnel, and
+// cross-checks the decoded packet against the packet on BandChain. The differences are listed
+// in the mismatches of the decoded packet.
+func decodePacket(packet *bandtypes.Packet, encoder feedstypes.Encoder) (*types.DecodedPacket, error) {
+	if encoder != feedstypes.ENCODER_FIXED_POINT_ABI && encoder != feedstypes.ENCODER_TICK_ABI {
+		return nil, fmt.Errorf("unsupported encoder: %s", encoder)
+	}
+
+	// decode the signing relayed to the target chain
+	signing := packet.CurrentGroupSigning
+	if signing == nil || signing.SigningStatus == tsstypes.SIGNING_STATUS_FALLEN {
+		signing = packet.IncomingGroupSigning
+	}
+	if signing == nil {
+		return nil, fmt.Errorf("missing signing")
+	}
+
+	payload := wallet.NewTssPayload(signing.Message, nil, nil)
+	originatorHash, err := payload.OriginatorHash()
+	if err != nil {
+		return nil, err
+	}
+
+	tssPacket, err := payload.ToTSSPacket()
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := make([]string, 0)
+	messageEncoder, err := payload.Encoder()
+	if err != nil {
+		mismatches = append(mismatches, err.Error())
+	} else if messageEncoder != encoder {
+		mismatches = append(
+			mismatches,
+			fmt.Sprintf("message is encoded by %s instead of %s of the tunnel", messageEncoder, encoder),
+		)
+	}
+
+	if tssPacket.Sequence != packet.Sequence {
+		mismatches = append(
+			mismatches,
+			fmt.Sprintf("sequence %d differs from %d of the packet", tssPacket.Sequence, packet.Sequence),
+		)
+	}
+
+	if tssPacket.CreatedAt != packet.CreatedAt {
+		mismatches = append(
+			mismatches,
+			fmt.Sprintf("timestamp %d differs from %d of the packet", tssPacket.CreatedAt, packet.CreatedAt),
+		)
+	}
+
+	if len(tssPacket.RelayPrices) != len(packet.SignalPrices) {
+		mismatches = append(
+			mismatches,
+			fmt.Sprintf(
+				"%d signal prices differ from %d of the packet",
+				len(tssPacket.RelayPrices),
+				len(packet.SignalPrices),
+			),
+		)
+	}
+
+	signalPrices := make([]types.DecodedSignalPrice, 0, len(tssPacket.RelayPrices))
+	for i, relayPrice := range tssPacket.RelayPrices {
+		signalID := wallet.Bytes32ToString(relayPrice.SignalID)
+		signalPrices = append(
+			signalPrices,
+			types.NewDecodedSignalPrice(signalID, relayPrice.Price, formatPrice(encoder, relayPrice.Price)),
+		)
+
+		if i >= len(packet.SignalPrices) {
+			continue
+		}
+
+		signalPrice := packet.SignalPrices[i]
+		if signalPrice.SignalID != signalID {
+			mismatches = append(
+				mismatches,
+				fmt.Sprintf("signal %s differs from %s of the packet", signalID, signalPrice.SignalID),
+			)
+		} else if !isSamePrice(encoder, relayPrice.Price, signalPrice.Price) {
+			mismatches = append(
+				mismatches,
+				fmt.Sprintf(
+					"price %d of signal %s does not match %d of the packet",
+					relayPrice.Price,
+					signalID,
+					signalPrice.Price,
+				),
+			)
+		}
+	}
+
+	return &types.DecodedPacket{
+		TunnelID:       packet.TunnelID,
+		SigningID:      signing.ID,
+		Encoder:        encoder.String(),
+		OriginatorHash: hex.EncodeToString(originatorHash),
+		Sequence:       tssPacket.Sequence,
+		SignalPrices:   signalPrices,
+		CreatedAt:      time.Unix(tssPacket.CreatedAt, 0).UTC(),
+		Mismatches:     mismatches,
+	}, nil
+}
+
+// formatPrice returns the human-readable price of the encoded price of the given encoder.
+func formatPrice(encoder feedstypes.Encoder, encodedPrice uint64) string {
+	if encoder == feedstypes.ENCODER_TICK_ABI {
+		return decimal.NewFromFloat(wallet.TickToPrice(encodedPrice)).Round(fixedPointDecimals).String()
+	}
+
+	return decimal.NewFromBigInt(new(big.Int).SetUint64(encodedPrice), -fixedPointDecimals).String()
+}
+
+// isSamePrice checks if the encoded price of the given encoder matches the fixed-point price of
+// the packet. A tick may differ by one from the tick of the price due to floating-point errors.
+func isSamePrice(encoder feedstypes.Encoder, encodedPrice uint64, price uint64) bool {
+	if encoder != feedstypes.ENCODER_TICK_ABI || price == 0 {
+		return encodedPrice == price
+	}
+
+	tick, err := wallet.FixedPointToTick(price)
+	if err != nil {
+		return false
+	}
+
+	return max(tick, encodedPrice)-min(tick, encodedPrice) <= 1
+}
@@ -0,0 +1,6 @@
+package penalty
+
+// NewPolicyWithRand creates a Policy with the given source of random numbers for testing.
+func NewPolicyWithRand(overrides map[Class]BackoffConfig, rand func() float64) *Policy {
+	return newPolicy(overrides, rand)
+}
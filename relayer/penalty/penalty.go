@@ -0,0 +1,186 @@
+package penalty
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// Class is the class of the error that failed a tunnel relayer, which determines its penalty.
+type Class string
+
+const (
+	// ClassTransient is a temporary failure of an RPC endpoint or the database.
+	ClassTransient Class = "transient"
+	// ClassSigningNotReady is a packet whose signing on BandChain is not successful.
+	ClassSigningNotReady Class = "signing_not_ready"
+	// ClassInsufficientFunds is a signer without enough funds to pay for the transaction.
+	ClassInsufficientFunds Class = "insufficient_funds"
+	// ClassContractRevert is a transaction reverted by the target contract.
+	ClassContractRevert Class = "contract_revert"
+	// ClassConfig is an invalid configuration of the tunnel or the target chain.
+	ClassConfig Class = "config"
+	// ClassUnprofitable is a relay skipped because its gas cost exceeds the reimbursement.
+	ClassUnprofitable Class = "unprofitable"
+)
+
+// errorMsgs are the lowercase error messages of the target chains, by the class they belong to.
+var errorMsgs = []struct {
+	class Class
+	msgs  []string
+}{
+	{class: ClassInsufficientFunds, msgs: []string{"insufficient funds", "insufficient balance", "tecunfunded", "tecinsuff"}},
+	{class: ClassContractRevert, msgs: []string{"reverted"}},
+	{
+		class: ClassConfig,
+		msgs:  []string{"invalid address", "incorrect address", "invalid target address", "unsupported", "has no keys"},
+	},
+}
+
+// Error is an error with the class of its penalty.
+type Error struct {
+	Class Class
+	Err   error
+}
+
+// NewError wraps the given error with the class of its penalty.
+func NewError(class Class, err error) error {
+	return &Error{Class: class, Err: err}
+}
+
+// Error returns the message of the wrapped error.
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Classify returns the class of the given error. The class of an Error in the chain takes
+// precedence; otherwise, the error is classified by its message and defaults to ClassTransient.
+func Classify(err error) Class {
+	var penaltyErr *Error
+	if errors.As(err, &penaltyErr) {
+		return penaltyErr.Class
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, e := range errorMsgs {
+		for _, m := range e.msgs {
+			if strings.Contains(msg, m) {
+				return e.class
+			}
+		}
+	}
+
+	return ClassTransient
+}
+
+// BackoffConfig defines the exponential backoff of the penalty of an error class.
+type BackoffConfig struct {
+	// InitialBackoff is the backoff after the first failure.
+	InitialBackoff time.Duration `mapstructure:"initial_backoff" toml:"initial_backoff"`
+	// MaxBackoff caps the backoff, including the jitter.
+	MaxBackoff time.Duration `mapstructure:"max_backoff" toml:"max_backoff"`
+	// Multiplier is the growth of the backoff after each consecutive failure.
+	Multiplier float64 `mapstructure:"multiplier" toml:"multiplier"`
+	// Jitter is the fraction of the backoff that is randomly added or removed, from 0 to 1.
+	Jitter float64 `mapstructure:"jitter" toml:"jitter"`
+}
+
+// DefaultBackoffConfigs returns the default backoff of each error class.
+func DefaultBackoffConfigs() map[Class]BackoffConfig {
+	return map[Class]BackoffConfig{
+		ClassTransient: {
+			InitialBackoff: 10 * time.Second,
+			MaxBackoff:     5 * time.Minute,
+			Multiplier:     2,
+			Jitter:         0.2,
+		},
+		ClassSigningNotReady: {
+			InitialBackoff: 5 * time.Second,
+			MaxBackoff:     time.Minute,
+			Multiplier:     2,
+			Jitter:         0.2,
+		},
+		ClassInsufficientFunds: {
+			InitialBackoff: 5 * time.Minute,
+			MaxBackoff:     time.Hour,
+			Multiplier:     2,
+			Jitter:         0.2,
+		},
+		ClassContractRevert: {
+			InitialBackoff: time.Minute,
+			MaxBackoff:     30 * time.Minute,
+			Multiplier:     2,
+			Jitter:         0.2,
+		},
+		ClassConfig: {
+			InitialBackoff: 10 * time.Minute,
+			MaxBackoff:     time.Hour,
+			Multiplier:     2,
+			Jitter:         0.2,
+		},
+		ClassUnprofitable: {
+			InitialBackoff: time.Minute,
+			MaxBackoff:     15 * time.Minute,
+			Multiplier:     2,
+			Jitter:         0.2,
+		},
+	}
+}
+
+// Policy computes the penalty backoff of the failing tunnel relayers by error class.
+type Policy struct {
+	backoffs map[Class]BackoffConfig
+	rand     func() float64
+}
+
+// NewPolicy creates a new Policy from the default backoffs, overridden by the non-zero fields
+// of the given backoffs.
+func NewPolicy(overrides map[Class]BackoffConfig) *Policy {
+	return newPolicy(overrides, rand.Float64)
+}
+
+// newPolicy creates a new Policy with the given source of random numbers in [0, 1).
+func newPolicy(overrides map[Class]BackoffConfig, rand func() float64) *Policy {
+	backoffs := DefaultBackoffConfigs()
+	for class, override := range overrides {
+		cfg := backoffs[class]
+		if override.InitialBackoff > 0 {
+			cfg.InitialBackoff = override.InitialBackoff
+		}
+		if override.MaxBackoff > 0 {
+			cfg.MaxBackoff = override.MaxBackoff
+		}
+		if override.Multiplier > 0 {
+			cfg.Multiplier = override.Multiplier
+		}
+		if override.Jitter > 0 {
+			cfg.Jitter = override.Jitter
+		}
+		backoffs[class] = cfg
+	}
+
+	return &Policy{backoffs: backoffs, rand: rand}
+}
+
+// Backoff returns the backoff after the given number of consecutive failures of the class.
+func (p *Policy) Backoff(class Class, failures int) time.Duration {
+	cfg, ok := p.backoffs[class]
+	if !ok {
+		cfg = p.backoffs[ClassTransient]
+	}
+
+	backoff := float64(cfg.InitialBackoff) * math.Pow(cfg.Multiplier, float64(max(failures-1, 0)))
+	backoff *= 1 + cfg.Jitter*(2*p.rand()-1)
+	if cfg.MaxBackoff > 0 && backoff > float64(cfg.MaxBackoff) {
+		return cfg.MaxBackoff
+	}
+
+	return time.Duration(backoff)
+}
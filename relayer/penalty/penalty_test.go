@@ -0,0 +1,75 @@
+package penalty_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/relayer/penalty"
+)
+
+func TestClassify(t *testing.T) {
+	testcases := []struct {
+		name string
+		err  error
+		out  penalty.Class
+	}{
+		{
+			name: "classified error",
+			err:  fmt.Errorf("get packet: %w", penalty.NewError(penalty.ClassSigningNotReady, fmt.Errorf("failed"))),
+			out:  penalty.ClassSigningNotReady,
+		},
+		{
+			name: "insufficient funds",
+			err:  fmt.Errorf("broadcast tx error: insufficient funds for gas * price + value"),
+			out:  penalty.ClassInsufficientFunds,
+		},
+		{
+			name: "contract revert",
+			err:  fmt.Errorf("failed to relay packet after 3 retries: execution reverted"),
+			out:  penalty.ClassContractRevert,
+		},
+		{
+			name: "config error",
+			err:  fmt.Errorf("[EVMProvider] invalid address: 0x"),
+			out:  penalty.ClassConfig,
+		},
+		{
+			name: "unknown error",
+			err:  fmt.Errorf("connection refused"),
+			out:  penalty.ClassTransient,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.out, penalty.Classify(tc.err))
+		})
+	}
+}
+
+func TestPolicyBackoff(t *testing.T) {
+	// no jitter with the random number in the middle of the range.
+	policy := penalty.NewPolicyWithRand(map[penalty.Class]penalty.BackoffConfig{
+		penalty.ClassTransient: {InitialBackoff: time.Second, MaxBackoff: 10 * time.Second},
+	}, func() float64 { return 0.5 })
+
+	require.Equal(t, time.Second, policy.Backoff(penalty.ClassTransient, 1))
+	require.Equal(t, 2*time.Second, policy.Backoff(penalty.ClassTransient, 2))
+	require.Equal(t, 8*time.Second, policy.Backoff(penalty.ClassTransient, 4))
+	require.Equal(t, 10*time.Second, policy.Backoff(penalty.ClassTransient, 5))
+	require.Equal(t, 5*time.Minute, policy.Backoff(penalty.ClassInsufficientFunds, 1))
+
+	// the jitter adds or removes up to its fraction of the backoff, within the cap.
+	policy = penalty.NewPolicyWithRand(map[penalty.Class]penalty.BackoffConfig{
+		penalty.ClassTransient: {InitialBackoff: time.Second, MaxBackoff: 10 * time.Second, Jitter: 0.5},
+	}, func() float64 { return 0 })
+	require.Equal(t, 500*time.Millisecond, policy.Backoff(penalty.ClassTransient, 1))
+
+	policy = penalty.NewPolicyWithRand(map[penalty.Class]penalty.BackoffConfig{
+		penalty.ClassTransient: {InitialBackoff: 8 * time.Second, MaxBackoff: 10 * time.Second, Jitter: 0.5},
+	}, func() float64 { return 0.99 })
+	require.Equal(t, 10*time.Second, policy.Backoff(penalty.ClassTransient, 1))
+}
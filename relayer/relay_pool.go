@@ -0,0 +1,149 @@
+package relayer
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/bandprotocol/falcon/internal/relayermetrics"
+)
+
+// relayTask is a queued relay of a tunnel relayer.
+type relayTask struct {
+	tunnelID  uint64
+	chainName string
+	run       func()
+}
+
+// relayPool runs the queued relays with a global and a per-chain limit of concurrent relays.
+// The chains are served in turn, so that a chain with many tunnels does not starve the others,
+// and a tunnel is queued at most once at a time.
+type relayPool struct {
+	maxRelays int
+
+	mu             sync.Mutex
+	queues         map[string][]relayTask
+	chainOrder     []string
+	chainLimits    map[string]int
+	queuedTunnels  map[uint64]bool
+	running        int
+	runningByChain map[string]int
+	isStopped      bool
+	wg             sync.WaitGroup
+}
+
+// newRelayPool creates a new relayPool running at most maxRelays relays at once, 0 for no limit.
+func newRelayPool(maxRelays int) *relayPool {
+	return &relayPool{
+		maxRelays:      maxRelays,
+		queues:         make(map[string][]relayTask),
+		chainLimits:    make(map[string]int),
+		queuedTunnels:  make(map[uint64]bool),
+		runningByChain: make(map[string]int),
+	}
+}
+
+// submit queues the relay of the tunnel targeting the given chain, which runs at most chainLimit
+// relays at once, 0 for no limit. It returns false if the tunnel is already queued or the pool
+// is stopped.
+func (p *relayPool) submit(tunnelID uint64, chainName string, chainLimit int, run func()) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.isStopped || p.queuedTunnels[tunnelID] {
+		return false
+	}
+
+	if len(p.queues[chainName]) == 0 {
+		p.chainOrder = append(p.chainOrder, chainName)
+	}
+	p.queues[chainName] = append(p.queues[chainName], relayTask{tunnelID: tunnelID, chainName: chainName, run: run})
+	p.queuedTunnels[tunnelID] = true
+	p.chainLimits[chainName] = chainLimit
+	relayermetrics.SetRelayQueueDepth(chainName, len(p.queues[chainName]))
+
+	p.dispatch()
+
+	return true
+}
+
+// dispatch starts the queued relays within the limits, taking one relay of each chain in turn.
+// The caller must hold p.mu.
+func (p *relayPool) dispatch() {
+	for p.maxRelays <= 0 || p.running < p.maxRelays {
+		idx := slices.IndexFunc(p.chainOrder, p.canRun)
+		if idx < 0 {
+			return
+		}
+
+		chainName := p.chainOrder[idx]
+		task := p.queues[chainName][0]
+		p.queues[chainName] = p.queues[chainName][1:]
+
+		// move the chain to the back, so that the other chains are served first
+		p.chainOrder = slices.Delete(p.chainOrder, idx, idx+1)
+		if len(p.queues[chainName]) > 0 {
+			p.chainOrder = append(p.chainOrder, chainName)
+		} else {
+			delete(p.queues, chainName)
+		}
+
+		delete(p.queuedTunnels, task.tunnelID)
+		relayermetrics.SetRelayQueueDepth(chainName, len(p.queues[chainName]))
+
+		p.running++
+		p.runningByChain[chainName]++
+		p.wg.Add(1)
+		go p.run(task)
+	}
+}
+
+// canRun checks if a relay of the given chain can be started within the chain limit. The caller
+// must hold p.mu.
+func (p *relayPool) canRun(chainName string) bool {
+	chainLimit := p.chainLimits[chainName]
+	return chainLimit <= 0 || p.runningByChain[chainName] < chainLimit
+}
+
+// run runs the relay and starts the next queued relays once it is done.
+func (p *relayPool) run(task relayTask) {
+	defer p.wg.Done()
+
+	task.run()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.running--
+	p.runningByChain[task.chainName]--
+	if p.runningByChain[task.chainName] == 0 {
+		delete(p.runningByChain, task.chainName)
+	}
+
+	p.dispatch()
+}
+
+// stop drops the queued relays and stops accepting new ones. It returns false if the pool is
+// already stopped.
+func (p *relayPool) stop() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.isStopped {
+		return false
+	}
+	p.isStopped = true
+
+	for chainName := range p.queues {
+		relayermetrics.SetRelayQueueDepth(chainName, 0)
+	}
+	clear(p.queues)
+	clear(p.queuedTunnels)
+	p.chainOrder = nil
+
+	return true
+}
+
+// wait waits for the running relays to be done.
+func (p *relayPool) wait() {
+	p.wg.Wait()
+}
@@ -0,0 +1,146 @@
+package relayer_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bandprotocol/falcon/relayer"
+)
+
+// relayRecorder records the order and the concurrency of the relays run by the relay pool.
+// Every relay blocks until releaseCh is closed.
+type relayRecorder struct {
+	mu            sync.Mutex
+	started       []uint64
+	running       map[string]int
+	maxRunning    int
+	maxChainCount map[string]int
+
+	startedCh chan uint64
+	releaseCh chan struct{}
+}
+
+func newRelayRecorder() *relayRecorder {
+	return &relayRecorder{
+		running:       make(map[string]int),
+		maxChainCount: make(map[string]int),
+		startedCh:     make(chan uint64, 100),
+		releaseCh:     make(chan struct{}),
+	}
+}
+
+func (r *relayRecorder) relay(tunnelID uint64, chainName string) func() {
+	return func() {
+		r.mu.Lock()
+		r.started = append(r.started, tunnelID)
+		r.running[chainName]++
+		total := 0
+		for _, count := range r.running {
+			total += count
+		}
+		r.maxRunning = max(r.maxRunning, total)
+		r.maxChainCount[chainName] = max(r.maxChainCount[chainName], r.running[chainName])
+		r.mu.Unlock()
+
+		r.startedCh <- tunnelID
+		<-r.releaseCh
+
+		r.mu.Lock()
+		r.running[chainName]--
+		r.mu.Unlock()
+	}
+}
+
+// waitStarted waits for the given number of relays to be started.
+func (r *relayRecorder) waitStarted(t *testing.T, count int) {
+	for i := 0; i < count; i++ {
+		select {
+		case <-r.startedCh:
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "relay is not started")
+		}
+	}
+}
+
+func TestRelayPoolLimits(t *testing.T) {
+	pool := relayer.NewRelayPool(3)
+	recorder := newRelayRecorder()
+
+	for tunnelID := uint64(1); tunnelID <= 4; tunnelID++ {
+		require.True(t, pool.Submit(tunnelID, "chain_a", 2, recorder.relay(tunnelID, "chain_a")))
+	}
+	for tunnelID := uint64(5); tunnelID <= 8; tunnelID++ {
+		require.True(t, pool.Submit(tunnelID, "chain_b", 0, recorder.relay(tunnelID, "chain_b")))
+	}
+
+	// the pool is saturated by the global limit.
+	recorder.waitStarted(t, 3)
+	require.Never(t, func() bool { return len(recorder.startedCh) > 0 }, 50*time.Millisecond, 10*time.Millisecond)
+
+	close(recorder.releaseCh)
+	recorder.waitStarted(t, 5)
+	pool.Wait()
+
+	require.Len(t, recorder.started, 8)
+	require.Equal(t, 3, recorder.maxRunning)
+	require.Equal(t, 2, recorder.maxChainCount["chain_a"])
+}
+
+func TestRelayPoolFairQueueing(t *testing.T) {
+	pool := relayer.NewRelayPool(1)
+	recorder := newRelayRecorder()
+
+	// tunnel 1 is running while the others are queued.
+	require.True(t, pool.Submit(1, "chain_a", 0, recorder.relay(1, "chain_a")))
+	require.True(t, pool.Submit(2, "chain_a", 0, recorder.relay(2, "chain_a")))
+	require.True(t, pool.Submit(3, "chain_a", 0, recorder.relay(3, "chain_a")))
+	require.True(t, pool.Submit(4, "chain_b", 0, recorder.relay(4, "chain_b")))
+	require.True(t, pool.Submit(5, "chain_b", 0, recorder.relay(5, "chain_b")))
+
+	close(recorder.releaseCh)
+	recorder.waitStarted(t, 5)
+	pool.Wait()
+
+	// the chains are served in turn.
+	require.Equal(t, []uint64{1, 2, 4, 3, 5}, recorder.started)
+}
+
+func TestRelayPoolDeduplicatesQueuedTunnels(t *testing.T) {
+	pool := relayer.NewRelayPool(1)
+	recorder := newRelayRecorder()
+
+	require.True(t, pool.Submit(1, "chain_a", 0, recorder.relay(1, "chain_a")))
+	require.True(t, pool.Submit(2, "chain_a", 0, recorder.relay(2, "chain_a")))
+
+	// tunnel 2 is already queued, while tunnel 1 is running and can be queued again.
+	require.False(t, pool.Submit(2, "chain_a", 0, recorder.relay(2, "chain_a")))
+	require.True(t, pool.Submit(1, "chain_a", 0, recorder.relay(1, "chain_a")))
+
+	close(recorder.releaseCh)
+	recorder.waitStarted(t, 3)
+	pool.Wait()
+
+	require.Equal(t, []uint64{1, 2, 1}, recorder.started)
+}
+
+func TestRelayPoolStop(t *testing.T) {
+	pool := relayer.NewRelayPool(1)
+	recorder := newRelayRecorder()
+
+	require.True(t, pool.Submit(1, "chain_a", 0, recorder.relay(1, "chain_a")))
+	require.True(t, pool.Submit(2, "chain_a", 0, recorder.relay(2, "chain_a")))
+
+	// the queued relays are dropped and no new relay is accepted.
+	require.True(t, pool.Stop())
+	require.False(t, pool.Stop())
+	require.False(t, pool.Submit(3, "chain_a", 0, recorder.relay(3, "chain_a")))
+
+	close(recorder.releaseCh)
+	recorder.waitStarted(t, 1)
+	pool.Wait()
+
+	require.Equal(t, []uint64{1}, recorder.started)
+}
@@ -0,0 +1,249 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"slices"
+	"syscall"
+
+	"github.com/bandprotocol/falcon/internal/relayeradmin"
+	"github.com/bandprotocol/falcon/internal/relayerhealth"
+	"github.com/bandprotocol/falcon/relayer/chains"
+	"github.com/bandprotocol/falcon/relayer/config"
+	"github.com/bandprotocol/falcon/relayer/db"
+)
+
+// runningChain is a target chain provider started by the app, with the routines watching and
+// topping up its signers. They are all stopped by cancelling the context of the chain.
+type runningChain struct {
+	provider chains.ChainProvider
+	ctx      context.Context
+	cancel   context.CancelFunc
+	watcher  *chains.SignerBalanceWatcher
+	topUp    *chains.SignerTopUp
+}
+
+// prepareTargetChain initializes the chain provider of the given chain and prepares the
+// routines of its signers, without starting them.
+func (a *App) prepareTargetChain(
+	ctx context.Context,
+	chainName string,
+	chainProvider chains.ChainProvider,
+	chainConfigs config.ChainProviderConfigs,
+	database db.Database,
+) (*runningChain, error) {
+	chainCtx, cancel := context.WithCancel(ctx)
+	if err := chainProvider.Init(chainCtx); err != nil {
+		cancel()
+		a.Log.Error("Cannot initialize chain provider",
+			"chain_name", chainName,
+			err,
+		)
+		return nil, err
+	}
+
+	chainProvider.SetDatabase(database)
+
+	chainCfg := getBaseChainConfig(chainConfigs, chainName)
+	rc := &runningChain{
+		provider: chainProvider,
+		ctx:      chainCtx,
+		cancel:   cancel,
+		watcher:  chains.NewSignerBalanceWatcher(a.Log, chainProvider, a.Alert, chainCfg),
+	}
+
+	// top up the signers from the treasury key if it is configured
+	if chainCfg.TreasuryKey != "" {
+		topUp, err := chains.NewSignerTopUp(a.Log, chainProvider, database, a.Alert, chainCfg)
+		if err != nil {
+			cancel()
+			a.Log.Error("Cannot start signer top-up",
+				"chain_name", chainName,
+				err,
+			)
+			return nil, err
+		}
+
+		rc.topUp = topUp
+	}
+
+	return rc, nil
+}
+
+// runTargetChain starts the routines of the prepared chain and registers its health check.
+func (a *App) runTargetChain(chainName string, rc *runningChain) {
+	go rc.watcher.Start(rc.ctx)
+	if rc.topUp != nil {
+		go rc.topUp.Start(rc.ctx)
+	}
+
+	relayerhealth.RegisterReadiness(targetChainHealthName(chainName), relayerhealth.CheckerFunc(
+		func(context.Context) relayerhealth.ComponentHealth { return rc.provider.GetHealth() },
+	))
+
+	if a.runningChains == nil {
+		a.runningChains = make(map[string]*runningChain)
+	}
+	a.runningChains[chainName] = rc
+	a.TargetChains[chainName] = rc.provider
+}
+
+// stopTargetChain stops the running chain provider of the given chain and its routines.
+func (a *App) stopTargetChain(chainName string) {
+	rc, ok := a.runningChains[chainName]
+	if !ok {
+		return
+	}
+
+	rc.cancel()
+	relayerhealth.Unregister(targetChainHealthName(chainName))
+	delete(a.runningChains, chainName)
+	delete(a.TargetChains, chainName)
+}
+
+// Reload reloads the target chains from the config file into the running relayer. The chain
+// providers of the added chains are started and the ones of the removed chains are stopped
+// once their in-flight relays are done. The updated chains get a new chain provider that
+// replaces the running one in their tunnel relayers, which keep their state, once none of
+// them is relaying. The changes of the other sections of the config require a restart.
+func (a *App) Reload() (config.TargetChainsDiff, error) {
+	a.reloadMu.Lock()
+	defer a.reloadMu.Unlock()
+
+	if a.scheduler == nil {
+		return config.TargetChainsDiff{}, fmt.Errorf("relayer is not started")
+	}
+
+	newCfg, err := a.Store.GetConfig()
+	if err != nil {
+		return config.TargetChainsDiff{}, err
+	}
+	if newCfg == nil {
+		return config.TargetChainsDiff{}, fmt.Errorf("config is not initialized")
+	}
+
+	a.warnRestartRequired(newCfg)
+
+	diff := config.DiffTargetChains(a.Config.TargetChains, newCfg.TargetChains)
+	if diff.IsEmpty() {
+		a.Log.Info("No target chain changes to reload")
+		return diff, nil
+	}
+
+	// start the chain providers of the added and updated chains first, so that the running
+	// relayer is left untouched if any of them fails.
+	startingChains := slices.Concat(diff.Added, diff.Updated)
+	preparedChains := make(map[string]*runningChain, len(startingChains))
+	for _, chainName := range startingChains {
+		rc, err := a.prepareReloadedTargetChain(chainName, newCfg.TargetChains)
+		if err != nil {
+			for _, rc := range preparedChains {
+				rc.cancel()
+			}
+			return config.TargetChainsDiff{}, fmt.Errorf("failed to reload chain %s: %w", chainName, err)
+		}
+
+		preparedChains[chainName] = rc
+	}
+
+	for _, chainName := range diff.Removed {
+		a.scheduler.RemoveChainProvider(chainName)
+		a.stopTargetChain(chainName)
+	}
+
+	for _, chainName := range startingChains {
+		rc := preparedChains[chainName]
+		a.scheduler.SetChainProvider(chainName, rc.provider, newCfg.TargetChains[chainName])
+		a.stopTargetChain(chainName)
+		a.runTargetChain(chainName, rc)
+	}
+
+	a.Config.TargetChains = newCfg.TargetChains
+	a.Log.Info(
+		"Reloaded target chains",
+		"added", diff.Added,
+		"removed", diff.Removed,
+		"updated", diff.Updated,
+	)
+
+	return diff, nil
+}
+
+// reloadOnSignal reloads the config of the running relayer on every SIGHUP, until the context
+// is done.
+func (a *App) reloadOnSignal(ctx context.Context) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	defer signal.Stop(sigCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sigCh:
+			a.Log.Info("Received SIGHUP, reloading the config")
+			if _, err := a.Reload(); err != nil {
+				a.Log.Error("Failed to reload the config", err)
+			}
+		}
+	}
+}
+
+// prepareReloadedTargetChain creates and prepares the chain provider of the given chain from
+// the reloaded config.
+func (a *App) prepareReloadedTargetChain(
+	chainName string,
+	chainConfigs config.ChainProviderConfigs,
+) (*runningChain, error) {
+	chainProvider, err := a.newChainProvider(chainName, chainConfigs[chainName])
+	if err != nil {
+		return nil, err
+	}
+
+	return a.prepareTargetChain(a.runCtx, chainName, chainProvider, chainConfigs, a.database)
+}
+
+// warnRestartRequired logs the sections of the reloaded config, other than the target chains,
+// that are changed but cannot be applied to the running relayer.
+func (a *App) warnRestartRequired(newCfg *config.Config) {
+	sections := []struct {
+		name      string
+		isChanged bool
+	}{
+		{name: "global", isChanged: !reflect.DeepEqual(a.Config.Global, newCfg.Global)},
+		{name: "bandchain", isChanged: !reflect.DeepEqual(a.Config.BandChain, newCfg.BandChain)},
+		{name: "alert", isChanged: !reflect.DeepEqual(a.Config.Alert, newCfg.Alert)},
+	}
+
+	for _, section := range sections {
+		if section.isChanged {
+			a.Log.Warn("Config changes of the section require a restart to take effect", "section", section.name)
+		}
+	}
+}
+
+// targetChainHealthName returns the name of the health check of the given target chain.
+func targetChainHealthName(chainName string) string {
+	return "target_chain:" + chainName
+}
+
+// adminController exposes the scheduler and the config reload of the app to the admin API.
+type adminController struct {
+	*Scheduler
+	app *App
+}
+
+var _ relayeradmin.Controller = adminController{}
+
+// Reload reloads the target chains from the config file into the running relayer.
+func (c adminController) Reload() (relayeradmin.ReloadResult, error) {
+	diff, err := c.app.Reload()
+	if err != nil {
+		return relayeradmin.ReloadResult{}, err
+	}
+
+	return relayeradmin.ReloadResult{Added: diff.Added, Removed: diff.Removed, Updated: diff.Updated}, nil
+}
@@ -1,9 +1,15 @@
 package relayer
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
 	"time"
 
+	"github.com/bandprotocol/falcon/internal/relayeradmin"
 	"github.com/bandprotocol/falcon/internal/relayermetrics"
 	"github.com/bandprotocol/falcon/relayer/alert"
 	"github.com/bandprotocol/falcon/relayer/band"
@@ -11,26 +17,59 @@ import (
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	"github.com/bandprotocol/falcon/relayer/config"
+	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/penalty"
 )
 
+// swapChainProviderInterval is the interval of checking whether the in-flight relays of a chain
+// are done before swapping its chain provider.
+const swapChainProviderInterval = 100 * time.Millisecond
+
 // Scheduler is a struct to manage all tunnel relayers
 type Scheduler struct {
 	Log                    logger.Logger
 	CheckingPacketInterval time.Duration
 	SyncTunnelsInterval    time.Duration
-	PenaltySkipRounds      uint
 	SubscriptionTimeout    time.Duration
+	CatchUpWindow          time.Duration
+	SigningMaxWait         time.Duration
+	ShutdownGracePeriod    time.Duration
+	PenaltyPolicy          *penalty.Policy
 
-	BandClient     band.Client
-	ChainProviders chains.ChainProviders
+	BandClient         band.Client
+	ChainProviders     chains.ChainProviders
+	TargetChainConfigs config.ChainProviderConfigs
+	CheckpointStore    db.CheckpointStore
 
 	Alert alert.Alert
 
-	relayTunnelIDCh  chan uint64
-	tunnelRelayers   map[uint64]*TunnelRelayer
-	bandLatestTunnel int
-	tunnelCreator    string
+	relayTunnelIDCh chan uint64
+	tunnelRelayers  map[uint64]*TunnelRelayer
+	tunnelCreator   string
+	isSyncTunnels   bool
+
+	// tunnels are the tunnels of the tunnel relayers as last synchronized from BandChain.
+	tunnels map[uint64]bandtypes.Tunnel
+
+	// mu guards the tunnel relayers, their penalties, the chain providers and the paused
+	// tunnels and chains, which are also accessed by the admin API and on config reload.
+	mu            sync.RWMutex
+	pausedTunnels map[uint64]bool
+	pausedChains  map[string]bool
+	syncTunnelsCh chan struct{}
+
+	// swappingChains are the chains whose chain provider is being replaced, which are paused
+	// until their in-flight relays are done and the provider is swapped.
+	swappingChains map[string]bool
+
+	// relayPool runs the relays of the tunnel relayers within the concurrency limits, and
+	// stopCh is closed on shutdown to stop the running relays at the next packet.
+	relayPool *relayPool
+	stopCh    chan struct{}
+
+	// signingWaiter wakes the tunnel relayers waiting for a signing once it is resolved.
+	signingWaiter *signingWaiter
 }
 
 // NewScheduler creates a new Scheduler
@@ -41,22 +80,41 @@ func NewScheduler(
 	chainProviders chains.ChainProviders,
 	tunnelCreator string,
 	alert alert.Alert,
+	checkpointStore db.CheckpointStore,
 ) *Scheduler {
 	relayTunnelIDCh := make(chan uint64, 1000)
 
+	// invalidate the cached packets waiting for a signing before waking the tunnel relayers
+	var onSigningResolved func(signingID uint64)
+	if invalidator, ok := bandClient.(band.SigningInvalidator); ok {
+		onSigningResolved = invalidator.InvalidateSigning
+	}
+
 	return &Scheduler{
 		Log:                    log,
 		CheckingPacketInterval: config.Global.CheckingPacketInterval,
 		SyncTunnelsInterval:    config.Global.SyncTunnelsInterval,
-		PenaltySkipRounds:      config.Global.PenaltySkipRounds,
 		SubscriptionTimeout:    config.BandChain.Timeout,
+		CatchUpWindow:          config.Global.CatchUpWindow,
+		SigningMaxWait:         config.Global.SigningMaxWait,
+		ShutdownGracePeriod:    config.Global.ShutdownGracePeriod,
+		PenaltyPolicy:          penalty.NewPolicy(config.Global.Penalties),
 		BandClient:             bandClient,
-		ChainProviders:         chainProviders,
+		ChainProviders:         maps.Clone(chainProviders),
+		TargetChainConfigs:     maps.Clone(config.TargetChains),
+		CheckpointStore:        checkpointStore,
 		Alert:                  alert,
 		relayTunnelIDCh:        relayTunnelIDCh,
 		tunnelRelayers:         make(map[uint64]*TunnelRelayer),
-		bandLatestTunnel:       0,
 		tunnelCreator:          tunnelCreator,
+		tunnels:                make(map[uint64]bandtypes.Tunnel),
+		pausedTunnels:          make(map[uint64]bool),
+		pausedChains:           make(map[string]bool),
+		swappingChains:         make(map[string]bool),
+		syncTunnelsCh:          make(chan struct{}, 1),
+		relayPool:              newRelayPool(config.Global.MaxConcurrentRelays),
+		stopCh:                 make(chan struct{}),
+		signingWaiter:          newSigningWaiter(log, config.BandChain.Timeout, onSigningResolved),
 	}
 }
 
@@ -68,17 +126,24 @@ func (s *Scheduler) WithTunnels(tunnels []bandtypes.Tunnel) *Scheduler {
 	return s
 }
 
-// Start starts all tunnel relayers
+// Start starts all tunnel relayers. Once the context is done, it stops accepting new relays
+// and drains the in-flight ones before returning, see shutdown.
 func (s *Scheduler) Start(ctx context.Context, isSyncTunnels bool) error {
-	if err := s.initialize(ctx); err != nil {
+	// the relays are not cancelled with the context, so that the in-flight transactions can
+	// be confirmed and recorded during the shutdown grace period.
+	relayCtx, cancelRelay := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelRelay()
+
+	s.isSyncTunnels = isSyncTunnels
+	if err := s.initialize(ctx, relayCtx); err != nil {
 		return err
 	}
 
 	// execute first time
 	if isSyncTunnels {
-		s.SyncTunnels(ctx)
+		s.SyncTunnels(relayCtx)
 	} else {
-		s.Execute(ctx)
+		s.Execute(relayCtx)
 	}
 
 	ticker := time.NewTicker(s.CheckingPacketInterval)
@@ -91,14 +156,19 @@ func (s *Scheduler) Start(ctx context.Context, isSyncTunnels bool) error {
 		select {
 		case <-ctx.Done():
 			s.Log.Info("Stopping the scheduler")
+			s.shutdown(cancelRelay)
 
 			return nil
 		case <-syncTunnelTicker.C:
 			if isSyncTunnels {
-				s.SyncTunnels(ctx)
+				s.SyncTunnels(relayCtx)
+			}
+		case <-s.syncTunnelsCh:
+			if isSyncTunnels {
+				s.SyncTunnels(relayCtx)
 			}
 		case <-ticker.C:
-			s.Execute(ctx)
+			s.Execute(relayCtx)
 		}
 	}
 }
@@ -107,41 +177,62 @@ func (s *Scheduler) Start(ctx context.Context, isSyncTunnels bool) error {
 func (s *Scheduler) Execute(ctx context.Context) {
 	s.Log.Info("Executing tunnel relayers from the scheduler")
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	for _, tr := range s.tunnelRelayers {
-		if tr.penaltySkipRemaining > 0 {
+		if s.isPaused(tr) {
+			s.Log.Debug("Skipping tunnel execution as it is paused", "tunnel_id", tr.TunnelID)
+			continue
+		}
+
+		if s.isPenalized(tr) {
 			s.Log.Debug(
-				"Skipping tunnel execution due to penalty from previous failure.",
+				"Skipping tunnel execution due to penalty from previous failure",
 				"tunnel_id", tr.TunnelID,
-				"penalty_skip_remaining", tr.penaltySkipRemaining,
+				"penalty_until", tr.penaltyState.until,
 			)
-			tr.penaltySkipRemaining -= 1
 			continue
 		}
-		go func() { _ = s.TriggerTunnelRelayer(ctx, tr) }()
+		s.queueRelay(tr, func() { _ = s.TriggerTunnelRelayer(ctx, tr) })
 	}
 }
 
 // TriggerTunnelRelayer triggers the tunnel relayer to check and relay the packet
 func (s *Scheduler) TriggerTunnelRelayer(ctx context.Context, tr *TunnelRelayer) (status RelayStatus) {
+	s.mu.RLock()
 	chainName := tr.TargetChainProvider.GetChainName()
 	chainType := tr.TargetChainProvider.ChainType().String()
+	s.mu.RUnlock()
 	startExecutionTaskTime := time.Now()
 
 	// checkAndRelay tunnel's packets and update penalty if it fails to do so.
 	relayStatus, err := tr.CheckAndRelay(ctx, false)
 	if err != nil {
-		tr.penaltySkipRemaining = s.PenaltySkipRounds
+		s.mu.Lock()
+		s.penalize(tr, err)
+		penaltyState := tr.penaltyState
+		s.mu.Unlock()
 
 		relayermetrics.IncTasksCount(tr.TunnelID, chainName, chainType, relayermetrics.ErrorTaskStatus)
 		s.Log.Error(
 			"Failed to execute, Penalty for the tunnel relayer",
 			"tunnel_id", tr.TunnelID,
+			"error_class", penaltyState.errorClass,
+			"consecutive_failures", penaltyState.consecutiveFailures,
+			"backoff", penaltyState.backoff,
 			err,
 		)
 
 		return RelayStatusFailed
 	}
 
+	if relayStatus != RelayStatusExecuting {
+		s.mu.Lock()
+		s.resetPenalty(tr)
+		s.mu.Unlock()
+	}
+
 	switch relayStatus {
 	case RelayStatusExecuting:
 		// Record metrics for the executing task execution
@@ -164,7 +255,9 @@ func (s *Scheduler) TriggerTunnelRelayer(ctx context.Context, tr *TunnelRelayer)
 	return relayStatus
 }
 
-// SyncTunnels synchronizes the Bandchain's tunnels with the latest tunnels.
+// SyncTunnels reconciles the tunnel relayers with the tunnels on BandChain. The relayers of
+// the new or reactivated tunnels are added, those of the tunnels whose route has changed are
+// updated, and those of the deactivated, removed or no longer supported tunnels are retired.
 // If tunnel creator is provided, only tunnels created by that address will be synchronized.
 func (s *Scheduler) SyncTunnels(ctx context.Context) {
 	s.Log.Info("Start syncing tunnels from Bandchain")
@@ -174,32 +267,77 @@ func (s *Scheduler) SyncTunnels(ctx context.Context) {
 		return
 	}
 
-	newTunnels := tunnels[min(s.bandLatestTunnel, len(tunnels)):]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	isSynced := make(map[uint64]bool)
+	var changedRelayers []*TunnelRelayer
+	for _, tunnel := range tunnels {
+		isSynced[tunnel.ID] = true
 
-	validTunnels := s.filterTunnels(newTunnels)
-	if len(validTunnels) == 0 {
-		s.Log.Info("No new tunnels to sync")
+		tr, ok := s.tunnelRelayers[tunnel.ID]
+		switch {
+		case !tunnel.IsActive || !s.isSupportedTunnel(tunnel):
+			if ok {
+				s.retireTunnelRelayer(tr, "tunnel is inactive or no longer supported")
+			}
+		case !ok:
+			changedRelayers = append(changedRelayers, s.addTunnelRelayer(tunnel))
+		case !isSameRoute(s.tunnels[tunnel.ID], tunnel):
+			if s.updateTunnelRelayer(tr, tunnel) {
+				changedRelayers = append(changedRelayers, tr)
+			}
+		}
+	}
+
+	for tunnelID, tr := range s.tunnelRelayers {
+		if !isSynced[tunnelID] {
+			s.retireTunnelRelayer(tr, "tunnel is not found on BandChain")
+		}
+	}
+
+	if len(changedRelayers) == 0 {
+		s.Log.Info("No new or updated tunnels to sync")
 		return
 	}
 
-	s.setTunnelRelayer(validTunnels)
-	s.bandLatestTunnel = len(tunnels)
+	for _, tr := range changedRelayers {
+		s.queueRelay(tr, func() { _ = s.TriggerTunnelRelayer(ctx, tr) })
+	}
+}
+
+// discoverTunnel adds the tunnel relayer of the given tunnel unknown to the scheduler, if the
+// tunnel is active and supported on BandChain. It returns nil if the tunnel is not relayed.
+func (s *Scheduler) discoverTunnel(ctx context.Context, tunnelID uint64) *TunnelRelayer {
+	tunnel, err := s.BandClient.GetTunnel(ctx, tunnelID)
+	if err != nil {
+		s.Log.Error("Failed to fetch the triggered tunnel from BandChain", "tunnel_id", tunnelID, err)
+		return nil
+	}
 
-	// update the valid tunnel IDs in the packet handler
-	for _, tunnel := range validTunnels {
-		go func() {
-			tr := s.tunnelRelayers[tunnel.ID]
-			_ = s.TriggerTunnelRelayer(ctx, tr)
-		}()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tr, ok := s.tunnelRelayers[tunnelID]; ok {
+		return tr
+	}
+
+	if !tunnel.IsActive || !s.isSupportedTunnel(*tunnel) {
+		s.Log.Debug("Skipping the triggered tunnel as it is inactive or not supported", "tunnel_id", tunnelID)
+		return nil
 	}
+
+	return s.addTunnelRelayer(*tunnel)
 }
 
-// initialize initializes the scheduler and execute subroutines.
-func (s *Scheduler) initialize(ctx context.Context) error {
+// initialize initializes the scheduler and execute subroutines. The subscriptions are bound to
+// the given context and the triggered relays to the relay context.
+func (s *Scheduler) initialize(ctx context.Context, relayCtx context.Context) error {
 	subscribers := []subscriber.Subscriber{
 		subscriber.NewPacketSuccessSubscriber(s.Log, s.relayTunnelIDCh, s.SubscriptionTimeout),
 		subscriber.NewManualTriggerSubscriber(s.Log, s.relayTunnelIDCh, s.SubscriptionTimeout),
 	}
+	subscribers = append(subscribers, s.signingWaiter.subscribers...)
 	s.BandClient.SetSubscribers(subscribers)
 
 	if err := s.BandClient.Subscribe(ctx); err != nil {
@@ -213,30 +351,106 @@ func (s *Scheduler) initialize(ctx context.Context) error {
 	}
 
 	// handle trigger relayer event from packet handler
-	go s.handleTriggerTunnelRelayer(ctx)
+	go s.handleTriggerTunnelRelayer(relayCtx)
 
 	return nil
 }
 
+// queueRelay queues the given relay of the tunnel relayer in the relay pool, within the
+// concurrency limit of its target chain. The relay is dropped if the tunnel is already queued
+// or the scheduler is stopping. The caller must hold s.mu.
+func (s *Scheduler) queueRelay(tr *TunnelRelayer, relay func()) {
+	chainName := tr.TargetChainProvider.GetChainName()
+	chainCfg := getBaseChainConfig(s.TargetChainConfigs, chainName)
+	if !s.relayPool.submit(tr.TunnelID, chainName, chainCfg.MaxConcurrentRelays, relay) {
+		s.Log.Debug("Skipping tunnel execution as it is already queued or stopping", "tunnel_id", tr.TunnelID)
+	}
+}
+
+// shutdown drops the queued relays, stops accepting new ones and lets the tunnel relayers stop at the next packet.
+// It waits up to the shutdown grace period for the in-flight relays to be done, then cancels
+// the remaining ones with the given function and waits for them to return.
+func (s *Scheduler) shutdown(cancelRelay context.CancelFunc) {
+	if !s.relayPool.stop() {
+		return
+	}
+	close(s.stopCh)
+
+	done := make(chan struct{})
+	go func() {
+		s.relayPool.wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.Log.Info("All in-flight relays are done")
+		return
+	case <-time.After(s.ShutdownGracePeriod):
+	}
+
+	s.Log.Warn(
+		"Shutdown grace period is over, cancelling the in-flight relays",
+		"grace_period", s.ShutdownGracePeriod,
+		"tunnel_ids", s.getRelayingTunnelIDs(),
+	)
+	cancelRelay()
+	<-done
+}
+
+// getRelayingTunnelIDs returns the IDs of the tunnels being relayed, sorted.
+func (s *Scheduler) getRelayingTunnelIDs() []uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var tunnelIDs []uint64
+	for tunnelID, tr := range s.tunnelRelayers {
+		if tr.mu.TryLock() {
+			tr.mu.Unlock()
+			continue
+		}
+
+		tunnelIDs = append(tunnelIDs, tunnelID)
+	}
+	slices.Sort(tunnelIDs)
+
+	return tunnelIDs
+}
+
 // handleTriggerTunnelRelayer triggers the tunnel relayer from the received tunnelID.
 func (s *Scheduler) handleTriggerTunnelRelayer(ctx context.Context) {
 	for tunnelID := range s.relayTunnelIDCh {
+		s.mu.RLock()
 		tunnelRelayer, ok := s.tunnelRelayers[tunnelID]
+		s.mu.RUnlock()
+
+		// discover the tunnels created since the last synchronization
+		if !ok && s.isSyncTunnels {
+			tunnelRelayer = s.discoverTunnel(ctx, tunnelID)
+			ok = tunnelRelayer != nil
+		}
 		if !ok {
 			continue
 		}
 
+		s.mu.RLock()
+		isPaused := s.isPaused(tunnelRelayer)
+		s.mu.RUnlock()
+
 		s.Log.Info("Received trigger relayer event", "tunnel_id", tunnelID)
 
-		if tunnelRelayer.penaltySkipRemaining > 0 {
-			s.Log.Info(
-				"Skipping tunnel execution due to penalty from previous failure",
-				"tunnel_id", tunnelID,
-			)
+		if isPaused {
+			s.Log.Info("Skipping tunnel execution as it is paused", "tunnel_id", tunnelID)
 			continue
 		}
 
-		go func() {
+		s.mu.Lock()
+		if s.isPenalized(tunnelRelayer) {
+			s.deferTrigger(tunnelRelayer)
+			s.mu.Unlock()
+			continue
+		}
+		s.queueRelay(tunnelRelayer, func() {
 			status := s.TriggerTunnelRelayer(ctx, tunnelRelayer)
 			if status != RelayStatusSuccess {
 				s.Log.Info(
@@ -245,8 +459,82 @@ func (s *Scheduler) handleTriggerTunnelRelayer(ctx context.Context) {
 					"status", string(status),
 				)
 			}
-		}()
+		})
+		s.mu.Unlock()
+	}
+}
+
+// penalize penalizes the tunnel relayer after the given error, with the backoff of the class of
+// the error growing with the consecutive failures of the same class. The caller must hold s.mu.
+func (s *Scheduler) penalize(tr *TunnelRelayer, err error) {
+	chainName := tr.TargetChainProvider.GetChainName()
+	chainType := tr.TargetChainProvider.ChainType().String()
+
+	errorClass := penalty.Classify(err)
+	if tr.penaltyState.errorClass != errorClass {
+		if tr.penaltyState.errorClass != "" {
+			relayermetrics.ResetTunnelPenalty(tr.TunnelID, chainName, chainType, string(tr.penaltyState.errorClass))
+		}
+		tr.penaltyState.errorClass = errorClass
+		tr.penaltyState.consecutiveFailures = 0
+	}
+
+	tr.penaltyState.consecutiveFailures++
+	tr.penaltyState.backoff = s.PenaltyPolicy.Backoff(errorClass, tr.penaltyState.consecutiveFailures)
+	tr.penaltyState.until = time.Now().Add(tr.penaltyState.backoff)
+
+	relayermetrics.SetTunnelPenalty(
+		tr.TunnelID,
+		chainName,
+		chainType,
+		string(errorClass),
+		tr.penaltyState.backoff,
+		tr.penaltyState.consecutiveFailures,
+	)
+}
+
+// resetPenalty resets the penalty and the consecutive failures of the tunnel relayer. The caller
+// must hold s.mu.
+func (s *Scheduler) resetPenalty(tr *TunnelRelayer) {
+	if tr.penaltyState.errorClass == "" {
+		return
 	}
+
+	relayermetrics.ResetTunnelPenalty(
+		tr.TunnelID,
+		tr.TargetChainProvider.GetChainName(),
+		tr.TargetChainProvider.ChainType().String(),
+		string(tr.penaltyState.errorClass),
+	)
+	tr.penaltyState = tunnelPenalty{isTriggerDeferred: tr.penaltyState.isTriggerDeferred}
+}
+
+// deferTrigger re-sends the trigger event of the penalized tunnel relayer once its penalty
+// expires, so that the event is not lost. At most one event is deferred per tunnel relayer.
+// The caller must hold s.mu.
+func (s *Scheduler) deferTrigger(tr *TunnelRelayer) {
+	if tr.penaltyState.isTriggerDeferred {
+		return
+	}
+	tr.penaltyState.isTriggerDeferred = true
+
+	s.Log.Info(
+		"Deferring tunnel execution due to penalty from previous failure",
+		"tunnel_id", tr.TunnelID,
+		"penalty_until", tr.penaltyState.until,
+	)
+
+	time.AfterFunc(time.Until(tr.penaltyState.until), func() {
+		s.mu.Lock()
+		tr.penaltyState.isTriggerDeferred = false
+		s.mu.Unlock()
+
+		select {
+		case s.relayTunnelIDCh <- tr.TunnelID:
+		default:
+			s.Log.Warn("Dropping the deferred trigger event as the trigger queue is full", "tunnel_id", tr.TunnelID)
+		}
+	})
 }
 
 // isSupportedTunnel checks if the tunnel is supported by the scheduler.
@@ -264,6 +552,9 @@ func (s *Scheduler) isSupportedTunnel(tunnel bandtypes.Tunnel) bool {
 
 // filterTunnels selects only the supported tunnel and returns the valid tunnels.
 func (s *Scheduler) filterTunnels(tunnels []bandtypes.Tunnel) []bandtypes.Tunnel {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	var validTunnels []bandtypes.Tunnel
 	for _, tunnel := range tunnels {
 		if !s.isSupportedTunnel(tunnel) {
@@ -281,27 +572,443 @@ func (s *Scheduler) filterTunnels(tunnels []bandtypes.Tunnel) []bandtypes.Tunnel
 	return validTunnels
 }
 
-// setTunnelRelayer sets the tunnel relayer from the given tunnels.
+// setTunnelRelayer sets the tunnel relayer from the given tunnels, skipping the tunnels that
+// already have one.
 func (s *Scheduler) setTunnelRelayer(tunnels []bandtypes.Tunnel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	for _, tunnel := range tunnels {
-		chainProvider := s.ChainProviders[tunnel.TargetChainID]
-		tr := NewTunnelRelayer(
-			s.Log,
-			tunnel.ID,
-			s.CheckingPacketInterval,
-			s.BandClient,
-			chainProvider,
-			s.Alert,
-		)
+		if _, ok := s.tunnelRelayers[tunnel.ID]; ok {
+			continue
+		}
 
-		s.tunnelRelayers[tunnel.ID] = &tr
+		s.addTunnelRelayer(tunnel)
+	}
+}
 
-		// update the metric for the number of tunnels per destination chain
+// addTunnelRelayer adds a new tunnel relayer of the given tunnel. The caller must hold s.mu.
+func (s *Scheduler) addTunnelRelayer(tunnel bandtypes.Tunnel) *TunnelRelayer {
+	chainProvider := s.ChainProviders[tunnel.TargetChainID]
+	chainCfg := getBaseChainConfig(s.TargetChainConfigs, tunnel.TargetChainID)
+	tr := NewTunnelRelayer(
+		s.Log,
+		tunnel.ID,
+		s.CheckingPacketInterval,
+		s.BandClient,
+		chainProvider,
+		s.Alert,
+		s.CheckpointStore,
+		s.CatchUpWindow,
+		s.SigningMaxWait,
+		chainCfg.MinTunnelBalance,
+		chainCfg.PauseOnLowTunnelBalance,
+	)
+	tr.stopCh = s.stopCh
+	tr.signingWaiter = s.signingWaiter
+
+	s.tunnelRelayers[tunnel.ID] = &tr
+	s.tunnels[tunnel.ID] = tunnel
+
+	// update the metric for the number of tunnels per destination chain
+	relayermetrics.IncTunnelsPerDestinationChain(tunnel.TargetChainID, chainProvider.ChainType().String())
+	s.Log.Info(
+		"New tunnel is set into the scheduler",
+		"chain_name", tunnel.TargetChainID,
+		"tunnel_id", tunnel.ID,
+	)
+
+	return &tr
+}
+
+// updateTunnelRelayer updates the tunnel relayer to the given tunnel, switching it to the chain
+// provider of the new target chain and clearing its penalty from the previous route. The update
+// is retried on the next synchronization if the tunnel relayer is relaying, and it returns
+// whether the tunnel relayer is updated. The caller must hold s.mu.
+func (s *Scheduler) updateTunnelRelayer(tr *TunnelRelayer, tunnel bandtypes.Tunnel) bool {
+	if !tr.mu.TryLock() {
+		s.Log.Debug("Skipping the tunnel update as the tunnel relayer is executing", "tunnel_id", tr.TunnelID)
+		return false
+	}
+	defer tr.mu.Unlock()
+
+	prevTunnel := s.tunnels[tunnel.ID]
+	s.tunnels[tunnel.ID] = tunnel
+
+	s.resetPenalty(tr)
+	tr.resetTargetChainActive()
+
+	if prevTunnel.TargetChainID != tunnel.TargetChainID {
+		prevChainType := tr.TargetChainProvider.ChainType().String()
+		chainProvider := s.ChainProviders[tunnel.TargetChainID]
+		chainCfg := getBaseChainConfig(s.TargetChainConfigs, tunnel.TargetChainID)
+		tr.TargetChainProvider = chainProvider
+		tr.MinTunnelBalance = chainCfg.MinTunnelBalance
+		tr.PauseOnLowTunnelBalance = chainCfg.PauseOnLowTunnelBalance
+
+		relayermetrics.DecTunnelsPerDestinationChain(prevTunnel.TargetChainID, prevChainType)
 		relayermetrics.IncTunnelsPerDestinationChain(tunnel.TargetChainID, chainProvider.ChainType().String())
-		s.Log.Info(
-			"New tunnel is set into the scheduler",
-			"chain_name", tunnel.TargetChainID,
-			"tunnel_id", tunnel.ID,
+	}
+
+	s.Log.Info(
+		"Updated the route of the tunnel",
+		"tunnel_id", tunnel.ID,
+		"chain_name", tunnel.TargetChainID,
+		"target_address", tunnel.TargetAddress,
+		"prev_chain_name", prevTunnel.TargetChainID,
+		"prev_target_address", prevTunnel.TargetAddress,
+	)
+
+	return true
+}
+
+// retireTunnelRelayer removes the tunnel relayer from the scheduler for the given reason. The
+// tunnel relayer is marked as retired, so that the goroutines still holding it skip relaying
+// from now on; its removal is retried on the next synchronization if it is relaying. The
+// caller must hold s.mu.
+func (s *Scheduler) retireTunnelRelayer(tr *TunnelRelayer, reason string) {
+	if !tr.mu.TryLock() {
+		s.Log.Debug("Skipping the tunnel retirement as the tunnel relayer is executing", "tunnel_id", tr.TunnelID)
+		return
+	}
+
+	tr.isRetired = true
+	tr.resetTargetChainActive()
+	tr.mu.Unlock()
+
+	s.removeTunnelRelayer(tr)
+	s.Log.Info("Retired the tunnel relayer", "tunnel_id", tr.TunnelID, "reason", reason)
+}
+
+// removeTunnelRelayer removes the tunnel relayer with its state from the scheduler. The caller
+// must hold s.mu.
+func (s *Scheduler) removeTunnelRelayer(tr *TunnelRelayer) {
+	s.resetPenalty(tr)
+
+	delete(s.tunnelRelayers, tr.TunnelID)
+	delete(s.tunnels, tr.TunnelID)
+	delete(s.pausedTunnels, tr.TunnelID)
+	relayermetrics.DecTunnelsPerDestinationChain(
+		tr.TargetChainProvider.GetChainName(),
+		tr.TargetChainProvider.ChainType().String(),
+	)
+}
+
+// SetChainProvider adds or replaces the chain provider of the given chain. When replacing, the
+// dispatch of the chain is paused and the provider is swapped once none of its tunnel relayers
+// is relaying, so that the old and new providers never relay at the same time, e.g. with the
+// same nonces of the signers. The tunnel relayers keep their state. If the chain is new, the
+// tunnels are synchronized to pick up its tunnels.
+func (s *Scheduler) SetChainProvider(
+	chainName string,
+	chainProvider chains.ChainProvider,
+	chainConfig chains.ChainProviderConfig,
+) {
+	s.mu.Lock()
+	_, isUpdate := s.ChainProviders[chainName]
+	if isUpdate {
+		s.swappingChains[chainName] = true
+	}
+	s.mu.Unlock()
+
+	tunnelRelayers := s.lockChainTunnelRelayers(chainName)
+	s.ChainProviders[chainName] = chainProvider
+	s.TargetChainConfigs[chainName] = chainConfig
+	delete(s.swappingChains, chainName)
+
+	chainCfg := chainConfig.GetBaseConfig()
+	for _, tr := range tunnelRelayers {
+		tr.TargetChainProvider = chainProvider
+		tr.MinTunnelBalance = chainCfg.MinTunnelBalance
+		tr.PauseOnLowTunnelBalance = chainCfg.PauseOnLowTunnelBalance
+		tr.mu.Unlock()
+	}
+	s.mu.Unlock()
+
+	if isUpdate {
+		s.Log.Info("Updated the chain provider", "chain_name", chainName, "tunnels", len(tunnelRelayers))
+		return
+	}
+
+	s.Log.Info("Added the chain provider", "chain_name", chainName)
+	select {
+	case s.syncTunnelsCh <- struct{}{}:
+	default:
+	}
+}
+
+// RemoveChainProvider removes the chain provider of the given chain with its tunnel relayers
+// and waits for their in-flight relays to be done.
+func (s *Scheduler) RemoveChainProvider(chainName string) {
+	s.mu.Lock()
+	if _, ok := s.ChainProviders[chainName]; !ok {
+		s.mu.Unlock()
+		return
+	}
+
+	tunnelRelayers := s.getChainTunnelRelayers(chainName)
+	for _, tr := range tunnelRelayers {
+		s.removeTunnelRelayer(tr)
+	}
+	delete(s.ChainProviders, chainName)
+	delete(s.TargetChainConfigs, chainName)
+	delete(s.pausedChains, chainName)
+	s.mu.Unlock()
+
+	// the removed tunnel relayers are retired once their in-flight relays are done, so that
+	// the goroutines still holding them skip relaying from now on.
+	for _, tr := range tunnelRelayers {
+		tr.mu.Lock()
+		tr.isRetired = true
+		tr.resetTargetChainActive()
+		tr.mu.Unlock()
+	}
+
+	s.Log.Info("Removed the chain provider", "chain_name", chainName, "tunnels", len(tunnelRelayers))
+}
+
+// lockChainTunnelRelayers locks all tunnel relayers targeting the given chain, retrying until
+// none of them is relaying. It returns them with s.mu held, which the caller must release.
+func (s *Scheduler) lockChainTunnelRelayers(chainName string) []*TunnelRelayer {
+	for {
+		s.mu.Lock()
+		tunnelRelayers := s.getChainTunnelRelayers(chainName)
+		lockedCount := 0
+		for _, tr := range tunnelRelayers {
+			if !tr.mu.TryLock() {
+				break
+			}
+			lockedCount++
+		}
+
+		if lockedCount == len(tunnelRelayers) {
+			return tunnelRelayers
+		}
+
+		for _, tr := range tunnelRelayers[:lockedCount] {
+			tr.mu.Unlock()
+		}
+		s.mu.Unlock()
+
+		s.Log.Debug("Waiting for the in-flight relays of the chain to be done", "chain_name", chainName)
+		time.Sleep(swapChainProviderInterval)
+	}
+}
+
+// getChainTunnelRelayers returns the tunnel relayers targeting the given chain. The caller
+// must hold s.mu.
+func (s *Scheduler) getChainTunnelRelayers(chainName string) []*TunnelRelayer {
+	var tunnelRelayers []*TunnelRelayer
+	for _, tr := range s.tunnelRelayers {
+		if tr.TargetChainProvider.GetChainName() == chainName {
+			tunnelRelayers = append(tunnelRelayers, tr)
+		}
+	}
+
+	return tunnelRelayers
+}
+
+// GetTunnelStates returns the states of all tunnel relayers, sorted by tunnel ID.
+func (s *Scheduler) GetTunnelStates() []relayeradmin.TunnelState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	states := make([]relayeradmin.TunnelState, 0, len(s.tunnelRelayers))
+	for _, tr := range s.tunnelRelayers {
+		states = append(states, s.getTunnelState(tr))
+	}
+
+	slices.SortFunc(states, func(a, b relayeradmin.TunnelState) int {
+		return cmp.Compare(a.TunnelID, b.TunnelID)
+	})
+
+	return states
+}
+
+// GetTunnelState returns the state of the tunnel relayer of the given tunnel ID.
+func (s *Scheduler) GetTunnelState(tunnelID uint64) (relayeradmin.TunnelState, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tr, err := s.getTunnelRelayer(tunnelID)
+	if err != nil {
+		return relayeradmin.TunnelState{}, err
+	}
+
+	return s.getTunnelState(tr), nil
+}
+
+// TriggerTunnel pushes the given tunnel ID to the trigger channel, so that the tunnel relayer
+// checks and relays packets the same way as on a packet event from BandChain.
+func (s *Scheduler) TriggerTunnel(tunnelID uint64) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tr, err := s.getTunnelRelayer(tunnelID)
+	if err != nil {
+		return err
+	}
+
+	if s.isPaused(tr) {
+		return fmt.Errorf("tunnel %d is paused: %w", tunnelID, relayeradmin.ErrConflict)
+	}
+
+	if s.isPenalized(tr) {
+		return fmt.Errorf(
+			"tunnel %d is penalized until %s: %w",
+			tunnelID,
+			tr.penaltyState.until.Format(time.RFC3339),
+			relayeradmin.ErrConflict,
 		)
 	}
+
+	select {
+	case s.relayTunnelIDCh <- tunnelID:
+		return nil
+	default:
+		return fmt.Errorf("trigger queue is full")
+	}
+}
+
+// PauseTunnel stops scheduling the tunnel relayer of the given tunnel ID.
+func (s *Scheduler) PauseTunnel(tunnelID uint64) error {
+	return s.setTunnelPaused(tunnelID, true)
+}
+
+// ResumeTunnel resumes scheduling the tunnel relayer of the given tunnel ID.
+func (s *Scheduler) ResumeTunnel(tunnelID uint64) error {
+	return s.setTunnelPaused(tunnelID, false)
+}
+
+// PauseChain stops scheduling all tunnel relayers targeting the given chain.
+func (s *Scheduler) PauseChain(chainName string) error {
+	return s.setChainPaused(chainName, true)
+}
+
+// ResumeChain resumes scheduling the tunnel relayers targeting the given chain.
+func (s *Scheduler) ResumeChain(chainName string) error {
+	return s.setChainPaused(chainName, false)
+}
+
+// ClearPenalty resets the penalty and the consecutive failures of the tunnel relayer of the
+// given tunnel ID.
+func (s *Scheduler) ClearPenalty(tunnelID uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tr, err := s.getTunnelRelayer(tunnelID)
+	if err != nil {
+		return err
+	}
+
+	s.resetPenalty(tr)
+	s.Log.Info("Cleared penalty of the tunnel relayer", "tunnel_id", tunnelID)
+
+	return nil
+}
+
+// setTunnelPaused sets whether the tunnel relayer of the given tunnel ID is paused.
+func (s *Scheduler) setTunnelPaused(tunnelID uint64, isPaused bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.getTunnelRelayer(tunnelID); err != nil {
+		return err
+	}
+
+	if isPaused {
+		s.pausedTunnels[tunnelID] = true
+		s.Log.Info("Paused the tunnel relayer", "tunnel_id", tunnelID)
+	} else {
+		delete(s.pausedTunnels, tunnelID)
+		s.Log.Info("Resumed the tunnel relayer", "tunnel_id", tunnelID)
+	}
+
+	return nil
+}
+
+// setChainPaused sets whether the tunnel relayers targeting the given chain are paused.
+func (s *Scheduler) setChainPaused(chainName string, isPaused bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.ChainProviders[chainName]; !ok {
+		return fmt.Errorf("chain %s: %w", chainName, relayeradmin.ErrNotFound)
+	}
+
+	if isPaused {
+		s.pausedChains[chainName] = true
+		s.Log.Info("Paused the tunnel relayers of the chain", "chain_name", chainName)
+	} else {
+		delete(s.pausedChains, chainName)
+		s.Log.Info("Resumed the tunnel relayers of the chain", "chain_name", chainName)
+	}
+
+	return nil
+}
+
+// getTunnelRelayer returns the tunnel relayer of the given tunnel ID. The caller must hold s.mu.
+func (s *Scheduler) getTunnelRelayer(tunnelID uint64) (*TunnelRelayer, error) {
+	tr, ok := s.tunnelRelayers[tunnelID]
+	if !ok {
+		return nil, fmt.Errorf("tunnel %d: %w", tunnelID, relayeradmin.ErrNotFound)
+	}
+
+	return tr, nil
+}
+
+// getTunnelState returns the state of the given tunnel relayer. The caller must hold s.mu.
+func (s *Scheduler) getTunnelState(tr *TunnelRelayer) relayeradmin.TunnelState {
+	chainName := tr.TargetChainProvider.GetChainName()
+	state := relayeradmin.TunnelState{
+		TunnelID:            tr.TunnelID,
+		ChainName:           chainName,
+		ChainType:           tr.TargetChainProvider.ChainType().String(),
+		IsPaused:            s.pausedTunnels[tr.TunnelID],
+		IsChainPaused:       s.pausedChains[chainName],
+		ConsecutiveFailures: tr.penaltyState.consecutiveFailures,
+		PenaltyErrorClass:   string(tr.penaltyState.errorClass),
+	}
+
+	if s.isPenalized(tr) {
+		penaltyUntil := tr.penaltyState.until
+		state.PenaltyUntil = &penaltyUntil
+	}
+
+	lastRelayedSequence, lastRelayedAt := tr.getLastRelayed()
+	state.LastRelayedSequence = lastRelayedSequence
+	if !lastRelayedAt.IsZero() {
+		state.LastRelayedAt = &lastRelayedAt
+	}
+
+	return state
+}
+
+// isPenalized checks if the tunnel relayer is penalized from a previous failure. The caller must
+// hold s.mu.
+func (s *Scheduler) isPenalized(tr *TunnelRelayer) bool {
+	return time.Now().Before(tr.penaltyState.until)
+}
+
+// isPaused checks if the tunnel relayer or its target chain is paused, or the chain provider of
+// its target chain is being swapped. The caller must hold s.mu.
+func (s *Scheduler) isPaused(tr *TunnelRelayer) bool {
+	chainName := tr.TargetChainProvider.GetChainName()
+	return s.pausedTunnels[tr.TunnelID] || s.pausedChains[chainName] || s.swappingChains[chainName]
+}
+
+// isSameRoute checks if the given tunnels relay to the same target contract on the same chain.
+func isSameRoute(a bandtypes.Tunnel, b bandtypes.Tunnel) bool {
+	return a.TargetChainID == b.TargetChainID && a.TargetAddress == b.TargetAddress
+}
+
+// getBaseChainConfig returns the common config of the given target chain, or an empty
+// config if the chain is not configured.
+func getBaseChainConfig(chainConfigs config.ChainProviderConfigs, chainName string) chains.BaseChainProviderConfig {
+	chainCfg, ok := chainConfigs[chainName]
+	if !ok || chainCfg == nil {
+		return chains.BaseChainProviderConfig{}
+	}
+
+	return chainCfg.GetBaseConfig()
 }
@@ -0,0 +1,350 @@
+package relayer_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/bandprotocol/falcon/internal/relayeradmin"
+	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
+	"github.com/bandprotocol/falcon/relayer"
+	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
+	"github.com/bandprotocol/falcon/relayer/chains"
+	"github.com/bandprotocol/falcon/relayer/chains/evm"
+	chaintypes "github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/config"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+const (
+	schedulerEVMChainName  = "testnet_evm"
+	schedulerXRPLChainName = "testnet_xrpl"
+)
+
+type SchedulerTestSuite struct {
+	suite.Suite
+
+	ctx       context.Context
+	client    *mocks.MockClient
+	scheduler *relayer.Scheduler
+}
+
+func TestSchedulerTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerTestSuite))
+}
+
+// SetupTest sets up the scheduler with a tunnel on each of the EVM and XRPL chains.
+func (s *SchedulerTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.ctx = context.Background()
+	s.client = mocks.NewMockClient(ctrl)
+
+	evmProvider := mocks.NewMockChainProvider(ctrl)
+	evmProvider.EXPECT().GetChainName().Return(schedulerEVMChainName).AnyTimes()
+	evmProvider.EXPECT().ChainType().Return(chaintypes.ChainTypeEVM).AnyTimes()
+
+	xrplProvider := mocks.NewMockChainProvider(ctrl)
+	xrplProvider.EXPECT().GetChainName().Return(schedulerXRPLChainName).AnyTimes()
+	xrplProvider.EXPECT().ChainType().Return(chaintypes.ChainTypeXRPL).AnyTimes()
+
+	s.scheduler = relayer.NewScheduler(
+		logger.NewZapLogWrapper(zap.NewNop().Sugar()),
+		config.DefaultConfig(),
+		s.client,
+		chains.ChainProviders{
+			schedulerEVMChainName:  evmProvider,
+			schedulerXRPLChainName: xrplProvider,
+		},
+		"",
+		nil,
+		nil,
+	).WithTunnels([]bandtypes.Tunnel{
+		*bandtypes.NewTunnel(2, 0, "", schedulerXRPLChainName, true, ""),
+		*bandtypes.NewTunnel(1, 0, "", schedulerEVMChainName, true, ""),
+	})
+}
+
+func (s *SchedulerTestSuite) TestGetTunnelStates() {
+	states := s.scheduler.GetTunnelStates()
+	s.Require().Equal([]relayeradmin.TunnelState{
+		{TunnelID: 1, ChainName: schedulerEVMChainName, ChainType: "evm"},
+		{TunnelID: 2, ChainName: schedulerXRPLChainName, ChainType: "xrpl"},
+	}, states)
+
+	_, err := s.scheduler.GetTunnelState(3)
+	s.Require().ErrorIs(err, relayeradmin.ErrNotFound)
+}
+
+func (s *SchedulerTestSuite) TestPauseAndResume() {
+	s.Require().NoError(s.scheduler.PauseTunnel(1))
+	s.Require().NoError(s.scheduler.PauseChain(schedulerXRPLChainName))
+
+	states := s.scheduler.GetTunnelStates()
+	s.Require().True(states[0].IsPaused)
+	s.Require().True(states[1].IsChainPaused)
+
+	// paused tunnels are neither executed nor triggered.
+	s.scheduler.Execute(s.ctx)
+	s.Require().ErrorIs(s.scheduler.TriggerTunnel(1), relayeradmin.ErrConflict)
+	s.Require().ErrorIs(s.scheduler.TriggerTunnel(2), relayeradmin.ErrConflict)
+
+	s.Require().NoError(s.scheduler.ResumeTunnel(1))
+	s.Require().NoError(s.scheduler.ResumeChain(schedulerXRPLChainName))
+	s.Require().NoError(s.scheduler.TriggerTunnel(1))
+
+	states = s.scheduler.GetTunnelStates()
+	s.Require().False(states[0].IsPaused)
+	s.Require().False(states[1].IsChainPaused)
+
+	s.Require().ErrorIs(s.scheduler.PauseTunnel(3), relayeradmin.ErrNotFound)
+	s.Require().ErrorIs(s.scheduler.PauseChain("unknown"), relayeradmin.ErrNotFound)
+}
+
+func (s *SchedulerTestSuite) TestClearPenalty() {
+	s.client.EXPECT().GetTunnel(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("connection refused"))
+
+	tr := s.scheduler.GetTunnelRelayer(1)
+	s.Require().Equal(relayer.RelayStatusFailed, s.scheduler.TriggerTunnelRelayer(s.ctx, tr))
+
+	state, err := s.scheduler.GetTunnelState(1)
+	s.Require().NoError(err)
+	s.Require().Equal(1, state.ConsecutiveFailures)
+	s.Require().Equal("transient", state.PenaltyErrorClass)
+	s.Require().NotNil(state.PenaltyUntil)
+	s.Require().ErrorIs(s.scheduler.TriggerTunnel(1), relayeradmin.ErrConflict)
+
+	s.Require().NoError(s.scheduler.ClearPenalty(1))
+
+	state, err = s.scheduler.GetTunnelState(1)
+	s.Require().NoError(err)
+	s.Require().Zero(state.ConsecutiveFailures)
+	s.Require().Empty(state.PenaltyErrorClass)
+	s.Require().Nil(state.PenaltyUntil)
+	s.Require().NoError(s.scheduler.TriggerTunnel(1))
+}
+
+func (s *SchedulerTestSuite) TestPenaltyByErrorClass() {
+	tr := s.scheduler.GetTunnelRelayer(1)
+	chainProvider := tr.TargetChainProvider.(*mocks.MockChainProvider)
+
+	// consecutive failures of the same class grow the backoff.
+	s.client.EXPECT().GetTunnel(gomock.Any(), uint64(1)).Return(nil, fmt.Errorf("connection refused")).Times(2)
+	s.Require().Equal(relayer.RelayStatusFailed, s.scheduler.TriggerTunnelRelayer(s.ctx, tr))
+	first, err := s.scheduler.GetTunnelState(1)
+	s.Require().NoError(err)
+
+	s.Require().Equal(relayer.RelayStatusFailed, s.scheduler.TriggerTunnelRelayer(s.ctx, tr))
+	second, err := s.scheduler.GetTunnelState(1)
+	s.Require().NoError(err)
+	s.Require().Equal(2, second.ConsecutiveFailures)
+	s.Require().True(second.PenaltyUntil.After(*first.PenaltyUntil))
+
+	// a failure of another class starts over.
+	s.client.EXPECT().
+		GetTunnel(gomock.Any(), uint64(1)).
+		Return(bandtypes.NewTunnel(1, 0, "0xtarget", schedulerEVMChainName, true, ""), nil)
+	chainProvider.EXPECT().
+		QueryTunnelInfo(gomock.Any(), uint64(1), "0xtarget").
+		Return(nil, fmt.Errorf("execution reverted"))
+	s.Require().Equal(relayer.RelayStatusFailed, s.scheduler.TriggerTunnelRelayer(s.ctx, tr))
+
+	state, err := s.scheduler.GetTunnelState(1)
+	s.Require().NoError(err)
+	s.Require().Equal(1, state.ConsecutiveFailures)
+	s.Require().Equal("contract_revert", state.PenaltyErrorClass)
+}
+
+func (s *SchedulerTestSuite) TestSyncTunnels() {
+	// the queued relays of the added and updated tunnels fail to get the tunnel.
+	s.client.EXPECT().GetTunnel(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("connection refused")).AnyTimes()
+	s.client.EXPECT().GetTunnels(gomock.Any()).Return([]bandtypes.Tunnel{
+		*bandtypes.NewTunnel(1, 5, "0xnew", schedulerXRPLChainName, true, ""),
+		*bandtypes.NewTunnel(2, 0, "", schedulerXRPLChainName, false, ""),
+		*bandtypes.NewTunnel(3, 0, "0xtarget", schedulerEVMChainName, true, ""),
+		*bandtypes.NewTunnel(4, 0, "0xtarget", "unknown", true, ""),
+	}, nil)
+	retiredTr := s.scheduler.GetTunnelRelayer(2)
+
+	s.scheduler.SyncTunnels(s.ctx)
+	s.scheduler.WaitRelays()
+
+	// the route of tunnel 1 is updated, the inactive tunnel 2 is retired and tunnel 3 is added.
+	s.Require().Equal(relayer.RelayStatusSkipped, s.scheduler.TriggerTunnelRelayer(s.ctx, retiredTr))
+	states := s.scheduler.GetTunnelStates()
+	s.Require().Len(states, 2)
+	s.Require().Equal(uint64(1), states[0].TunnelID)
+	s.Require().Equal(schedulerXRPLChainName, states[0].ChainName)
+	s.Require().Equal(uint64(3), states[1].TunnelID)
+	s.Require().Equal(schedulerEVMChainName, states[1].ChainName)
+
+	// the tunnels no longer on BandChain are retired.
+	s.client.EXPECT().GetTunnels(gomock.Any()).Return([]bandtypes.Tunnel{
+		*bandtypes.NewTunnel(3, 1, "0xtarget", schedulerEVMChainName, true, ""),
+	}, nil)
+
+	s.scheduler.SyncTunnels(s.ctx)
+	s.scheduler.WaitRelays()
+
+	states = s.scheduler.GetTunnelStates()
+	s.Require().Len(states, 1)
+	s.Require().Equal(uint64(3), states[0].TunnelID)
+}
+
+func (s *SchedulerTestSuite) TestDiscoverTunnel() {
+	s.client.EXPECT().
+		GetTunnel(gomock.Any(), uint64(3)).
+		Return(bandtypes.NewTunnel(3, 0, "0xtarget", schedulerEVMChainName, true, ""), nil)
+	s.client.EXPECT().
+		GetTunnel(gomock.Any(), uint64(4)).
+		Return(bandtypes.NewTunnel(4, 0, "0xtarget", schedulerEVMChainName, false, ""), nil)
+
+	tr := s.scheduler.DiscoverTunnel(s.ctx, 3)
+	s.Require().NotNil(tr)
+	s.Require().Same(tr, s.scheduler.GetTunnelRelayer(3))
+
+	// inactive tunnels are not relayed.
+	s.Require().Nil(s.scheduler.DiscoverTunnel(s.ctx, 4))
+	s.Require().Len(s.scheduler.GetTunnelStates(), 3)
+}
+
+func (s *SchedulerTestSuite) TestSetChainProvider() {
+	ctrl := gomock.NewController(s.T())
+	evmProvider := mocks.NewMockChainProvider(ctrl)
+	evmProvider.EXPECT().GetChainName().Return(schedulerEVMChainName).AnyTimes()
+	evmProvider.EXPECT().ChainType().Return(chaintypes.ChainTypeEVM).AnyTimes()
+
+	s.scheduler.SetChainProvider(schedulerEVMChainName, evmProvider, &evm.EVMChainProviderConfig{
+		BaseChainProviderConfig: chains.BaseChainProviderConfig{MinTunnelBalance: big.NewInt(100)},
+	})
+
+	// the tunnel relayer is switched to the new chain provider.
+	tr := s.scheduler.GetTunnelRelayer(1)
+	s.Require().Same(evmProvider, tr.TargetChainProvider)
+	s.Require().Equal(big.NewInt(100), tr.MinTunnelBalance)
+	s.Require().Len(s.scheduler.GetTunnelStates(), 2)
+}
+
+func (s *SchedulerTestSuite) TestSetChainProviderWaitsForInFlightRelays() {
+	ctrl := gomock.NewController(s.T())
+	evmProvider := mocks.NewMockChainProvider(ctrl)
+	evmProvider.EXPECT().GetChainName().Return(schedulerEVMChainName).AnyTimes()
+	evmProvider.EXPECT().ChainType().Return(chaintypes.ChainTypeEVM).AnyTimes()
+
+	// the tunnel relayer is relaying with the running chain provider.
+	tr := s.scheduler.GetTunnelRelayer(1)
+	runningProvider := tr.TargetChainProvider
+	tr.LockRelay()
+
+	done := make(chan struct{})
+	go func() {
+		s.scheduler.SetChainProvider(schedulerEVMChainName, evmProvider, &evm.EVMChainProviderConfig{})
+		close(done)
+	}()
+
+	// the chain is paused while waiting for the in-flight relay, which keeps its chain provider.
+	s.Require().Eventually(func() bool {
+		return errors.Is(s.scheduler.TriggerTunnel(1), relayeradmin.ErrConflict)
+	}, time.Second, 10*time.Millisecond)
+	s.Require().Same(runningProvider, tr.TargetChainProvider)
+	s.Require().NoError(s.scheduler.TriggerTunnel(2))
+
+	tr.UnlockRelay()
+	<-done
+
+	s.Require().Same(evmProvider, s.scheduler.GetTunnelRelayer(1).TargetChainProvider)
+	s.Require().NoError(s.scheduler.TriggerTunnel(1))
+}
+
+func (s *SchedulerTestSuite) TestRemoveChainProvider() {
+	s.Require().NoError(s.scheduler.PauseChain(schedulerXRPLChainName))
+	tr := s.scheduler.GetTunnelRelayer(2)
+
+	s.scheduler.RemoveChainProvider(schedulerXRPLChainName)
+
+	// the queued relays of the removed tunnel relayer are skipped rather than left executing.
+	s.Require().Equal(relayer.RelayStatusSkipped, s.scheduler.TriggerTunnelRelayer(s.ctx, tr))
+
+	s.Require().Equal([]relayeradmin.TunnelState{
+		{TunnelID: 1, ChainName: schedulerEVMChainName, ChainType: "evm"},
+	}, s.scheduler.GetTunnelStates())
+	s.Require().NotContains(s.scheduler.ChainProviders, schedulerXRPLChainName)
+	s.Require().ErrorIs(s.scheduler.PauseChain(schedulerXRPLChainName), relayeradmin.ErrNotFound)
+
+	// removing an unknown chain is a no-op.
+	s.scheduler.RemoveChainProvider("unknown")
+	s.Require().Len(s.scheduler.GetTunnelStates(), 1)
+}
+
+func (s *SchedulerTestSuite) TestStartDrainsInFlightRelays() {
+	s.client.EXPECT().SetSubscribers(gomock.Any())
+	s.client.EXPECT().Subscribe(gomock.Any()).Return(nil)
+
+	relaying := make(chan struct{}, 2)
+	release := make(chan struct{})
+	s.client.EXPECT().GetTunnel(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, uint64) (*bandtypes.Tunnel, error) {
+			relaying <- struct{}{}
+			<-release
+			return nil, fmt.Errorf("connection refused")
+		},
+	).Times(2)
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	done := make(chan error)
+	go func() { done <- s.scheduler.Start(ctx, false) }()
+
+	<-relaying
+	<-relaying
+	cancel()
+
+	// the scheduler waits for the in-flight relays before returning.
+	select {
+	case <-done:
+		s.Fail("scheduler returned before the in-flight relays are done")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	s.Require().NoError(<-done)
+
+	// no new relay is accepted after the shutdown.
+	s.scheduler.Execute(s.ctx)
+}
+
+func (s *SchedulerTestSuite) TestStartCancelsInFlightRelaysAfterGracePeriod() {
+	s.client.EXPECT().SetSubscribers(gomock.Any())
+	s.client.EXPECT().Subscribe(gomock.Any()).Return(nil)
+
+	relaying := make(chan struct{}, 2)
+	s.client.EXPECT().GetTunnel(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ uint64) (*bandtypes.Tunnel, error) {
+			relaying <- struct{}{}
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	).Times(2)
+
+	s.scheduler.ShutdownGracePeriod = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	done := make(chan error)
+	go func() { done <- s.scheduler.Start(ctx, false) }()
+
+	<-relaying
+	<-relaying
+	cancel()
+
+	select {
+	case err := <-done:
+		s.Require().NoError(err)
+	case <-time.After(5 * time.Second):
+		s.Fail("scheduler did not cancel the in-flight relays after the grace period")
+	}
+}
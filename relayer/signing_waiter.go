@@ -0,0 +1,93 @@
+package relayer
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	tsstypes "github.com/bandprotocol/falcon/internal/bandchain/tss"
+	"github.com/bandprotocol/falcon/relayer/band/subscriber"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+// signingWaiter wakes the tunnel relayers waiting for a signing on BandChain once the signing
+// succeeds or fails, as notified by its subscribers of the signing events.
+type signingWaiter struct {
+	subscribers []subscriber.Subscriber
+
+	// onResolved is called with the ID of every resolved signing before the waiting relayers
+	// are woken, e.g. to invalidate the cached packets waiting for it.
+	onResolved func(signingID uint64)
+
+	mu      sync.Mutex
+	waiters map[uint64][]chan struct{}
+}
+
+// newSigningWaiter creates a new signingWaiter with the subscribers of the signing events. The
+// onResolved function, if any, is called with the ID of every resolved signing.
+func newSigningWaiter(
+	log logger.Logger,
+	subscriptionTimeout time.Duration,
+	onResolved func(signingID uint64),
+) *signingWaiter {
+	w := &signingWaiter{
+		onResolved: onResolved,
+		waiters:    make(map[uint64][]chan struct{}),
+	}
+
+	w.subscribers = []subscriber.Subscriber{
+		subscriber.NewSigningSubscriber(log, tsstypes.EventTypeSigningSuccess, w.notify, subscriptionTimeout),
+		subscriber.NewSigningSubscriber(log, tsstypes.EventTypeSigningFailed, w.notify, subscriptionTimeout),
+	}
+
+	return w
+}
+
+// wait returns a channel closed once the signing of the given ID is resolved, and a function
+// to stop waiting.
+func (w *signingWaiter) wait(signingID uint64) (<-chan struct{}, func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	ch := make(chan struct{})
+	w.waiters[signingID] = append(w.waiters[signingID], ch)
+
+	return ch, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		waiters := slices.DeleteFunc(w.waiters[signingID], func(c chan struct{}) bool { return c == ch })
+		if len(waiters) == 0 {
+			delete(w.waiters, signingID)
+		} else {
+			w.waiters[signingID] = waiters
+		}
+	}
+}
+
+// notify wakes the tunnel relayers waiting for the signing of the given ID.
+func (w *signingWaiter) notify(signingID uint64) {
+	if w.onResolved != nil {
+		w.onResolved(signingID)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, ch := range w.waiters[signingID] {
+		close(ch)
+	}
+	delete(w.waiters, signingID)
+}
+
+// isSubscribed checks if the subscribers of all signing events are subscribed, so that the
+// resolved signings are notified.
+func (w *signingWaiter) isSubscribed() bool {
+	for _, s := range w.subscribers {
+		if !s.IsSubscribed() {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,46 @@
+package relayer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/bandprotocol/falcon/relayer"
+	"github.com/bandprotocol/falcon/relayer/logger"
+)
+
+func TestSigningWaiter(t *testing.T) {
+	w := relayer.NewSigningWaiter(logger.NewZapLogWrapper(zap.NewNop().Sugar()))
+
+	ch1, stop1 := w.Wait(1)
+	defer stop1()
+	ch2, stop2 := w.Wait(1)
+	ch3, stop3 := w.Wait(2)
+	defer stop3()
+
+	// a stopped waiter is not notified
+	stop2()
+
+	w.Notify(1)
+	require.True(t, isClosed(ch1))
+	require.False(t, isClosed(ch2))
+	require.False(t, isClosed(ch3))
+
+	// notifying a resolved or an unknown signing does nothing
+	w.Notify(1)
+	w.Notify(3)
+	require.False(t, isClosed(ch3))
+
+	w.Notify(2)
+	require.True(t, isClosed(ch3))
+}
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
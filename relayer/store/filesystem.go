@@ -10,6 +10,7 @@ import (
 	"github.com/bandprotocol/falcon/internal/os"
 	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
 	"github.com/bandprotocol/falcon/relayer/config"
+	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/wallet"
 	"github.com/bandprotocol/falcon/relayer/wallet/evm"
 	"github.com/bandprotocol/falcon/relayer/wallet/flow"
@@ -25,6 +26,7 @@ const (
 	cfgDir             = "config"
 	cfgFileName        = "config.toml"
 	passphraseFileName = "passphrase.hash"
+	checkpointDir      = "checkpoints"
 )
 
 type FileSystem struct {
@@ -127,6 +129,34 @@ func (fs *FileSystem) NewWallet(chainType chainstypes.ChainType, chainName, pass
 	}
 }
 
+// GetRelayCheckpoint reads the relay checkpoint of the given tunnel from the filesystem.
+// It returns nil if there is no checkpoint of the tunnel.
+func (fs *FileSystem) GetRelayCheckpoint(tunnelID uint64) (*db.RelayCheckpoint, error) {
+	b, err := os.ReadFileIfExist(path.Join(getCheckpointPath(fs.HomePath, tunnelID)...))
+	if err != nil {
+		return nil, err
+	} else if b == nil {
+		return nil, nil
+	}
+
+	var checkpoint db.RelayCheckpoint
+	if err := toml.Unmarshal(b, &checkpoint); err != nil {
+		return nil, err
+	}
+
+	return &checkpoint, nil
+}
+
+// SaveRelayCheckpoint saves the relay checkpoint of the tunnel to the filesystem.
+func (fs *FileSystem) SaveRelayCheckpoint(checkpoint *db.RelayCheckpoint) error {
+	b, err := toml.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	return os.Write(b, getCheckpointPath(fs.HomePath, checkpoint.TunnelID))
+}
+
 // getConfigPath returns the directories of the config file and config file name.
 func getConfigPath(homePath string) []string {
 	return []string{homePath, cfgDir, cfgFileName}
@@ -136,3 +166,8 @@ func getConfigPath(homePath string) []string {
 func getPassphrasePath(homePath string) []string {
 	return []string{homePath, cfgDir, passphraseFileName}
 }
+
+// getCheckpointPath returns the directories of the checkpoint file and checkpoint file name of the tunnel.
+func getCheckpointPath(homePath string, tunnelID uint64) []string {
+	return []string{homePath, checkpointDir, fmt.Sprintf("tunnel_%d.toml", tunnelID)}
+}
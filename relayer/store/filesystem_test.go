@@ -3,10 +3,12 @@ package store_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/bandprotocol/falcon/relayer/config"
+	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/store"
 )
 
@@ -105,3 +107,26 @@ func (s *FileSystemTestSuite) TestValidatePassphraseInvalidPassphrase() {
 		})
 	}
 }
+
+func (s *FileSystemTestSuite) TestRelayCheckpoint() {
+	// empty checkpoint
+	checkpoint, err := s.store.GetRelayCheckpoint(1)
+	s.NoError(err)
+	s.Nil(checkpoint)
+
+	relayedAt := time.Unix(1_700_000_000, 0).UTC()
+	err = s.store.SaveRelayCheckpoint(db.NewRelayCheckpoint(1, 5, "0x05", relayedAt))
+	s.NoError(err)
+
+	err = s.store.SaveRelayCheckpoint(db.NewRelayCheckpoint(1, 6, "0x06", relayedAt))
+	s.NoError(err)
+
+	checkpoint, err = s.store.GetRelayCheckpoint(1)
+	s.NoError(err)
+	s.Equal(db.NewRelayCheckpoint(1, 6, "0x06", relayedAt), checkpoint)
+
+	// checkpoint of another tunnel is not affected
+	checkpoint, err = s.store.GetRelayCheckpoint(2)
+	s.NoError(err)
+	s.Nil(checkpoint)
+}
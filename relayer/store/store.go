@@ -3,6 +3,7 @@ package store
 import (
 	chainstypes "github.com/bandprotocol/falcon/relayer/chains/types"
 	"github.com/bandprotocol/falcon/relayer/config"
+	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/wallet"
 )
 
@@ -14,4 +15,6 @@ type Store interface {
 	SavePassphrase(passphrase string) error
 	ValidatePassphrase(passphrase string) error
 	NewWallet(chainType chainstypes.ChainType, chainName, passphrase string) (wallet.Wallet, error)
+
+	db.CheckpointStore
 }
@@ -2,7 +2,9 @@ package relayer
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -13,7 +15,9 @@ import (
 	"github.com/bandprotocol/falcon/relayer/band/types"
 	"github.com/bandprotocol/falcon/relayer/chains"
 	chaintypes "github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/logger"
+	"github.com/bandprotocol/falcon/relayer/penalty"
 )
 
 type RelayStatus string
@@ -27,23 +31,64 @@ const (
 
 const defaultLastSequenceValidityPeriod = 5 * time.Minute
 
+const (
+	// signingPollInterval is the interval of polling the signing of a packet while the signing
+	// events are not subscribed.
+	signingPollInterval = time.Second
+	// signingRecheckInterval is the interval of re-checking the signing of a packet while
+	// waiting for its signing event, in case the event is missed.
+	signingRecheckInterval = 5 * time.Second
+)
+
 // TunnelRelayer is a relayer that listens to the tunnel and relays the packet
 type TunnelRelayer struct {
-	Log                    logger.Logger
-	TunnelID               uint64
-	CheckingPacketInterval time.Duration
-	BandClient             band.Client
-	TargetChainProvider    chains.ChainProvider
-	Alert                  alert.Alert
-
-	isTargetChainActive  bool
-	penaltySkipRemaining uint
+	Log                     logger.Logger
+	TunnelID                uint64
+	CheckingPacketInterval  time.Duration
+	BandClient              band.Client
+	TargetChainProvider     chains.ChainProvider
+	Alert                   alert.Alert
+	CheckpointStore         db.CheckpointStore
+	CatchUpWindow           time.Duration
+	SigningMaxWait          time.Duration
+	MinTunnelBalance        *big.Int
+	PauseOnLowTunnelBalance bool
+
+	isTargetChainActive bool
+	isLowTunnelBalance  bool
+	// penaltyState is the penalty after the consecutive failures of the relayer, guarded by
+	// the scheduler.
+	penaltyState tunnelPenalty
 	// lastRelayedSequence tracks the highest sequence already handled.
-	// On the first call it is seeded from BandChain's LatestSequence so
-	// we never re-relay packets that were processed before this process started.
+	// On the first call it is seeded from the persisted checkpoint if any,
+	// otherwise from BandChain's LatestSequence so we never re-relay packets
+	// that were processed before this process started.
 	lastRelayedSequence *uint64
 	lastRelayedAt       time.Time
-	mu                  *sync.Mutex
+	// catchUpUntil is BandChain's LatestSequence at the time the relayer resumed from
+	// its checkpoint; packets up to this sequence were missed during downtime.
+	catchUpUntil uint64
+	mu           *sync.Mutex
+	// isRetired is set, guarded by mu, once the tunnel relayer is removed from the scheduler, so
+	// that the goroutines still holding it skip relaying from now on.
+	isRetired bool
+	// stateMu guards the last relayed state read by the admin API while relaying.
+	stateMu *sync.RWMutex
+	// stopCh is closed when the relayer is shutting down, to stop relaying at the next packet.
+	stopCh <-chan struct{}
+	// signingWaiter wakes the relayer waiting for the signing of a packet, if the signing
+	// events are subscribed.
+	signingWaiter *signingWaiter
+}
+
+// tunnelPenalty is the penalty of a failing tunnel relayer, which is not relayed until it expires.
+type tunnelPenalty struct {
+	errorClass          penalty.Class
+	consecutiveFailures int
+	backoff             time.Duration
+	until               time.Time
+	// isTriggerDeferred is set if a trigger event is deferred until the penalty expires.
+	isTriggerDeferred bool
 }
 
 // NewTunnelRelayer creates a new TunnelRelayer
@@ -54,19 +99,29 @@ func NewTunnelRelayer(
 	bandClient band.Client,
 	targetChainProvider chains.ChainProvider,
 	alert alert.Alert,
+	checkpointStore db.CheckpointStore,
+	catchUpWindow time.Duration,
+	signingMaxWait time.Duration,
+	minTunnelBalance *big.Int,
+	pauseOnLowTunnelBalance bool,
 ) TunnelRelayer {
 	return TunnelRelayer{
-		Log:                    log.With("tunnel_id", tunnelID),
-		TunnelID:               tunnelID,
-		CheckingPacketInterval: checkingPacketInterval,
-		BandClient:             bandClient,
-		TargetChainProvider:    targetChainProvider,
-		Alert:                  alert,
-		isTargetChainActive:    false,
-		penaltySkipRemaining:   0,
-		lastRelayedSequence:    nil,
-		lastRelayedAt:          time.Time{},
-		mu:                     &sync.Mutex{},
+		Log:                     log.With("tunnel_id", tunnelID),
+		TunnelID:                tunnelID,
+		CheckingPacketInterval:  checkingPacketInterval,
+		BandClient:              bandClient,
+		TargetChainProvider:     targetChainProvider,
+		Alert:                   alert,
+		CheckpointStore:         checkpointStore,
+		CatchUpWindow:           catchUpWindow,
+		SigningMaxWait:          signingMaxWait,
+		MinTunnelBalance:        minTunnelBalance,
+		PauseOnLowTunnelBalance: pauseOnLowTunnelBalance,
+		isTargetChainActive:     false,
+		lastRelayedSequence:     nil,
+		lastRelayedAt:           time.Time{},
+		mu:                      &sync.Mutex{},
+		stateMu:                 &sync.RWMutex{},
 	}
 }
 
@@ -80,6 +135,11 @@ func (t *TunnelRelayer) CheckAndRelay(
 		t.Log.Debug("Skip this tunnel: tunnel relayer is executing on another process")
 		return RelayStatusExecuting, nil
 	}
+	if t.isRetired {
+		t.mu.Unlock()
+		t.Log.Debug("Skip this tunnel: tunnel relayer is retired")
+		return RelayStatusSkipped, nil
+	}
 	defer func() {
 		t.mu.Unlock()
 
@@ -98,6 +158,12 @@ func (t *TunnelRelayer) CheckAndRelay(
 	t.Log.Debug("Executing task")
 	isPacketRelayed := false
 	for {
+		// stop at a safe point between packets if the relayer is shutting down
+		if t.isStopping() {
+			t.Log.Info("Stop relaying the next packets as the relayer is shutting down")
+			break
+		}
+
 		// get next packet sequence to relay
 		seq, targetAddr, err := t.getNextPacketSequence(ctx, isForce)
 		if err != nil {
@@ -120,8 +186,29 @@ func (t *TunnelRelayer) CheckAndRelay(
 		}
 
 		t.Log.Debug("Next packet sequence to relay", "sequence", seq)
+
 		// get packet of the sequence
-		packet, err := t.getTunnelPacket(ctx, seq)
+		packet, err := t.queryTunnelPacket(ctx, seq)
+		if err != nil {
+			return RelayStatusFailed, err
+		}
+
+		// skip packets missed during downtime that are older than the catch-up window, before
+		// waiting for their signing; the checkpoint is saved without a tx hash so that the
+		// skipped packet is not caught up again after a restart.
+		if t.isStaleCatchUpPacket(packet) {
+			t.Log.Warn(
+				"Skip missed packet older than catch-up window",
+				"sequence", seq,
+				"catch_up_window", t.CatchUpWindow,
+			)
+			t.setLastRelayedSequence(seq)
+			t.saveCheckpoint(seq, "", time.Now())
+			continue
+		}
+
+		// wait for the signing of the packet
+		packet, err = t.getSignedTunnelPacket(ctx, packet)
 		if err != nil {
 			return RelayStatusFailed, err
 		}
@@ -146,6 +233,16 @@ func (t *TunnelRelayer) CheckAndRelay(
 	return RelayStatusSuccess, nil
 }
 
+// isStopping checks if the relayer is shutting down.
+func (t *TunnelRelayer) isStopping() bool {
+	select {
+	case <-t.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
 // getNextPacketSequence returns the next packet sequence to relay. Sequence 0 is returned
 // if the tunnel status on BandChain is inactive (and not being forced) or the target contract
 // is inactive or the current packet is already relayed.
@@ -161,7 +258,7 @@ func (t *TunnelRelayer) getNextPacketSequence(ctx context.Context, isForce bool)
 			err.Error(),
 		)
 		t.Log.Error("Failed to get tunnel", err)
-		return 0, "", err
+		return 0, "", penalty.NewError(penalty.ClassTransient, err)
 	}
 	alert.HandleReset(
 		t.Alert,
@@ -173,7 +270,10 @@ func (t *TunnelRelayer) getNextPacketSequence(ctx context.Context, isForce bool)
 	// seed lastRelayedSequence on first call so we never re-relay packets
 	// that were already processed before this process started
 	if t.lastRelayedSequence == nil {
-		t.lastRelayedSequence = &tunnelInfo.LatestSequence
+		if err := t.loadCheckpoint(tunnelInfo.LatestSequence); err != nil {
+			t.Log.Error("Failed to load relay checkpoint", err)
+			return 0, "", penalty.NewError(penalty.ClassTransient, err)
+		}
 	}
 
 	// exit if the tunnel is not active and isForce is false
@@ -210,15 +310,17 @@ func (t *TunnelRelayer) getNextPacketSequence(ctx context.Context, isForce bool)
 	// 1. If the target contract provides LatestSequence, use that value directly.
 	// 2. If the target contract does not provide LatestSequence (i.e. it is nil), fall back to BandChain tunnel info:
 	//  a. Use the lastRelayedSequence tracked by the relayer
-	//  b. If chain type is XRPL, also consider the possibility
-	//     that there are already packets relayed on BandChain but not yet reflected on the target chain
-	//     by taking the max between lastRelayedSequence and (BandChain's LatestSequence - 1).
+	//  b. If chain type is XRPL and the relayer is not catching up from its checkpoint, also consider
+	//     the possibility that there are already packets relayed on BandChain but not yet reflected on
+	//     the target chain by taking the max between lastRelayedSequence and (BandChain's LatestSequence - 1).
 	var chainLatestSeq uint64
 	if targetContractInfo.LatestSequence != nil {
 		chainLatestSeq = *targetContractInfo.LatestSequence
+		// the on-chain sequence is authoritative, there is nothing to catch up locally.
+		t.catchUpUntil = 0
 	} else {
 		chainLatestSeq = *t.lastRelayedSequence
-		if t.TargetChainProvider.ChainType() == chaintypes.ChainTypeXRPL {
+		if t.TargetChainProvider.ChainType() == chaintypes.ChainTypeXRPL && chainLatestSeq >= t.catchUpUntil {
 			// Guard against underflow when LatestSequence is 0 by only subtracting 1
 			// when there is at least one packet on BandChain.
 			bandLatestSeqMinusOne := uint64(0)
@@ -243,6 +345,12 @@ func (t *TunnelRelayer) getNextPacketSequence(ctx context.Context, isForce bool)
 		return 0, "", nil
 	}
 
+	// pause relaying until the tunnel balance is topped up, if configured
+	if t.checkTunnelBalance(targetContractInfo.Balance) && t.PauseOnLowTunnelBalance && !isForce {
+		t.Log.Debug("Tunnel balance on target chain is below threshold; relaying is paused")
+		return 0, "", nil
+	}
+
 	nextSeq := chainLatestSeq + 1
 	if tunnelInfo.LatestSequence < nextSeq {
 		t.Log.Debug("No new packet to relay", "sequence", chainLatestSeq)
@@ -252,12 +360,155 @@ func (t *TunnelRelayer) getNextPacketSequence(ctx context.Context, isForce bool)
 	return nextSeq, tunnelInfo.TargetAddress, nil
 }
 
+// checkTunnelBalance records the tunnel balance on the target contract and alerts if it is
+// below MinTunnelBalance. It returns true if the balance is below the threshold.
+func (t *TunnelRelayer) checkTunnelBalance(balance *big.Int) bool {
+	if balance == nil {
+		return false
+	}
+
+	balanceFloat, _ := new(big.Float).SetInt(balance).Float64()
+	relayermetrics.SetTunnelTargetBalance(
+		t.TunnelID,
+		t.TargetChainProvider.GetChainName(),
+		t.TargetChainProvider.ChainType().String(),
+		balanceFloat,
+	)
+
+	if t.MinTunnelBalance == nil || t.MinTunnelBalance.Sign() == 0 {
+		return false
+	}
+
+	topic := alert.NewTopic(alert.LowTunnelBalanceMsg).
+		WithTunnelID(t.TunnelID).
+		WithChainName(t.TargetChainProvider.GetChainName())
+
+	minBalance := t.MinTunnelBalance
+	isLow := balance.Cmp(minBalance) < 0
+	switch {
+	case isLow:
+		alert.HandleAlert(
+			t.Alert,
+			topic,
+			fmt.Sprintf("balance %s is below minimum balance %s", balance, minBalance),
+		)
+		if !t.isLowTunnelBalance {
+			t.Log.Warn(
+				"Tunnel balance on target chain is below threshold",
+				"balance", balance.String(),
+				"min_balance", minBalance.String(),
+				"pause_relaying", t.PauseOnLowTunnelBalance,
+			)
+		}
+	case t.isLowTunnelBalance:
+		alert.HandleReset(t.Alert, topic)
+		t.Log.Info("Tunnel balance on target chain is topped up", "balance", balance.String())
+	}
+
+	t.isLowTunnelBalance = isLow
+	return isLow
+}
+
+// loadCheckpoint seeds lastRelayedSequence from the persisted checkpoint of the tunnel, or
+// from the given BandChain's latest sequence if there is none.
+func (t *TunnelRelayer) loadCheckpoint(bandLatestSeq uint64) error {
+	if t.CheckpointStore != nil {
+		checkpoint, err := t.CheckpointStore.GetRelayCheckpoint(t.TunnelID)
+		if err != nil {
+			return err
+		}
+
+		if checkpoint != nil {
+			seq := checkpoint.Sequence
+			t.setLastRelayedSequence(seq)
+			if seq < bandLatestSeq {
+				t.catchUpUntil = bandLatestSeq
+			}
+
+			t.Log.Info(
+				"Resume from relay checkpoint",
+				"sequence", checkpoint.Sequence,
+				"tx_hash", checkpoint.TxHash,
+				"relayed_at", checkpoint.RelayedAt,
+				"band_latest_sequence", bandLatestSeq,
+			)
+			return nil
+		}
+	}
+
+	t.setLastRelayedSequence(bandLatestSeq)
+	return nil
+}
+
+// saveCheckpoint persists the last relayed packet of the tunnel.
+func (t *TunnelRelayer) saveCheckpoint(seq uint64, txHash string, relayedAt time.Time) {
+	if t.CheckpointStore == nil {
+		return
+	}
+
+	topic := alert.NewTopic(alert.SaveCheckpointErrorMsg).
+		WithTunnelID(t.TunnelID).
+		WithChainName(t.TargetChainProvider.GetChainName())
+
+	checkpoint := db.NewRelayCheckpoint(t.TunnelID, seq, txHash, relayedAt)
+	if err := t.CheckpointStore.SaveRelayCheckpoint(checkpoint); err != nil {
+		alert.HandleAlert(t.Alert, topic, err.Error())
+		t.Log.Error("Failed to save relay checkpoint", "sequence", seq, err)
+		return
+	}
+	alert.HandleReset(t.Alert, topic)
+}
+
+// isStaleCatchUpPacket checks whether the packet was missed during downtime and is older
+// than the catch-up window, regardless of its signing. A zero catch-up window catches up all
+// missed packets.
+func (t *TunnelRelayer) isStaleCatchUpPacket(packet *types.Packet) bool {
+	return t.CatchUpWindow > 0 &&
+		packet.Sequence <= t.catchUpUntil &&
+		time.Since(time.Unix(packet.CreatedAt, 0)) > t.CatchUpWindow
+}
+
+// setLastRelayedSequence sets the highest sequence already handled by the tunnel relayer.
+func (t *TunnelRelayer) setLastRelayedSequence(seq uint64) {
+	t.stateMu.Lock()
+	defer t.stateMu.Unlock()
+
+	t.lastRelayedSequence = &seq
+}
+
+// getLastRelayed returns a copy of the last relayed sequence and the time it was relayed.
+func (t *TunnelRelayer) getLastRelayed() (*uint64, time.Time) {
+	t.stateMu.RLock()
+	defer t.stateMu.RUnlock()
+
+	if t.lastRelayedSequence == nil {
+		return nil, t.lastRelayedAt
+	}
+
+	seq := *t.lastRelayedSequence
+	return &seq, t.lastRelayedAt
+}
+
 func (t *TunnelRelayer) shouldSkipSequence(seq uint64) bool {
 	return !t.lastRelayedAt.IsZero() &&
 		seq <= *t.lastRelayedSequence &&
 		time.Since(t.lastRelayedAt) < defaultLastSequenceValidityPeriod
 }
 
+// resetTargetChainActive resets the active state of the target contract, as the tunnel relayer
+// no longer relays to it. The caller must hold t.mu.
+func (t *TunnelRelayer) resetTargetChainActive() {
+	if !t.isTargetChainActive {
+		return
+	}
+
+	relayermetrics.DecActiveTargetContractsCount(
+		t.TargetChainProvider.GetChainName(),
+		t.TargetChainProvider.ChainType().String(),
+	)
+	t.isTargetChainActive = false
+}
+
 // updateRelayerMetrics updates the metrics for the relayer.
 func (t *TunnelRelayer) updateRelayerMetrics(
 	bandLatestSequence uint64,
@@ -284,44 +535,75 @@ func (t *TunnelRelayer) relayPacket(ctx context.Context, packet *types.Packet) e
 	t.Log.Info("Relaying packet", "sequence", packet.Sequence)
 
 	// Relay the packet to the target chain
-	if err := t.TargetChainProvider.RelayPacket(ctx, packet); err != nil {
+	txHash, err := t.TargetChainProvider.RelayPacket(ctx, packet)
+	if err != nil {
+		// the packet is refused by the signing verifier before it is submitted
+		if errors.Is(err, chains.ErrInvalidSignature) {
+			alert.HandleAlert(
+				t.Alert,
+				alert.NewTopic(alert.InvalidPacketSignatureMsg).
+					WithTunnelID(t.TunnelID).
+					WithChainName(t.TargetChainProvider.GetChainName()),
+				err.Error(),
+			)
+		}
 		t.Log.Error("Failed to relay packet", "sequence", packet.Sequence, err)
 		return err
 	}
+	alert.HandleReset(
+		t.Alert,
+		alert.NewTopic(alert.InvalidPacketSignatureMsg).
+			WithTunnelID(t.TunnelID).
+			WithChainName(t.TargetChainProvider.GetChainName()),
+	)
 
 	// Increment the metric for successfully relayed packets
 	seq := packet.Sequence
+	relayedAt := time.Now()
+	t.stateMu.Lock()
 	t.lastRelayedSequence = &seq
-	t.lastRelayedAt = time.Now()
+	t.lastRelayedAt = relayedAt
+	t.stateMu.Unlock()
+	t.saveCheckpoint(seq, txHash, relayedAt)
 	relayermetrics.IncPacketsRelayedSuccess(t.TunnelID)
 	t.Log.Info("Successfully relayed packet", "sequence", packet.Sequence)
 
 	return nil
 }
 
-// getTunnelPacket polls BandChain for the packet with the given sequence
-// until its TSS signing status becomes SUCCESS, then returns it.
-func (t *TunnelRelayer) getTunnelPacket(ctx context.Context, seq uint64) (*types.Packet, error) {
-	for {
-		// get packet of the sequence
-		packet, err := t.BandClient.GetTunnelPacket(ctx, t.TunnelID, seq)
-		if err != nil {
-			alert.HandleAlert(
-				t.Alert,
-				alert.NewTopic(alert.GetTunnelPacketErrorMsg).
-					WithTunnelID(t.TunnelID).
-					WithChainName(t.TargetChainProvider.GetChainName()),
-				err.Error(),
-			)
-			t.Log.Error("Failed to get packet", "sequence", seq, err)
-			return nil, err
-		}
-		alert.HandleReset(
+// queryTunnelPacket queries BandChain for the packet with the given sequence, whatever its
+// signing status.
+func (t *TunnelRelayer) queryTunnelPacket(ctx context.Context, seq uint64) (*types.Packet, error) {
+	packet, err := t.BandClient.GetTunnelPacket(ctx, t.TunnelID, seq)
+	if err != nil {
+		alert.HandleAlert(
 			t.Alert,
 			alert.NewTopic(alert.GetTunnelPacketErrorMsg).
 				WithTunnelID(t.TunnelID).
 				WithChainName(t.TargetChainProvider.GetChainName()),
+			err.Error(),
 		)
+		t.Log.Error("Failed to get packet", "sequence", seq, err)
+		return nil, penalty.NewError(penalty.ClassTransient, err)
+	}
+	alert.HandleReset(
+		t.Alert,
+		alert.NewTopic(alert.GetTunnelPacketErrorMsg).
+			WithTunnelID(t.TunnelID).
+			WithChainName(t.TargetChainProvider.GetChainName()),
+	)
+
+	return packet, nil
+}
+
+// getSignedTunnelPacket returns the given packet queried from BandChain once its TSS signing
+// status becomes SUCCESS. While the signing is waiting, the packet is queried again once the
+// signing is resolved, see waitForSigning, up to the signing max wait.
+func (t *TunnelRelayer) getSignedTunnelPacket(ctx context.Context, packet *types.Packet) (*types.Packet, error) {
+	seq := packet.Sequence
+
+	var waitStartedAt time.Time
+	for {
 		// Check signing status; if it is waiting, wait for the completion of the EVM signature.
 		// If it is not success (Failed or Undefined), return error.
 		signing := packet.CurrentGroupSigning
@@ -331,18 +613,54 @@ func (t *TunnelRelayer) getTunnelPacket(ctx context.Context, seq uint64) (*types
 		}
 
 		if signing.SigningStatus == tsstypes.SIGNING_STATUS_WAITING {
+			if waitStartedAt.IsZero() {
+				waitStartedAt = time.Now()
+			}
+
+			if t.SigningMaxWait > 0 && time.Since(waitStartedAt) >= t.SigningMaxWait {
+				t.observeSigningWaitTime(waitStartedAt, relayermetrics.TimeoutSigningStatus)
+				err := fmt.Errorf("signing %d is still waiting after %s", signing.ID, t.SigningMaxWait)
+				alert.HandleAlert(
+					t.Alert,
+					alert.NewTopic(alert.PacketSigningStatusErrorMsg).
+						WithTunnelID(t.TunnelID).
+						WithChainName(t.TargetChainProvider.GetChainName()),
+					err.Error(),
+				)
+				t.Log.Error("Failed to relay packet", "sequence", seq, err)
+				return nil, penalty.NewError(penalty.ClassSigningNotReady, err)
+			}
+
 			t.Log.Debug(
 				"The current packet must wait for the completion of the EVM signature",
 				"sequence", seq,
+				"signing_id", signing.ID,
 			)
-			// wait 1 secs for each block
-			time.Sleep(time.Second)
+			if err := t.waitForSigning(ctx, signing.ID, waitStartedAt); err != nil {
+				return nil, penalty.NewError(penalty.ClassTransient, err)
+			}
+
+			// get packet of the sequence again
+			var err error
+			if packet, err = t.queryTunnelPacket(ctx, seq); err != nil {
+				return nil, err
+			}
 			continue
-		} else if signing.SigningStatus != tsstypes.SIGNING_STATUS_SUCCESS {
+		}
+
+		if !waitStartedAt.IsZero() {
+			signingStatus := relayermetrics.SuccessSigningStatus
+			if signing.SigningStatus != tsstypes.SIGNING_STATUS_SUCCESS {
+				signingStatus = relayermetrics.FailedSigningStatus
+			}
+			t.observeSigningWaitTime(waitStartedAt, signingStatus)
+		}
+
+		if signing.SigningStatus != tsstypes.SIGNING_STATUS_SUCCESS {
 			err := fmt.Errorf("signing status is not success")
 			alert.HandleAlert(t.Alert, alert.NewTopic(alert.PacketSigningStatusErrorMsg).WithTunnelID(t.TunnelID).WithChainName(t.TargetChainProvider.GetChainName()), err.Error())
 			t.Log.Error("Failed to relay packet", "sequence", seq, err)
-			return nil, err
+			return nil, penalty.NewError(penalty.ClassSigningNotReady, err)
 		}
 		alert.HandleReset(
 			t.Alert,
@@ -354,3 +672,47 @@ func (t *TunnelRelayer) getTunnelPacket(ctx context.Context, seq uint64) (*types
 		return packet, nil
 	}
 }
+
+// waitForSigning waits until the signing of the given ID may be resolved. It waits for the
+// signing event if the signing events are subscribed, re-checking the signing periodically in
+// case the event is missed, or polls the signing otherwise. The wait never exceeds the signing
+// max wait since waitStartedAt.
+func (t *TunnelRelayer) waitForSigning(ctx context.Context, signingID uint64, waitStartedAt time.Time) error {
+	var signedCh <-chan struct{}
+	interval := signingPollInterval
+	if t.signingWaiter != nil && t.signingWaiter.isSubscribed() {
+		ch, stop := t.signingWaiter.wait(signingID)
+		defer stop()
+
+		signedCh = ch
+		interval = signingRecheckInterval
+	}
+
+	if t.SigningMaxWait > 0 {
+		interval = min(interval, t.SigningMaxWait-time.Since(waitStartedAt))
+	}
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-signedCh:
+	case <-timer.C:
+	}
+
+	return nil
+}
+
+// observeSigningWaitTime records the time the relayer waited for the signing of a packet since
+// waitStartedAt, by how the signing is resolved.
+func (t *TunnelRelayer) observeSigningWaitTime(waitStartedAt time.Time, signingStatus string) {
+	relayermetrics.ObserveSigningWaitTime(
+		t.TunnelID,
+		t.TargetChainProvider.GetChainName(),
+		t.TargetChainProvider.ChainType().String(),
+		signingStatus,
+		time.Since(waitStartedAt).Milliseconds(),
+	)
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/bandprotocol/falcon/internal/bandchain/tss"
 	"github.com/bandprotocol/falcon/internal/relayertest/mocks"
 	"github.com/bandprotocol/falcon/relayer"
+	"github.com/bandprotocol/falcon/relayer/alert"
 	bandtypes "github.com/bandprotocol/falcon/relayer/band/types"
 	chaintypes "github.com/bandprotocol/falcon/relayer/chains/types"
+	"github.com/bandprotocol/falcon/relayer/db"
 	"github.com/bandprotocol/falcon/relayer/logger"
 )
 
@@ -55,6 +57,11 @@ func (s *TunnelRelayerTestSuite) SetupTest() {
 		s.client,
 		s.chainProvider,
 		nil,
+		nil,
+		0,
+		0,
+		nil,
+		false,
 	)
 	s.tunnelRelayer = &tunnelRelayer
 
@@ -172,7 +179,7 @@ func (s *TunnelRelayerTestSuite) TestCheckAndRelay() {
 				s.client.EXPECT().
 					GetTunnelPacket(gomock.Any(), s.tunnelRelayer.TunnelID, defaultTargetChainSequence+1).
 					Return(packet, nil)
-				s.chainProvider.EXPECT().RelayPacket(gomock.Any(), packet).Return(nil)
+				s.chainProvider.EXPECT().RelayPacket(gomock.Any(), packet).Return("0xabc", nil)
 
 				// Check and relay the packet for the second time
 				s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
@@ -253,7 +260,7 @@ func (s *TunnelRelayerTestSuite) TestCheckAndRelay() {
 				s.client.EXPECT().
 					GetTunnelPacket(gomock.Any(), s.tunnelRelayer.TunnelID, defaultTargetChainSequence+1).
 					Return(packet, nil)
-				s.chainProvider.EXPECT().RelayPacket(gomock.Any(), packet).Return(nil)
+				s.chainProvider.EXPECT().RelayPacket(gomock.Any(), packet).Return("0xabc", nil)
 
 				// Check and relay the packet for the second time
 				s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
@@ -313,7 +320,7 @@ func (s *TunnelRelayerTestSuite) TestCheckAndRelay() {
 						Return(successPacket, nil),
 				)
 
-				s.chainProvider.EXPECT().RelayPacket(gomock.Any(), successPacket).Return(nil)
+				s.chainProvider.EXPECT().RelayPacket(gomock.Any(), successPacket).Return("0xabc", nil)
 
 				// Check and relay the packet for the second time
 				s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
@@ -323,6 +330,30 @@ func (s *TunnelRelayerTestSuite) TestCheckAndRelay() {
 			relayStatus: relayer.RelayStatusSuccess,
 			chainType:   chaintypes.ChainTypeEVM,
 		},
+		{
+			name: "signing status is still waiting after the signing max wait",
+			preprocess: func() {
+				s.tunnelRelayer.SigningMaxWait = 10 * time.Millisecond
+
+				s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
+				s.mockQueryTunnelInfo(defaultTargetChainSequence, true, defaultEVMContractAddress)
+				waitingPacket := createMockPacket(
+					s.tunnelRelayer.TunnelID,
+					defaultTargetChainSequence+1,
+					int32(tss.SIGNING_STATUS_WAITING),
+					-1,
+				)
+
+				s.client.
+					EXPECT().
+					GetTunnelPacket(s.ctx, s.tunnelRelayer.TunnelID, defaultTargetChainSequence+1).
+					Return(waitingPacket, nil).
+					MinTimes(2)
+			},
+			err:         fmt.Errorf("signing 1 is still waiting after 10ms"),
+			relayStatus: relayer.RelayStatusFailed,
+			chainType:   chaintypes.ChainTypeEVM,
+		},
 		{
 			name: "failed to relay packet",
 			preprocess: func() {
@@ -339,7 +370,7 @@ func (s *TunnelRelayerTestSuite) TestCheckAndRelay() {
 				s.client.EXPECT().
 					GetTunnelPacket(s.ctx, s.tunnelRelayer.TunnelID, defaultTargetChainSequence+1).
 					Return(packet, nil)
-				s.chainProvider.EXPECT().RelayPacket(s.ctx, packet).Return(fmt.Errorf("failed to relay packet"))
+				s.chainProvider.EXPECT().RelayPacket(s.ctx, packet).Return("", fmt.Errorf("failed to relay packet"))
 			},
 			err:         fmt.Errorf("failed to relay packet"),
 			relayStatus: relayer.RelayStatusFailed,
@@ -383,6 +414,11 @@ func (s *TunnelRelayerTestSuite) TestCheckAndRelay() {
 				mockClient,
 				mockChainProvider,
 				nil,
+				nil,
+				0,
+				0,
+				nil,
+				false,
 			)
 
 			mockChainProvider.EXPECT().GetChainName().Return("").AnyTimes()
@@ -421,3 +457,234 @@ func (s *TunnelRelayerTestSuite) TestCheckAndRelay() {
 		})
 	}
 }
+
+func (s *TunnelRelayerTestSuite) TestCheckAndRelayWithCheckpoint() {
+	testcases := []struct {
+		name        string
+		chainType   chaintypes.ChainType
+		preprocess  func(store *mocks.MockStore)
+		relayStatus relayer.RelayStatus
+	}{
+		{
+			name:      "evm saves checkpoint after relaying",
+			chainType: chaintypes.ChainTypeEVM,
+			preprocess: func(store *mocks.MockStore) {
+				s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
+				store.EXPECT().GetRelayCheckpoint(defaultTunnelID).Return(nil, nil)
+				s.mockQueryTunnelInfo(defaultTargetChainSequence, true, defaultEVMContractAddress)
+
+				packet := createMockPacket(defaultTunnelID, 1, int32(tss.SIGNING_STATUS_SUCCESS), -1)
+				s.client.EXPECT().GetTunnelPacket(gomock.Any(), defaultTunnelID, uint64(1)).Return(packet, nil)
+				s.chainProvider.EXPECT().RelayPacket(gomock.Any(), packet).Return("0xabc", nil)
+				store.EXPECT().SaveRelayCheckpoint(gomock.Any()).DoAndReturn(func(cp *db.RelayCheckpoint) error {
+					s.Require().Equal(defaultTunnelID, cp.TunnelID)
+					s.Require().Equal(uint64(1), cp.Sequence)
+					s.Require().Equal("0xabc", cp.TxHash)
+					return nil
+				})
+
+				s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
+				s.mockQueryTunnelInfo(1, true, defaultEVMContractAddress)
+			},
+			relayStatus: relayer.RelayStatusSuccess,
+		},
+		{
+			name:      "xrpl resumes from checkpoint and catches up missed packet",
+			chainType: chaintypes.ChainTypeXRPL,
+			preprocess: func(store *mocks.MockStore) {
+				s.mockGetTunnel(2, defaultContractAddress)
+				store.EXPECT().
+					GetRelayCheckpoint(defaultTunnelID).
+					Return(db.NewRelayCheckpoint(defaultTunnelID, 1, "0x01", time.Now().Add(-time.Minute)), nil)
+				s.mockQueryXRPLTunnelInfo(true, defaultContractAddress)
+
+				packet := createMockPacket(defaultTunnelID, 2, int32(tss.SIGNING_STATUS_SUCCESS), -1)
+				s.client.EXPECT().GetTunnelPacket(gomock.Any(), defaultTunnelID, uint64(2)).Return(packet, nil)
+				s.chainProvider.EXPECT().RelayPacket(gomock.Any(), packet).Return("0x02", nil)
+				store.EXPECT().SaveRelayCheckpoint(gomock.Any()).Return(nil)
+
+				s.mockGetTunnel(2, defaultContractAddress)
+				s.mockQueryXRPLTunnelInfo(true, defaultContractAddress)
+			},
+			relayStatus: relayer.RelayStatusSuccess,
+		},
+		{
+			name:      "xrpl skips missed packet older than catch-up window",
+			chainType: chaintypes.ChainTypeXRPL,
+			preprocess: func(store *mocks.MockStore) {
+				s.mockGetTunnel(2, defaultContractAddress)
+				store.EXPECT().
+					GetRelayCheckpoint(defaultTunnelID).
+					Return(db.NewRelayCheckpoint(defaultTunnelID, 1, "0x01", time.Now().Add(-3*time.Hour)), nil)
+				s.mockQueryXRPLTunnelInfo(true, defaultContractAddress)
+
+				packet := createMockPacket(defaultTunnelID, 2, int32(tss.SIGNING_STATUS_SUCCESS), -1)
+				packet.CreatedAt = time.Now().Add(-2 * time.Hour).Unix()
+				s.client.EXPECT().GetTunnelPacket(gomock.Any(), defaultTunnelID, uint64(2)).Return(packet, nil)
+				store.EXPECT().SaveRelayCheckpoint(gomock.Any()).DoAndReturn(func(cp *db.RelayCheckpoint) error {
+					s.Require().Equal(defaultTunnelID, cp.TunnelID)
+					s.Require().Equal(uint64(2), cp.Sequence)
+					s.Require().Empty(cp.TxHash)
+					return nil
+				})
+
+				s.mockGetTunnel(2, defaultContractAddress)
+				s.mockQueryXRPLTunnelInfo(true, defaultContractAddress)
+			},
+			relayStatus: relayer.RelayStatusSkipped,
+		},
+		{
+			name:      "xrpl skips missed packet older than catch-up window without waiting for its signing",
+			chainType: chaintypes.ChainTypeXRPL,
+			preprocess: func(store *mocks.MockStore) {
+				s.mockGetTunnel(2, defaultContractAddress)
+				store.EXPECT().
+					GetRelayCheckpoint(defaultTunnelID).
+					Return(db.NewRelayCheckpoint(defaultTunnelID, 1, "0x01", time.Now().Add(-3*time.Hour)), nil)
+				s.mockQueryXRPLTunnelInfo(true, defaultContractAddress)
+
+				packet := createMockPacket(defaultTunnelID, 2, int32(tss.SIGNING_STATUS_WAITING), -1)
+				packet.CreatedAt = time.Now().Add(-2 * time.Hour).Unix()
+				s.client.EXPECT().GetTunnelPacket(gomock.Any(), defaultTunnelID, uint64(2)).Return(packet, nil)
+				store.EXPECT().SaveRelayCheckpoint(gomock.Any()).Return(nil)
+
+				s.mockGetTunnel(2, defaultContractAddress)
+				s.mockQueryXRPLTunnelInfo(true, defaultContractAddress)
+			},
+			relayStatus: relayer.RelayStatusSkipped,
+		},
+		{
+			name:      "failed to load checkpoint",
+			chainType: chaintypes.ChainTypeEVM,
+			preprocess: func(store *mocks.MockStore) {
+				s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
+				store.EXPECT().GetRelayCheckpoint(defaultTunnelID).Return(nil, fmt.Errorf("failed to read"))
+			},
+			relayStatus: relayer.RelayStatusFailed,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockChainProvider := mocks.NewMockChainProvider(ctrl)
+			mockClient := mocks.NewMockClient(ctrl)
+			mockStore := mocks.NewMockStore(ctrl)
+
+			tunnelRelayer := relayer.NewTunnelRelayer(
+				logger.NewZapLogWrapper(zap.NewNop().Sugar()),
+				defaultTunnelID,
+				defaultCheckingPacketInterval,
+				mockClient,
+				mockChainProvider,
+				nil,
+				mockStore,
+				time.Hour,
+				0,
+				nil,
+				false,
+			)
+
+			mockChainProvider.EXPECT().GetChainName().Return("").AnyTimes()
+			mockChainProvider.EXPECT().ChainType().Return(tc.chainType).AnyTimes()
+
+			oldClient := s.client
+			oldProvider := s.chainProvider
+			oldRelayer := s.tunnelRelayer
+			s.client = mockClient
+			s.chainProvider = mockChainProvider
+			s.tunnelRelayer = &tunnelRelayer
+			defer func() {
+				s.client = oldClient
+				s.chainProvider = oldProvider
+				s.tunnelRelayer = oldRelayer
+			}()
+
+			tc.preprocess(mockStore)
+
+			relayStatus, _ := tunnelRelayer.CheckAndRelay(s.ctx, false)
+			s.Require().Equal(tc.relayStatus, relayStatus)
+		})
+	}
+}
+
+// triggeredAlert records the triggered and reset topics.
+type triggeredAlert struct {
+	triggered map[string]string
+	reset     []string
+}
+
+func (a *triggeredAlert) Trigger(topic, detail string) {
+	a.triggered[topic] = detail
+}
+
+func (a *triggeredAlert) Reset(topic string) {
+	a.reset = append(a.reset, topic)
+}
+
+func (s *TunnelRelayerTestSuite) TestCheckAndRelayWithLowTunnelBalance() {
+	topic := alert.NewTopic(alert.LowTunnelBalanceMsg).WithTunnelID(defaultTunnelID).WithChainName("").GetFullTopic()
+
+	s.Run("pause relaying", func() {
+		s.SetupTest()
+		recorder := &triggeredAlert{triggered: make(map[string]string)}
+		s.tunnelRelayer.Alert = recorder
+		s.tunnelRelayer.MinTunnelBalance = big.NewInt(10)
+		s.tunnelRelayer.PauseOnLowTunnelBalance = true
+
+		s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
+		s.mockQueryTunnelInfo(defaultTargetChainSequence, true, defaultEVMContractAddress)
+
+		status, err := s.tunnelRelayer.CheckAndRelay(s.ctx, false)
+		s.Require().NoError(err)
+		s.Require().Equal(relayer.RelayStatusSkipped, status)
+		s.Require().Equal("balance 1 is below minimum balance 10", recorder.triggered[topic])
+	})
+
+	s.Run("alert only", func() {
+		s.SetupTest()
+		recorder := &triggeredAlert{triggered: make(map[string]string)}
+		s.tunnelRelayer.Alert = recorder
+		s.tunnelRelayer.MinTunnelBalance = big.NewInt(10)
+
+		s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
+		s.mockQueryTunnelInfo(defaultTargetChainSequence, true, defaultEVMContractAddress)
+
+		packet := createMockPacket(
+			s.tunnelRelayer.TunnelID,
+			defaultTargetChainSequence+1,
+			int32(tss.SIGNING_STATUS_SUCCESS),
+			-1,
+		)
+		s.client.EXPECT().
+			GetTunnelPacket(gomock.Any(), s.tunnelRelayer.TunnelID, defaultTargetChainSequence+1).
+			Return(packet, nil)
+		s.chainProvider.EXPECT().RelayPacket(gomock.Any(), packet).Return("0xabc", nil)
+
+		s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
+		s.mockQueryTunnelInfo(defaultTargetChainSequence+1, true, defaultEVMContractAddress)
+
+		status, err := s.tunnelRelayer.CheckAndRelay(s.ctx, false)
+		s.Require().NoError(err)
+		s.Require().Equal(relayer.RelayStatusSuccess, status)
+		s.Require().Contains(recorder.triggered, topic)
+	})
+
+	s.Run("balance is enough", func() {
+		s.SetupTest()
+		recorder := &triggeredAlert{triggered: make(map[string]string)}
+		s.tunnelRelayer.Alert = recorder
+		s.tunnelRelayer.MinTunnelBalance = big.NewInt(1)
+		s.tunnelRelayer.PauseOnLowTunnelBalance = true
+
+		s.mockGetTunnel(defaultBandLatestSequence, defaultEVMContractAddress)
+		s.mockQueryTunnelInfo(defaultBandLatestSequence, true, defaultEVMContractAddress)
+
+		status, err := s.tunnelRelayer.CheckAndRelay(s.ctx, false)
+		s.Require().NoError(err)
+		s.Require().Equal(relayer.RelayStatusSkipped, status)
+		s.Require().Empty(recorder.triggered)
+	})
+}
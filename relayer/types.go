@@ -63,10 +63,24 @@ type Application interface {
 	ListKeys(chainName string) ([]*types.KeyOutput, error)
 	ExportKey(chainName string, keyName string) (string, error)
 	ShowKey(chainName string, keyName string) (string, error)
+	SweepKeys(
+		ctx context.Context,
+		chainName string,
+		keyNames []string,
+		destination string,
+		dryRun bool,
+	) ([]*types.SweepOutput, error)
 
 	Relay(ctx context.Context, tunnelID uint64, isForce bool) error
 	Start(ctx context.Context, tunnelIDs []uint64, tunnelCreator string) error
 	QueryTunnelInfo(ctx context.Context, tunnelID uint64) (*types.Tunnel, error)
 	QueryTunnelPacketInfo(ctx context.Context, tunnelID uint64, sequence uint64) (*bandtypes.Packet, error)
+	QueryDecodedTunnelPacket(ctx context.Context, tunnelID uint64, sequence uint64) (*types.DecodedPacket, error)
 	QueryBalance(ctx context.Context, chainName string, keyName string) (*big.Int, error)
+	QueryTransactions(filter db.TransactionFilter) ([]db.Transaction, error)
+	QueryTransaction(txHash string) (*db.Transaction, error)
+
+	MigrateDatabase(ctx context.Context) ([]db.MigrationResult, error)
+	RollbackDatabase(ctx context.Context) (db.MigrationResult, error)
+	GetDatabaseStatus(ctx context.Context) ([]db.MigrationStatus, error)
 }
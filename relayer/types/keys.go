@@ -15,3 +15,27 @@ func NewKeyOutput(mnemonic string, address string, keyName string) *KeyOutput {
 		KeyName:  keyName,
 	}
 }
+
+// SweepOutput contains the result of sweeping the balance of a key; amounts are in the
+// smallest unit of the native token.
+type SweepOutput struct {
+	KeyName     string `json:"key_name"`
+	Address     string `json:"address"`
+	Destination string `json:"destination"`
+	Balance     string `json:"balance,omitempty"`
+	Fee         string `json:"fee,omitempty"`
+	Reserve     string `json:"reserve,omitempty"`
+	Amount      string `json:"amount,omitempty"`
+	TxHash      string `json:"tx_hash,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// NewSweepOutput creates a new instance of SweepOutput
+func NewSweepOutput(keyName string, address string, destination string) *SweepOutput {
+	return &SweepOutput{
+		KeyName:     keyName,
+		Address:     address,
+		Destination: destination,
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import "time"
+
+// DecodedPacket defines the packet with its TSS message decoded by the encoder of the tunnel.
+type DecodedPacket struct {
+	TunnelID       uint64               `json:"tunnel_id"`
+	SigningID      uint64               `json:"signing_id"`
+	Encoder        string               `json:"encoder"`
+	OriginatorHash string               `json:"originator_hash"`
+	Sequence       uint64               `json:"sequence"`
+	SignalPrices   []DecodedSignalPrice `json:"signal_prices"`
+	CreatedAt      time.Time            `json:"created_at"`
+	Mismatches     []string             `json:"mismatches"`
+}
+
+// DecodedSignalPrice defines the price of a signal decoded from the TSS message. The encoded
+// price is the fixed-point price or the tick, depending on the encoder.
+type DecodedSignalPrice struct {
+	SignalID     string `json:"signal_id"`
+	EncodedPrice uint64 `json:"encoded_price"`
+	Price        string `json:"price"`
+}
+
+// NewDecodedSignalPrice creates a new decoded signal price object.
+func NewDecodedSignalPrice(signalID string, encodedPrice uint64, price string) DecodedSignalPrice {
+	return DecodedSignalPrice{
+		SignalID:     signalID,
+		EncodedPrice: encodedPrice,
+		Price:        price,
+	}
+}
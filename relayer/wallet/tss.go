@@ -3,8 +3,12 @@ package wallet
 import (
 	"bytes"
 	"fmt"
+	"math"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	feedstypes "github.com/bandprotocol/falcon/internal/bandchain/feeds"
 )
 
 var (
@@ -29,6 +33,28 @@ var (
 
 const EncoderABIPrefixLength = 56
 
+const (
+	// OriginatorHashLength is the length of the hash of the originator at the start of a TSS message.
+	OriginatorHashLength = 32
+	// EncoderSelectorLength is the length of the selector of the encoder at the end of the prefix
+	// of a TSS message.
+	EncoderSelectorLength = 4
+
+	// tickOffset is the tick of the price of 1, as the ticks are unsigned.
+	tickOffset = 262144
+	// tickBase is the ratio of the prices of two consecutive ticks.
+	tickBase = 1.0001
+	// fixedPointPrecision is the number of decimals of a fixed-point price.
+	fixedPointPrecision = 1e9
+)
+
+var (
+	// EncoderFixedPointABISelector is the selector of the fixed-point ABI encoder in a TSS message.
+	EncoderFixedPointABISelector = crypto.Keccak256([]byte("FixedPointABI"))[:EncoderSelectorLength]
+	// EncoderTickABISelector is the selector of the tick ABI encoder in a TSS message.
+	EncoderTickABISelector = crypto.Keccak256([]byte("TickABI"))[:EncoderSelectorLength]
+)
+
 type TssPayload struct {
 	TssMessage []byte
 	RandomAddr []byte
@@ -78,6 +104,55 @@ func (p TssPayload) ToTSSPacket() (*TSSPacket, error) {
 	return &result.TSSPacket, nil
 }
 
+// OriginatorHash returns the hash of the originator of the TSS message.
+func (p TssPayload) OriginatorHash() ([]byte, error) {
+	if len(p.TssMessage) < EncoderABIPrefixLength {
+		return nil, fmt.Errorf("tss message should have at least %d bytes", EncoderABIPrefixLength)
+	}
+
+	return p.TssMessage[:OriginatorHashLength], nil
+}
+
+// Encoder returns the encoder of the TSS message from its selector.
+func (p TssPayload) Encoder() (feedstypes.Encoder, error) {
+	if len(p.TssMessage) < EncoderABIPrefixLength {
+		return feedstypes.ENCODER_UNSPECIFIED, fmt.Errorf(
+			"tss message should have at least %d bytes",
+			EncoderABIPrefixLength,
+		)
+	}
+
+	selector := p.TssMessage[EncoderABIPrefixLength-EncoderSelectorLength : EncoderABIPrefixLength]
+	switch {
+	case bytes.Equal(selector, EncoderFixedPointABISelector):
+		return feedstypes.ENCODER_FIXED_POINT_ABI, nil
+	case bytes.Equal(selector, EncoderTickABISelector):
+		return feedstypes.ENCODER_TICK_ABI, nil
+	default:
+		return feedstypes.ENCODER_UNSPECIFIED, fmt.Errorf("unknown encoder selector: %x", selector)
+	}
+}
+
+// TickToPrice converts the tick of the tick ABI encoder to its price.
+func TickToPrice(tick uint64) float64 {
+	return math.Pow(tickBase, float64(tick)-tickOffset)
+}
+
+// FixedPointToTick converts the fixed-point price, which is the price multiplied by 10^9, to the
+// tick of the tick ABI encoder, rounded down.
+func FixedPointToTick(price uint64) (uint64, error) {
+	if price == 0 {
+		return 0, fmt.Errorf("price must be positive")
+	}
+
+	tick := math.Floor(math.Log(float64(price)/fixedPointPrecision)/math.Log(tickBase)) + tickOffset
+	if tick < 0 {
+		return 0, fmt.Errorf("price %d is below the minimum tick", price)
+	}
+
+	return uint64(tick), nil
+}
+
 func Bytes32ToString(byteArray [32]byte) string {
 	trimmed := bytes.TrimLeft(byteArray[:], "\x00")
 	return string(trimmed)
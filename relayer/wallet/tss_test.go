@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	feedstypes "github.com/bandprotocol/falcon/internal/bandchain/feeds"
 	"github.com/bandprotocol/falcon/relayer/wallet"
 )
 
@@ -154,3 +155,72 @@ func TestBytes32ToString(t *testing.T) {
 		})
 	}
 }
+
+// TestTssPayloadEncoder verifies that the encoder is recovered from the selector of the TSS message.
+func TestTssPayloadEncoder(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		expected feedstypes.Encoder
+		err      string
+	}{
+		{
+			name:     "fixed-point ABI",
+			selector: "cba0ad5a",
+			expected: feedstypes.ENCODER_FIXED_POINT_ABI,
+		},
+		{
+			name:     "tick ABI",
+			selector: "db99b2b3",
+			expected: feedstypes.ENCODER_TICK_ABI,
+		},
+		{
+			name:     "unknown selector",
+			selector: "deadbeef",
+			expected: feedstypes.ENCODER_UNSPECIFIED,
+			err:      "unknown encoder selector: deadbeef",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := hex.DecodeString(tc.selector)
+			require.NoError(t, err)
+
+			msg := make([]byte, wallet.EncoderABIPrefixLength)
+			msg[0] = 0xab
+			copy(msg[wallet.EncoderABIPrefixLength-wallet.EncoderSelectorLength:], selector)
+
+			payload := wallet.NewTssPayload(msg, nil, nil)
+			encoder, err := payload.Encoder()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expected, encoder)
+
+			originatorHash, err := payload.OriginatorHash()
+			require.NoError(t, err)
+			require.Equal(t, msg[:wallet.OriginatorHashLength], originatorHash)
+		})
+	}
+
+	_, err := wallet.NewTssPayload([]byte{0x01}, nil, nil).Encoder()
+	require.ErrorContains(t, err, "tss message should have at least")
+}
+
+// TestFixedPointToTick verifies the conversion between fixed-point prices and ticks.
+func TestFixedPointToTick(t *testing.T) {
+	tick, err := wallet.FixedPointToTick(2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0xf188), tick)
+
+	tick, err = wallet.FixedPointToTick(1e9)
+	require.NoError(t, err)
+	require.Equal(t, uint64(262144), tick)
+	require.InDelta(t, 1.0, wallet.TickToPrice(tick), 1e-12)
+
+	_, err = wallet.FixedPointToTick(0)
+	require.Error(t, err)
+}
@@ -24,7 +24,10 @@ var (
 	dataClassHex = hex.EncodeToString([]byte("currency"))
 )
 
-var _ wallet.Signer = (*LocalSigner)(nil)
+var (
+	_ wallet.Signer = (*LocalSigner)(nil)
+	_ PaymentSigner = (*LocalSigner)(nil)
+)
 
 // LocalSigner uses a local XRPL secret for signing.
 type LocalSigner struct {
@@ -126,6 +129,37 @@ func (l *LocalSigner) Sign(payload []byte, tssPayload wallet.TssPayload) ([]byte
 	return []byte(txBlob), nil
 }
 
+// SignPayment signs the XRP payment transaction and returns the signed tx blob.
+func (l *LocalSigner) SignPayment(payload PaymentPayload) ([]byte, error) {
+	amount, err := strconv.ParseUint(payload.Amount, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := strconv.ParseUint(payload.Fee, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.Payment{
+		BaseTx: transaction.BaseTx{
+			Account:         xrpltypes.Address(payload.Account),
+			TransactionType: transaction.PaymentTx,
+			Sequence:        payload.Sequence,
+			Fee:             xrpltypes.XRPCurrencyAmount(fee),
+		},
+		Amount:      xrpltypes.XRPCurrencyAmount(amount),
+		Destination: xrpltypes.Address(payload.Destination),
+	}
+
+	txBlob, _, err := l.Wallet.Sign(tx.Flatten())
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(txBlob), nil
+}
+
 func formatAssetPrice(tx map[string]any) ([]map[string]any, error) {
 	// Look for the PriceDataSeries in the flattened map
 	priceDataSeries, ok := tx["PriceDataSeries"].([]map[string]any)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	xrplwallet "github.com/Peersyst/xrpl-go/xrpl/wallet"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,3 +67,28 @@ func TestLocalSigner(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, signedBlob)
 }
+
+func TestLocalSignerSignPayment(t *testing.T) {
+	seed := "sEdVeuhfwHB6dMxgSBccJ7ZYGyLfySa"
+	w, err := xrplwallet.FromSecret(seed)
+	require.NoError(t, err)
+
+	signer := xrpl.NewLocalSigner("test-local-signer", &w)
+	destination := "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+
+	payload := xrpl.NewPaymentPayload(signer.GetAddress(), destination, "3799900", "100", 10)
+	signedBlob, err := signer.SignPayment(payload)
+	require.NoError(t, err)
+
+	tx, err := binarycodec.Decode(string(signedBlob))
+	require.NoError(t, err)
+	assert.Equal(t, "Payment", tx["TransactionType"])
+	assert.Equal(t, signer.GetAddress(), tx["Account"])
+	assert.Equal(t, destination, tx["Destination"])
+	assert.Equal(t, "3799900", tx["Amount"])
+	assert.Equal(t, "100", tx["Fee"])
+	assert.NotEmpty(t, tx["TxnSignature"])
+
+	_, err = signer.SignPayment(xrpl.NewPaymentPayload(signer.GetAddress(), destination, "invalid", "100", 10))
+	assert.Error(t, err)
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/bandprotocol/falcon/relayer/wallet"
 )
 
-var _ wallet.Signer = (*RemoteSigner)(nil)
+var (
+	_ wallet.Signer = (*RemoteSigner)(nil)
+	_ PaymentSigner = (*RemoteSigner)(nil)
+)
 
 // RemoteSigner is signer that uses KMS service to sign XRPL data.
 type RemoteSigner struct {
@@ -53,3 +56,24 @@ func (r *RemoteSigner) Sign(payload []byte, tssPayload wallet.TssPayload) ([]byt
 
 	return []byte(hex.EncodeToString(res.TxBlob)), nil
 }
+
+// SignPayment requests the remote KMS to sign the XRP payment transaction and returns the tx blob.
+func (r *RemoteSigner) SignPayment(payload PaymentPayload) ([]byte, error) {
+	res, err := r.FkmsClient.SignXrplPayment(
+		r.ContextWithKey(),
+		&fkmsv1.SignXrplPaymentRequest{
+			PaymentPayload: &fkmsv1.XrplPaymentPayload{
+				Account:     payload.Account,
+				Destination: payload.Destination,
+				Amount:      payload.Amount,
+				Fee:         payload.Fee,
+				Sequence:    uint64(payload.Sequence),
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(hex.EncodeToString(res.TxBlob)), nil
+}
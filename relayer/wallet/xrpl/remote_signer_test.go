@@ -71,3 +71,35 @@ func TestRemoteSigner(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, hex.EncodeToString(expectedTxBlob), string(signedBlob))
 }
+
+func TestRemoteSignerSignPayment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFkmsClient := mocks.NewMockFkmsServiceClient(ctrl)
+
+	address := "rHb9CJAW8f5rjR5juUs6K3mJtr47MS9f2"
+	signer, err := xrpl.NewRemoteSigner("test-remote-signer", address, "localhost:50051", "test-api-key")
+	assert.NoError(t, err)
+	signer.FkmsClient = mockFkmsClient
+
+	payload := xrpl.NewPaymentPayload(address, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "3799900", "100", 10)
+
+	expectedTxBlob := []byte("signed-tx-blob")
+	mockFkmsClient.EXPECT().SignXrplPayment(
+		gomock.Any(),
+		&fkmsv1.SignXrplPaymentRequest{
+			PaymentPayload: &fkmsv1.XrplPaymentPayload{
+				Account:     address,
+				Destination: payload.Destination,
+				Amount:      payload.Amount,
+				Fee:         payload.Fee,
+				Sequence:    10,
+			},
+		},
+	).Return(&fkmsv1.SignXrplPaymentResponse{TxBlob: expectedTxBlob}, nil)
+
+	signedBlob, err := signer.SignPayment(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(expectedTxBlob), string(signedBlob))
+}
@@ -15,3 +15,28 @@ func NewSignerPayload(account string, oracleID uint64, fee string, sequence uint
 		Sequence: sequence,
 	}
 }
+
+// PaymentPayload is the payload of an XRP payment transaction; the amount and fee are in drops.
+type PaymentPayload struct {
+	Account     string
+	Destination string
+	Amount      string
+	Fee         string
+	Sequence    uint32
+}
+
+func NewPaymentPayload(account, destination, amount, fee string, sequence uint32) PaymentPayload {
+	return PaymentPayload{
+		Account:     account,
+		Destination: destination,
+		Amount:      amount,
+		Fee:         fee,
+		Sequence:    sequence,
+	}
+}
+
+// PaymentSigner is implemented by the XRPL signers that can sign XRP payment transactions.
+type PaymentSigner interface {
+	// SignPayment signs the payment transaction and returns the signed tx blob.
+	SignPayment(payload PaymentPayload) ([]byte, error)
+}